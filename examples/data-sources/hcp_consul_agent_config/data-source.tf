@@ -0,0 +1,13 @@
+data "hcp_consul_agent_config" "example" {
+  cluster_id = var.cluster_id
+}
+
+resource "local_file" "consul_ca" {
+  content  = data.hcp_consul_agent_config.example.ca_file
+  filename = "${path.module}/ca.pem"
+}
+
+resource "local_sensitive_file" "consul_config" {
+  content  = replace(data.hcp_consul_agent_config.example.config, "<CONSUL_AGENT_TOKEN>", var.consul_agent_token)
+  filename = "${path.module}/client.hcl"
+}
@@ -0,0 +1,28 @@
+data "hcp_consul_agent_ecs_config" "example" {
+  cluster_id            = var.cluster_id
+  gossip_key_secret_arn = aws_secretsmanager_secret.gossip_key.arn
+  acl_token_secret_arn  = aws_secretsmanager_secret.agent_token.arn
+}
+
+resource "aws_secretsmanager_secret_version" "gossip_key" {
+  secret_id     = aws_secretsmanager_secret.gossip_key.id
+  secret_string = data.hcp_consul_agent_ecs_config.example.gossip_key
+}
+
+resource "aws_ecs_task_definition" "example" {
+  family                   = "example"
+  requires_compatibilities = ["FARGATE"]
+  network_mode             = "awsvpc"
+  cpu                      = 256
+  memory                   = 512
+  execution_role_arn       = aws_iam_role.execution.arn
+
+  container_definitions = jsonencode([
+    jsondecode(data.hcp_consul_agent_ecs_config.example.container_definition),
+    {
+      name      = "app"
+      image     = "example/app:latest"
+      essential = true
+    },
+  ])
+}
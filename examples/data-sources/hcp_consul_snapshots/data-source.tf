@@ -0,0 +1,3 @@
+data "hcp_consul_snapshots" "example" {
+  cluster_id = var.cluster_id
+}
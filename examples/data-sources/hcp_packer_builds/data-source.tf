@@ -0,0 +1,8 @@
+data "hcp_packer_builds" "hardened-source" {
+  bucket_name         = "hardened-ubuntu-16-04"
+  version_fingerprint = "01H1SF9NWAK8AP25PAWDBGZ1YD"
+  status              = "BUILD_DONE"
+  labels = {
+    "arch" = "arm64"
+  }
+}
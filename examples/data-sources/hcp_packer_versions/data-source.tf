@@ -0,0 +1,19 @@
+data "hcp_packer_versions" "hardened-source" {
+  bucket_name   = "hardened-ubuntu-16-04"
+  status        = "VERSION_ACTIVE"
+  created_after = "2024-01-01T00:00:00Z"
+  labels = {
+    "os" = "ubuntu"
+  }
+}
+
+# Build a provenance report mapping each version to the Packer runs and
+# parent versions it was produced from.
+output "provenance" {
+  value = {
+    for v in data.hcp_packer_versions.hardened-source.versions : v.fingerprint => {
+      packer_run_uuids = [for b in v.builds : b.packer_run_uuid]
+      parents          = [for p in v.parents : "${p.bucket_name}/${p.version_fingerprint}"]
+    }
+  }
+}
@@ -0,0 +1,13 @@
+data "hcp_vault_cluster_connection" "example" {
+  cluster_id = var.cluster_id
+}
+
+resource "hcp_vault_cluster_admin_token" "example" {
+  cluster_id = var.cluster_id
+}
+
+provider "vault" {
+  address   = data.hcp_vault_cluster_connection.example.address
+  namespace = data.hcp_vault_cluster_connection.example.namespace
+  token     = hcp_vault_cluster_admin_token.example.token
+}
@@ -0,0 +1,10 @@
+data "hcp_vault_cluster_pending_upgrades" "example" {
+  cluster_id = var.cluster_id
+}
+
+output "manual_upgrades_required" {
+  value = [
+    for upgrade in data.hcp_vault_cluster_pending_upgrades.example.upgrades :
+    upgrade.type if upgrade.status == "ACTION_REQUIRED"
+  ]
+}
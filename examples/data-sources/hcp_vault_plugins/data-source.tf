@@ -0,0 +1,17 @@
+data "hcp_vault_plugins" "example" {
+  cluster_id = var.cluster_id
+}
+
+locals {
+  venafi = one([
+    for plugin in data.hcp_vault_plugins.example.plugins : plugin
+    if plugin.plugin_name == "venafi-pki-backend" && plugin.plugin_type == "SECRET"
+  ])
+}
+
+resource "hcp_vault_plugin" "venafi" {
+  cluster_id     = var.cluster_id
+  plugin_name    = local.venafi.plugin_name
+  plugin_type    = local.venafi.plugin_type
+  plugin_version = local.venafi.plugin_version
+}
@@ -2,3 +2,9 @@ data "hcp_vault_secrets_secret" "example" {
   app_name    = "example-vault-secrets-app"
   secret_name = "my_secret"
 }
+
+data "hcp_vault_secrets_secret" "pinned" {
+  app_name    = "example-vault-secrets-app"
+  secret_name = "my_secret"
+  version     = 3
+}
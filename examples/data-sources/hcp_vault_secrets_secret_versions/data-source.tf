@@ -0,0 +1,11 @@
+data "hcp_vault_secrets_secret_versions" "example" {
+  app_name    = "example-vault-secrets-app"
+  secret_name = "my_secret"
+}
+
+# Roll consumers back to the version before the latest one.
+data "hcp_vault_secrets_secret" "previous" {
+  app_name    = "example-vault-secrets-app"
+  secret_name = "my_secret"
+  version     = data.hcp_vault_secrets_secret_versions.example.latest_version - 1
+}
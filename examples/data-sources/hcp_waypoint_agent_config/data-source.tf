@@ -0,0 +1,25 @@
+resource "hcp_service_principal" "agent" {
+  name   = "waypoint-agent"
+  parent = var.project_resource_name
+}
+
+resource "hcp_service_principal_key" "agent" {
+  service_principal = hcp_service_principal.agent.resource_name
+}
+
+data "hcp_waypoint_agent_config" "example" {
+  group_name    = "production"
+  client_id     = hcp_service_principal_key.agent.client_id
+  client_secret = hcp_service_principal_key.agent.client_secret
+
+  commands = {
+    deploy   = ["./scripts/deploy.sh"]
+    rollback = ["./scripts/rollback.sh", "--force"]
+  }
+}
+
+# Write the agent configuration next to the agent's scripts.
+resource "local_file" "agent_config" {
+  filename = "${path.module}/agent.hcl"
+  content  = data.hcp_waypoint_agent_config.example.config
+}
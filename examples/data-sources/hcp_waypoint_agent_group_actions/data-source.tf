@@ -0,0 +1,7 @@
+data "hcp_waypoint_agent_group_actions" "example" {
+  group_name = "production"
+}
+
+output "operations" {
+  value = data.hcp_waypoint_agent_group_actions.example.actions[*].operation_id
+}
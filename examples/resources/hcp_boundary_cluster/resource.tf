@@ -2,6 +2,7 @@ resource "hcp_boundary_cluster" "example" {
   cluster_id = "boundary-cluster"
   username   = "test-user"
   password   = "Password123!"
+  tier       = "Plus"
   maintenance_window_config {
     day          = "TUESDAY"
     start        = 2
@@ -0,0 +1,34 @@
+resource "hcp_boundary_cluster" "example" {
+  cluster_id = "boundary-cluster"
+  username   = "test-user"
+  password   = var.boundary_password
+  tier       = "Plus"
+}
+
+resource "hcp_boundary_worker" "example" {
+  cluster_id  = hcp_boundary_cluster.example.cluster_id
+  login_name  = hcp_boundary_cluster.example.username
+  password    = hcp_boundary_cluster.example.password
+  name        = "worker-us-east-1a"
+  description = "Self-managed worker in us-east-1a"
+}
+
+# The worker configuration, to be written as the configuration file of the
+# worker, for instance with the user data of its instance.
+locals {
+  worker_config = <<-EOT
+    disable_mlock = true
+
+    hcp_boundary_cluster_id = "${hcp_boundary_worker.example.hcp_boundary_cluster_id}"
+
+    listener "tcp" {
+      address = "0.0.0.0:9202"
+      purpose = "proxy"
+    }
+
+    worker {
+      auth_storage_path                     = "/var/lib/boundary/worker"
+      controller_generated_activation_token = "${hcp_boundary_worker.example.activation_token}"
+    }
+  EOT
+}
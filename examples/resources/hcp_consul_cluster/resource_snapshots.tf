@@ -0,0 +1,22 @@
+resource "hcp_consul_cluster" "example" {
+  cluster_id = "consul-cluster"
+  hvn_id     = hcp_hvn.example.hvn_id
+  tier       = "standard"
+
+  consul_snapshot_interval  = "12h"
+  consul_snapshot_retention = "14d"
+}
+
+data "hcp_consul_snapshots" "primary" {
+  cluster_id = "consul-primary"
+}
+
+# Restores the latest snapshot of the primary cluster to the cluster, for
+# example during a disaster recovery drill.
+resource "hcp_consul_cluster" "restored" {
+  cluster_id = "consul-restored"
+  hvn_id     = hcp_hvn.example.hvn_id
+  tier       = "standard"
+
+  restore_from_snapshot_id = data.hcp_consul_snapshots.primary.snapshots[length(data.hcp_consul_snapshots.primary.snapshots) - 1].snapshot_id
+}
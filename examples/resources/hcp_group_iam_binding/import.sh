@@ -0,0 +1,2 @@
+# A Group's IAM Binding can be imported by specifying the group's resource name, the role and the principal ID separated by colons
+terraform import hcp_group_iam_binding.example existing-group:roles/iam.group-manager:example-sp-12345678
@@ -0,0 +1,4 @@
+# Log Streaming Destination can be imported by specifying the streaming destination ID
+# Note that since sensitive values such as the Splunk token or the Datadog API key
+# are never returned by the API, they must be set again after the import.
+terraform import hcp_log_streaming_destination.example 5ad6bd1b-3d30-4c6e-8b1a-c1a7b55e3f54
@@ -0,0 +1,3 @@
+# Organization IAM Binding can be imported by specifying the role and the principal ID separated by a colon.
+# The organization is determined by the provider configuration.
+terraform import hcp_organization_iam_binding.example roles/viewer:example-sp-12345678
@@ -0,0 +1,2 @@
+# Packer Bucket IAM Binding can be imported by specifying the bucket's resource name, the role and the principal ID separated by colons
+terraform import hcp_packer_bucket_iam_binding.example packer/project/f709ec73-55d4-46d8-897d-816ebba28778/bucket/alpine:roles/viewer:example-sp-12345678
@@ -0,0 +1,2 @@
+# Project IAM Binding can be imported by specifying the project ID, the role and the principal ID separated by colons
+terraform import hcp_project_iam_binding.example 840e3701-55b6-4f86-8c17-b1fe397303c5:roles/viewer:example-sp-12345678
@@ -0,0 +1,30 @@
+resource "hcp_hvn" "example" {
+  hvn_id         = "hvn"
+  cloud_provider = "aws"
+  region         = "us-west-2"
+  cidr_block     = "172.25.16.0/20"
+}
+
+resource "hcp_vault_cluster" "example" {
+  cluster_id = "vault-cluster"
+  hvn_id     = hcp_hvn.example.hvn_id
+  tier       = "standard_large"
+}
+
+resource "hcp_vault_cluster_audit_log_streaming" "example" {
+  cluster_id = hcp_vault_cluster.example.cluster_id
+
+  cloudwatch = {
+    access_key_id     = var.aws_access_key_id
+    secret_access_key = var.aws_secret_access_key
+    region            = "us-west-2"
+  }
+
+  http = {
+    uri    = "https://logs.example.com/vault"
+    method = "POST"
+    codec  = "NDJSON"
+
+    bearer_token = var.log_collector_token
+  }
+}
@@ -0,0 +1,5 @@
+# The metrics streaming of a Vault cluster can be imported by specifying the cluster ID
+terraform import hcp_vault_cluster_metrics_streaming.example vault-cluster
+
+# Or by also specifying the project ID
+terraform import hcp_vault_cluster_metrics_streaming.example 41d107a7-eea6-4b5e-8481-508ab29e2b07:vault-cluster
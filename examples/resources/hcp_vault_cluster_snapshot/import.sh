@@ -0,0 +1,5 @@
+# A Vault cluster snapshot can be imported by specifying the snapshot ID
+terraform import hcp_vault_cluster_snapshot.example 0a0e9b2c-0bd1-4e88-bd4c-3ff3bca6d6c8
+
+# Or by also specifying the project ID
+terraform import hcp_vault_cluster_snapshot.example 41d107a7-eea6-4b5e-8481-508ab29e2b07:0a0e9b2c-0bd1-4e88-bd4c-3ff3bca6d6c8
@@ -0,0 +1,17 @@
+resource "hcp_hvn" "example" {
+  hvn_id         = "hvn"
+  cloud_provider = "aws"
+  region         = "us-west-2"
+  cidr_block     = "172.25.16.0/20"
+}
+
+resource "hcp_vault_cluster" "example" {
+  cluster_id = "vault-cluster"
+  hvn_id     = hcp_hvn.example.hvn_id
+  tier       = "standard_large"
+}
+
+resource "hcp_vault_cluster_snapshot" "example" {
+  cluster_id    = hcp_vault_cluster.example.cluster_id
+  snapshot_name = "before-upgrade"
+}
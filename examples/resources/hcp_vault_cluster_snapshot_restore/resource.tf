@@ -0,0 +1,9 @@
+resource "hcp_vault_cluster_snapshot_restore" "example" {
+  cluster_id  = hcp_vault_cluster.example.cluster_id
+  snapshot_id = hcp_vault_cluster_snapshot.example.snapshot_id
+
+  # Change this value to restore the snapshot again.
+  triggers = {
+    restore = "1"
+  }
+}
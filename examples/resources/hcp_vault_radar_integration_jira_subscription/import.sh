@@ -0,0 +1,5 @@
+# Vault Radar Jira Subscription can be imported by specifying its ID
+terraform import hcp_vault_radar_integration_jira_subscription.example 0f5c4b7e9d2a41c8b36e7f1a
+
+# Or by also specifying the project ID
+terraform import hcp_vault_radar_integration_jira_subscription.example 41d107a7-eea6-4b5e-8481-508ab29e2b07:0f5c4b7e9d2a41c8b36e7f1a
@@ -0,0 +1,6 @@
+# Vault Radar Slack Connection can be imported by specifying its ID
+# Note that since tokens are never returned by the API, they must be set again after the import.
+terraform import hcp_vault_radar_integration_slack_connection.example 0f5c4b7e9d2a41c8b36e7f1a
+
+# Or by also specifying the project ID
+terraform import hcp_vault_radar_integration_slack_connection.example 41d107a7-eea6-4b5e-8481-508ab29e2b07:0f5c4b7e9d2a41c8b36e7f1a
@@ -0,0 +1,2 @@
+# Vault Radar Resource IAM Policy can be imported by specifying the resource name of the Radar resource
+terraform import hcp_vault_radar_resource_iam_policy.example vault-radar/project/41d107a7-eea6-4b5e-8481-508ab29e2b07/scan-target/0f5c4b7e9d2a41c8b36e7f1a
@@ -0,0 +1,5 @@
+# The replication group of a Vault cluster can be imported by specifying the ID of its primary cluster
+terraform import hcp_vault_replication_group.example vault-cluster-primary
+
+# Or by also specifying the project ID
+terraform import hcp_vault_replication_group.example 41d107a7-eea6-4b5e-8481-508ab29e2b07:vault-cluster-primary
@@ -0,0 +1,32 @@
+resource "hcp_hvn" "primary" {
+  hvn_id         = "hvn-primary"
+  cloud_provider = "aws"
+  region         = "us-west-2"
+  cidr_block     = "172.25.16.0/20"
+}
+
+resource "hcp_hvn" "secondary" {
+  hvn_id         = "hvn-secondary"
+  cloud_provider = "aws"
+  region         = "us-east-1"
+  cidr_block     = "172.24.16.0/20"
+}
+
+resource "hcp_vault_cluster" "primary" {
+  cluster_id = "vault-cluster-primary"
+  hvn_id     = hcp_hvn.primary.hvn_id
+  tier       = "plus_small"
+}
+
+resource "hcp_vault_replication_group" "example" {
+  primary_cluster_id = hcp_vault_cluster.primary.cluster_id
+  tier               = hcp_vault_cluster.primary.tier
+
+  secondaries = [
+    {
+      cluster_id   = "vault-cluster-secondary"
+      hvn_id       = hcp_hvn.secondary.hvn_id
+      paths_filter = ["path/a", "path/b"]
+    },
+  ]
+}
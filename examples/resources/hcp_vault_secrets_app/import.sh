@@ -0,0 +1,5 @@
+# Vault Secrets App can be imported by specifying the name of the app
+terraform import hcp_vault_secrets_app.example example-app-name
+
+# Or by also specifying the project ID
+terraform import hcp_vault_secrets_app.example 41d107a7-eea6-4b5e-8481-508ab29e2b07/example-app-name
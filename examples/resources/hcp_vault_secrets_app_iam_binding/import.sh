@@ -0,0 +1,5 @@
+# Vault Secrets App IAM Binding can be imported by specifying the app, the role and the principal ID separated by colons
+terraform import hcp_vault_secrets_app_iam_binding.example example-app-name:roles/secrets.app-secret-reader:example-sp-12345678
+
+# The app can also be specified with its project ID, or its resource name
+terraform import hcp_vault_secrets_app_iam_binding.example 41d107a7-eea6-4b5e-8481-508ab29e2b07/example-app-name:roles/secrets.app-secret-reader:example-sp-12345678
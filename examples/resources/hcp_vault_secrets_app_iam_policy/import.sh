@@ -1,2 +1,8 @@
-# Vault Secrets App IAM Policy can be imported by specifying the resource name of the app
+# Vault Secrets App IAM Policy can be imported by specifying the name of the app
+terraform import hcp_vault_secrets_app_iam_policy.example example-app-name
+
+# Or by specifying the project ID and the name of the app
+terraform import hcp_vault_secrets_app_iam_policy.example 41d107a7-eea6-4b5e-8481-508ab29e2b07/example-app-name
+
+# Or by specifying the resource name of the app
 terraform import hcp_vault_secrets_app_iam_policy.example secrets/project/41d107a7-eea6-4b5e-8481-508ab29e2b07/app/example-app-name
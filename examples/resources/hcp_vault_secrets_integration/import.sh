@@ -2,3 +2,6 @@
 # Note that since sensitive information are never returned on the Vault Secrets API,
 # the next plan or apply will show a diff for sensitive fields.
 terraform import hcp_vault_secrets_integration.example my-integration-name
+
+# Or by also specifying the project ID
+terraform import hcp_vault_secrets_integration.example 41d107a7-eea6-4b5e-8481-508ab29e2b07/my-integration-name
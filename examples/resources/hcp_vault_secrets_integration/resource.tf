@@ -80,4 +80,13 @@ resource "hcp_vault_secrets_integration" "example_twilio" {
     api_key_secret = "<api-key-secret>"
     api_key_sid    = "<api-key-sid>"
   }
-}
\ No newline at end of file
+}
+// PostgreSQL
+resource "hcp_vault_secrets_integration" "example_postgres" {
+  name          = "my-postgres-1"
+  capabilities  = ["ROTATION"]
+  provider_type = "postgres"
+  postgres_static_credentials = {
+    connection_string = "postgres://<username>:<password>@<host>:5432/<database>"
+  }
+}
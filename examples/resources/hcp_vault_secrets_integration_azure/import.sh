@@ -2,3 +2,6 @@
 # Note that since the client secret is never returned on the Vault Secrets API,
 # the next plan or apply will show a diff for that field.
 terraform import hcp_vault_secrets_integration_azure.example my-azure-1
+
+# Or by also specifying the project ID
+terraform import hcp_vault_secrets_integration_azure.example 41d107a7-eea6-4b5e-8481-508ab29e2b07/my-azure-1
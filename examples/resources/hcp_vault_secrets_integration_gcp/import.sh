@@ -2,3 +2,6 @@
 # Note that since the service account credentials are never returned on the Vault Secrets API,
 # the next plan or apply will show a diff for that field if using the service account key authentication method.
 terraform import hcp_vault_secrets_integration_gcp.example my-gcp-1
+
+# Or by also specifying the project ID
+terraform import hcp_vault_secrets_integration_gcp.example 41d107a7-eea6-4b5e-8481-508ab29e2b07/my-gcp-1
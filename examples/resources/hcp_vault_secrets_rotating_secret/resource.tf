@@ -7,6 +7,11 @@ resource "hcp_vault_secrets_rotating_secret" "example_aws" {
   aws_access_keys = {
     iam_username = "my-iam-username"
   }
+
+  # Changing any value rotates the secret immediately during apply
+  rotate_on_change = {
+    rotated_at = "2024-06-01"
+  }
 }
 
 resource "hcp_vault_secrets_rotating_secret" "example_gcp" {
@@ -64,3 +69,14 @@ resource "hcp_vault_secrets_rotating_secret" "example_azure" {
     app_client_id = "<app_client_id>"
   }
 }
+
+resource "hcp_vault_secrets_rotating_secret" "example_postgres" {
+  app_name             = "my-app-1"
+  secret_provider      = "postgres"
+  name                 = "my_postgres_1"
+  integration_name     = "my-postgres-1"
+  rotation_policy_name = "built-in:30-days-2-active"
+  postgres_user_password = {
+    usernames = ["my-user-1", "my-user-2"]
+  }
+}
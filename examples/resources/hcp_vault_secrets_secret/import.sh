@@ -0,0 +1,5 @@
+# Vault Secrets Secret can be imported by specifying the name of the app and the name of the secret
+terraform import hcp_vault_secrets_secret.example example-app-name/example_secret
+
+# Or by also specifying the project ID
+terraform import hcp_vault_secrets_secret.example 41d107a7-eea6-4b5e-8481-508ab29e2b07/example-app-name/example_secret
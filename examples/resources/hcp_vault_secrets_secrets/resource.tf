@@ -0,0 +1,21 @@
+resource "hcp_vault_secrets_app" "example" {
+  app_name    = "example-app-name"
+  description = "My new app!"
+}
+
+resource "hcp_vault_secrets_secrets" "example" {
+  app_name = hcp_vault_secrets_app.example.app_name
+  secrets = {
+    database_user     = "admin"
+    database_password = var.database_password
+  }
+}
+
+# With Terraform 1.11 or later, the values can be kept out of the state.
+resource "hcp_vault_secrets_secrets" "example_write_only" {
+  app_name = hcp_vault_secrets_app.example.app_name
+  secrets_wo = {
+    api_key = var.api_key
+  }
+  secrets_wo_version = 1
+}
@@ -1,2 +1,5 @@
-# Vault Secrets Integration can be imported by specifying the name of the integration
+# Vault Secrets Sync can be imported by specifying the name of the sync
 terraform import hcp_vault_secrets_sync.example_gitlab_project_sync gitlab-proj-sync
+
+# Or by also specifying the project ID
+terraform import hcp_vault_secrets_sync.example_gitlab_project_sync 41d107a7-eea6-4b5e-8481-508ab29e2b07/gitlab-proj-sync
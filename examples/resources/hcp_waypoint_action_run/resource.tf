@@ -0,0 +1,21 @@
+resource "hcp_waypoint_action" "example" {
+  name        = "notify-deploy"
+  description = "Notify the team about a deployment."
+  request = {
+    custom = {
+      method = "POST"
+      url    = "https://example.com/hooks/deploy"
+      body   = "{\"app\": \"${var.app_name}\", \"version\": \"${var.version}\"}"
+    }
+  }
+}
+
+resource "hcp_waypoint_action_run" "example" {
+  action_name      = hcp_waypoint_action.example.name
+  application_name = var.app_name
+
+  # Run the action again whenever a new version is deployed.
+  triggers = {
+    version = var.version
+  }
+}
@@ -0,0 +1,3 @@
+# Waypoint TFC Config can be imported by specifying the project ID
+# Note that since the token is never returned by the API, it must be set again after the import.
+terraform import hcp_waypoint_tfc_config.example 41d107a7-eea6-4b5e-8481-508ab29e2b07
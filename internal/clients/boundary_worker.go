@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// boundaryGlobalScopeID is the ID of the global scope of a Boundary cluster,
+// where the workers and the auth method of the initial admin user are.
+const boundaryGlobalScopeID = "global"
+
+// The HCP SDK does not manage the resources within a Boundary cluster, so the
+// workers of a cluster are managed through the API of the cluster itself.
+// BoundaryAPIClient calls it with the same OpenAPI runtime, logging, errors
+// and retries as the SDK clients, but authenticates with a Boundary auth token
+// rather than HCP credentials.
+
+// BoundaryWorker is a worker of a Boundary cluster, as returned by the API of
+// the cluster.
+type BoundaryWorker struct {
+	ID                                 string `json:"id"`
+	ScopeID                            string `json:"scope_id"`
+	Name                               string `json:"name"`
+	Description                        string `json:"description"`
+	Version                            uint32 `json:"version"`
+	Type                               string `json:"type"`
+	Address                            string `json:"address"`
+	ReleaseVersion                     string `json:"release_version"`
+	ControllerGeneratedActivationToken string `json:"controller_generated_activation_token"`
+}
+
+// BoundaryAPIClient is an authenticated client of the API of a Boundary
+// cluster.
+type BoundaryAPIClient struct {
+	transport *httptransport.Runtime
+	schemes   []string
+	authInfo  runtime.ClientAuthInfoWriter
+}
+
+// NewBoundaryAPIClient authenticates to the API of a Boundary cluster with the
+// password of a user. If no auth method is given, the primary password auth
+// method of the global scope is used, which is the auth method of the initial
+// admin user of HCP Boundary clusters.
+func NewBoundaryAPIClient(ctx context.Context, clusterURL, authMethodID, loginName, password string) (*BoundaryAPIClient, error) {
+	u, err := url.Parse(clusterURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid Boundary cluster URL %q", clusterURL)
+	}
+
+	schemes := []string{u.Scheme}
+	transport := httptransport.New(u.Host, u.Path, schemes)
+	transport.SetLogger(logger{})
+	transport.SetDebug(ShouldLog())
+	c := &BoundaryAPIClient{transport: transport, schemes: schemes}
+
+	if authMethodID == "" {
+		authMethodID, err = c.primaryPasswordAuthMethodID(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var authResp struct {
+		Attributes struct {
+			Token string `json:"token"`
+		} `json:"attributes"`
+	}
+	err = c.submit(ctx, "AuthMethodsAuthenticate", http.MethodPost, "/v1/auth-methods/{id}:authenticate", map[string]string{"id": authMethodID}, nil, map[string]any{
+		"command": "login",
+		"attributes": map[string]string{
+			"login_name": loginName,
+			"password":   password,
+		},
+	}, &authResp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to authenticate to Boundary cluster with auth method (%s): %w", authMethodID, err)
+	}
+	if authResp.Attributes.Token == "" {
+		return nil, fmt.Errorf("unable to authenticate to Boundary cluster with auth method (%s): no token returned", authMethodID)
+	}
+
+	c.authInfo = httptransport.BearerToken(authResp.Attributes.Token)
+	return c, nil
+}
+
+// primaryPasswordAuthMethodID returns the ID of the primary password auth
+// method of the global scope, or of its first password auth method if none
+// is primary. Listing them does not require authentication.
+func (c *BoundaryAPIClient) primaryPasswordAuthMethodID(ctx context.Context) (string, error) {
+	var listResp struct {
+		Items []struct {
+			ID        string `json:"id"`
+			Type      string `json:"type"`
+			IsPrimary bool   `json:"is_primary"`
+		} `json:"items"`
+	}
+	query := map[string]string{"scope_id": boundaryGlobalScopeID}
+	if err := c.submit(ctx, "AuthMethodsList", http.MethodGet, "/v1/auth-methods", nil, query, nil, &listResp); err != nil {
+		return "", fmt.Errorf("unable to list auth methods of Boundary cluster: %w", err)
+	}
+
+	id := ""
+	for _, authMethod := range listResp.Items {
+		if authMethod.Type != "password" {
+			continue
+		}
+		if authMethod.IsPrimary {
+			return authMethod.ID, nil
+		}
+		if id == "" {
+			id = authMethod.ID
+		}
+	}
+	if id == "" {
+		return "", fmt.Errorf("no password auth method found in the global scope of the Boundary cluster")
+	}
+	return id, nil
+}
+
+// CreateControllerLedBoundaryWorker registers a self-managed worker in the
+// global scope of a Boundary cluster. The returned worker has the activation
+// token the worker uses to authenticate to the cluster the first time it
+// connects.
+func (c *BoundaryAPIClient) CreateControllerLedBoundaryWorker(ctx context.Context, name, description string) (*BoundaryWorker, error) {
+	body := map[string]any{"scope_id": boundaryGlobalScopeID}
+	if name != "" {
+		body["name"] = name
+	}
+	if description != "" {
+		body["description"] = description
+	}
+
+	var worker BoundaryWorker
+	if err := c.submit(ctx, "WorkersCreateControllerLed", http.MethodPost, "/v1/workers:create:controller-led", nil, nil, body, &worker); err != nil {
+		return nil, err
+	}
+	return &worker, nil
+}
+
+// GetBoundaryWorker gets a worker of a Boundary cluster by its ID.
+func (c *BoundaryAPIClient) GetBoundaryWorker(ctx context.Context, workerID string) (*BoundaryWorker, error) {
+	var worker BoundaryWorker
+	if err := c.submit(ctx, "WorkersGet", http.MethodGet, "/v1/workers/{id}", map[string]string{"id": workerID}, nil, nil, &worker); err != nil {
+		return nil, err
+	}
+	return &worker, nil
+}
+
+// UpdateBoundaryWorker updates the name and description of a worker of a
+// Boundary cluster. The version is the current version of the worker, which
+// the API uses to reject concurrent updates.
+func (c *BoundaryAPIClient) UpdateBoundaryWorker(ctx context.Context, workerID string, version uint32, name, description string) (*BoundaryWorker, error) {
+	// Null values clear the fields.
+	body := map[string]any{"version": version, "name": nil, "description": nil}
+	if name != "" {
+		body["name"] = name
+	}
+	if description != "" {
+		body["description"] = description
+	}
+
+	var worker BoundaryWorker
+	if err := c.submit(ctx, "WorkersUpdate", http.MethodPatch, "/v1/workers/{id}", map[string]string{"id": workerID}, nil, body, &worker); err != nil {
+		return nil, err
+	}
+	return &worker, nil
+}
+
+// DeleteBoundaryWorker deletes a worker of a Boundary cluster by its ID.
+func (c *BoundaryAPIClient) DeleteBoundaryWorker(ctx context.Context, workerID string) error {
+	return c.submit(ctx, "WorkersDelete", http.MethodDelete, "/v1/workers/{id}", map[string]string{"id": workerID}, nil, nil, nil)
+}
+
+// submit sends a request to the API of the Boundary cluster, and decodes the
+// response into out if it is not nil. Errors are returned as
+// *runtime.APIError, like the errors of the SDK clients, and requests failing
+// with a gateway error are retried.
+func (c *BoundaryAPIClient) submit(ctx context.Context, id, method, pathPattern string, pathParams, query map[string]string, in, out any) error {
+	op := func() error {
+		_, err := c.transport.SubmitContext(ctx, &runtime.ClientOperation{
+			ID:                 id,
+			Method:             method,
+			PathPattern:        pathPattern,
+			ProducesMediaTypes: []string{runtime.JSONMime},
+			ConsumesMediaTypes: []string{runtime.JSONMime},
+			Schemes:            c.schemes,
+			AuthInfo:           c.authInfo,
+			Params: runtime.ClientRequestWriterFunc(func(req runtime.ClientRequest, _ strfmt.Registry) error {
+				for k, v := range pathParams {
+					if err := req.SetPathParam(k, v); err != nil {
+						return err
+					}
+				}
+				for k, v := range query {
+					if err := req.SetQueryParam(k, v); err != nil {
+						return err
+					}
+				}
+				if in != nil {
+					return req.SetBodyParam(in)
+				}
+				return nil
+			}),
+			Reader: runtime.ClientResponseReaderFunc(func(resp runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+				if resp.Code() >= http.StatusBadRequest {
+					var payload struct {
+						Kind    string `json:"kind"`
+						Message string `json:"message"`
+					}
+					// The error is returned with its status code even if its
+					// body cannot be decoded.
+					_ = consumer.Consume(resp.Body(), &payload)
+					return nil, runtime.NewAPIError(fmt.Sprintf("[%s %s][%d] %s", method, pathPattern, resp.Code(),
+						strings.TrimSpace(payload.Kind+" "+payload.Message)), payload, resp.Code())
+				}
+				if out == nil || resp.Code() == http.StatusNoContent {
+					return nil, nil
+				}
+				return nil, consumer.Consume(resp.Body(), out)
+			}),
+		})
+		if err == nil {
+			return nil
+		}
+
+		if apiErr, ok := err.(*runtime.APIError); ok && shouldRetryErrorCode(apiErr.Code, errorCodesToRetry[:]) {
+			return err
+		}
+		return backoff.Permanent(err)
+	}
+
+	return backoff.Retry(op, backoff.WithContext(newBackoff(), ctx))
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// testBoundaryServer returns a fake Boundary cluster API with a single
+// password auth method and a single worker.
+func testBoundaryServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/auth-methods", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("scope_id") != "global" {
+			t.Errorf("scope_id = %q; want global", r.URL.Query().Get("scope_id"))
+		}
+		_, _ = w.Write([]byte(`{"items": [
+			{"id": "amoidc_1234567890", "type": "oidc", "is_primary": true},
+			{"id": "ampw_1234567890", "type": "password"}
+		]}`))
+	})
+	mux.HandleFunc("POST /v1/auth-methods/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Command    string            `json:"command"`
+			Attributes map[string]string `json:"attributes"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unable to decode request: %v", err)
+		}
+		if r.PathValue("id") != "ampw_1234567890:authenticate" || body.Command != "login" ||
+			body.Attributes["login_name"] != "admin" || body.Attributes["password"] != "password123" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"kind": "Unauthenticated", "message": "Unauthenticated, or invalid token."}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"command": "login", "attributes": {"token": "at_token"}}`))
+	})
+	authorized := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer at_token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			next(w, r)
+		}
+	}
+	mux.HandleFunc("POST /v1/workers:create:controller-led", authorized(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unable to decode request: %v", err)
+		}
+		if body["scope_id"] != "global" || body["name"] != "worker" {
+			t.Errorf("request = %v; want the global scope and name worker", body)
+		}
+		_, _ = w.Write([]byte(`{"id": "w_1234567890", "scope_id": "global", "name": "worker", "version": 1,
+			"type": "pki", "controller_generated_activation_token": "neslat_token"}`))
+	}))
+	mux.HandleFunc("PATCH /v1/workers/w_1234567890", authorized(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unable to decode request: %v", err)
+		}
+		if body["version"] != float64(1) || body["name"] != "renamed" || body["description"] != nil {
+			t.Errorf("request = %v; want version 1, name renamed and a null description", body)
+		}
+		_, _ = w.Write([]byte(`{"id": "w_1234567890", "scope_id": "global", "name": "renamed", "version": 2, "type": "pki"}`))
+	}))
+	mux.HandleFunc("GET /v1/workers/{id}", authorized(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"kind": "NotFound", "message": "Resource not found."}`))
+	}))
+	mux.HandleFunc("DELETE /v1/workers/w_1234567890", authorized(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestBoundaryAPIClient(t *testing.T) {
+	ctx := context.Background()
+	server := testBoundaryServer(t)
+
+	if _, err := NewBoundaryAPIClient(ctx, server.URL, "", "admin", "wrong-password"); err == nil {
+		t.Fatalf("NewBoundaryAPIClient() with an invalid password succeeded")
+	}
+
+	client, err := NewBoundaryAPIClient(ctx, server.URL+"/", "", "admin", "password123")
+	if err != nil {
+		t.Fatalf("NewBoundaryAPIClient() error = %v", err)
+	}
+
+	worker, err := client.CreateControllerLedBoundaryWorker(ctx, "worker", "")
+	if err != nil {
+		t.Fatalf("CreateControllerLedBoundaryWorker() error = %v", err)
+	}
+	if worker.ID != "w_1234567890" || worker.ControllerGeneratedActivationToken != "neslat_token" {
+		t.Errorf("worker = %+v; want ID w_1234567890 and an activation token", worker)
+	}
+
+	worker, err = client.UpdateBoundaryWorker(ctx, worker.ID, worker.Version, "renamed", "")
+	if err != nil {
+		t.Fatalf("UpdateBoundaryWorker() error = %v", err)
+	}
+	if worker.Name != "renamed" || worker.Version != 2 {
+		t.Errorf("worker = %+v; want name renamed and version 2", worker)
+	}
+
+	if err := client.DeleteBoundaryWorker(ctx, worker.ID); err != nil {
+		t.Fatalf("DeleteBoundaryWorker() error = %v", err)
+	}
+
+	_, err = client.GetBoundaryWorker(ctx, worker.ID)
+	if !IsResponseCodeNotFound(err) {
+		t.Errorf("GetBoundaryWorker() error = %v; want a not found error", err)
+	}
+}
@@ -90,3 +90,60 @@ func RenameSnapshotByID(ctx context.Context, client *Client, loc *sharedmodels.H
 
 	return resp.Payload, nil
 }
+
+// ListSnapshots lists the snapshots of a Consul cluster.
+func ListSnapshots(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation,
+	clusterID string) ([]*consulmodels.HashicorpCloudConsul20210204Snapshot, error) {
+
+	resourceType := "hashicorp.consul.cluster"
+	p := consul_service.NewListSnapshotsParams()
+	p.Context = ctx
+	p.ResourceLocationOrganizationID = loc.OrganizationID
+	p.ResourceLocationProjectID = loc.ProjectID
+	p.ResourceID = &clusterID
+	p.ResourceType = &resourceType
+
+	var snapshots []*consulmodels.HashicorpCloudConsul20210204Snapshot
+	for {
+		resp, err := client.Consul.ListSnapshots(p, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshots = append(snapshots, resp.Payload.Snapshots...)
+
+		pagination := resp.Payload.Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return snapshots, nil
+		}
+		p.PaginationNextPageToken = &pagination.NextPageToken
+	}
+}
+
+// RestoreSnapshot will make a call to the Consul service to restore a
+// snapshot to a Consul cluster. The snapshot may have been taken of another
+// cluster, and the data of the cluster is replaced by the data of the
+// snapshot.
+func RestoreSnapshot(ctx context.Context, client *Client, cluster *consulmodels.HashicorpCloudConsul20210204Cluster,
+	snapshot *sharedmodels.HashicorpCloudLocationLink) (*consulmodels.HashicorpCloudConsul20210204RestoreSnapshotResponse, error) {
+
+	p := consul_service.NewRestoreSnapshotParams()
+	p.Context = ctx
+	p.ClusterID = cluster.ID
+	p.LocationOrganizationID = cluster.Location.OrganizationID
+	p.LocationProjectID = cluster.Location.ProjectID
+	p.Body = &consulmodels.HashicorpCloudConsul20210204RestoreSnapshotRequest{
+		// ClusterID and Location are repeated because the values above are required to populate the URL,
+		// and the values below are required in the API request body
+		ClusterID: cluster.ID,
+		Location:  cluster.Location,
+		Snapshot:  snapshot,
+	}
+
+	resp, err := client.Consul.RestoreSnapshot(p, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload, nil
+}
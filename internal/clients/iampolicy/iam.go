@@ -41,6 +41,54 @@ type TerraformResourceData interface {
 // Factory for generating ResourceIamUpdater for given ResourceData resource
 type NewResourceIamUpdaterFunc func(ctx context.Context, d TerraformResourceData, clients *clients.Client) (ResourceIamUpdater, diag.Diagnostics)
 
+// ImportIDFunc converts the ID given to `terraform import` into the value of
+// the import attribute, for example to expand a name into a resource name.
+type ImportIDFunc func(ctx context.Context, id string, client *clients.Client) (string, diag.Diagnostics)
+
+// ResourceOption configures the optional behavior of the IAM Policy and
+// Binding resources.
+type ResourceOption func(*resourceOptions)
+
+type resourceOptions struct {
+	importIDFunc      ImportIDFunc
+	importAttrDefault func(client *clients.Client) string
+}
+
+// WithImportIDFunc sets the function converting the ID given to
+// `terraform import` into the value of the import attribute. By default, the
+// ID is used as is.
+func WithImportIDFunc(f ImportIDFunc) ResourceOption {
+	return func(o *resourceOptions) {
+		o.importIDFunc = f
+	}
+}
+
+// WithImportAttrDefault sets the function returning the value used by the
+// provider when the import attribute is not configured, such as the project
+// configured on the provider. It is used to identify resources whose import
+// attribute is not set in the state.
+func WithImportAttrDefault(f func(client *clients.Client) string) ResourceOption {
+	return func(o *resourceOptions) {
+		o.importAttrDefault = f
+	}
+}
+
+func newResourceOptions(opts []ResourceOption) resourceOptions {
+	var o resourceOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// importAttrValue returns the value of the import attribute for the given ID.
+func (o resourceOptions) importAttrValue(ctx context.Context, id string, client *clients.Client) (string, diag.Diagnostics) {
+	if o.importIDFunc == nil {
+		return id, nil
+	}
+	return o.importIDFunc(ctx, id, client)
+}
+
 // Equal returns if the passed Policies are equal.
 func Equal(p1, p2 *models.HashicorpCloudResourcemanagerPolicy) bool {
 	if p1 == nil && p2 == nil {
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+)
+
+// organizationIDAttr is the identity attribute of the resources without an
+// import attribute, which manage the IAM policy of the organization configured
+// on the provider.
+const organizationIDAttr = "organization_id"
+
+// attributePart returns an import ID part for the given attribute, validated
+// by the validators of the attribute.
+func attributePart(attributes map[string]schema.Attribute, name string) hcpvalidator.ImportIDPart {
+	part := hcpvalidator.ImportIDPart{Name: name}
+	if attr, ok := attributes[name].(schema.StringAttribute); ok {
+		part.Validators = attr.Validators
+	}
+	return part
+}
+
+// scopePart returns the part of the import ID and identity referencing the
+// resource the IAM policy is attached to: the import attribute, or the
+// organization ID if there is none.
+func scopePart(parentSchema schema.Schema, importAttrName string) hcpvalidator.ImportIDPart {
+	if importAttrName == "" {
+		return hcpvalidator.ImportIDPart{
+			Name:       organizationIDAttr,
+			Validators: []validator.String{hcpvalidator.UUID()},
+		}
+	}
+	return attributePart(parentSchema.Attributes, importAttrName)
+}
+
+// importScope sets the import attribute in the state from its imported value.
+// The value is converted by the import ID function of the resource, then
+// validated by the validators of the attribute. Resources without an import
+// attribute only accept the organization configured on the provider.
+func importScope(ctx context.Context, client *clients.Client, importAttrName string, part hcpvalidator.ImportIDPart,
+	options resourceOptions, value string, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if importAttrName == "" {
+		if value != "" && value != client.Config.OrganizationID {
+			diags.AddError(
+				"Invalid import ID",
+				fmt.Sprintf("The organization %q is not the organization configured on the provider: %q", value, client.Config.OrganizationID),
+			)
+		}
+		return diags
+	}
+
+	value, diags = options.importAttrValue(ctx, value, client)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(hcpvalidator.ValidateImportIDPart(ctx, part, value)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root(importAttrName), value)...)
+	return diags
+}
+
+// setIdentity sets the identity of an IAM resource from its state. The
+// organization ID and unset import attributes are taken from the provider
+// configuration.
+func setIdentity(ctx context.Context, client *clients.Client, importAttrName string, options resourceOptions,
+	state tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+
+	for name := range identity.Schema.GetAttributes() {
+		if name == organizationIDAttr && importAttrName == "" {
+			diags.Append(identity.SetAttribute(ctx, path.Root(name), client.Config.OrganizationID)...)
+			continue
+		}
+
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...)
+		if value.IsNull() && name == importAttrName && options.importAttrDefault != nil {
+			value = types.StringValue(options.importAttrDefault(client))
+		}
+		diags.Append(identity.SetAttribute(ctx, path.Root(name), value)...)
+	}
+
+	return diags
+}
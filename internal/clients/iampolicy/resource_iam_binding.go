@@ -19,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 )
 
 var (
@@ -57,25 +59,36 @@ var (
 // importAttrName allows specifying the attribute to be set when a user runs
 // `terraform import`. Subsequent calls to SetResourceIamPolicy can use this
 // information to populate the policy.
+//
+// opts allows customizing the optional behavior of the resource, such as the
+// format of the import ID.
 func NewResourceIamBinding(
 	typeName string,
 	parentSpecificSchema schema.Schema,
 	importAttrName string,
 	newUpdaterFunc NewResourceIamUpdaterFunc,
+	opts ...ResourceOption,
 ) resource.Resource {
 	return &resourceBinding{
 		parentSchema:   parentSpecificSchema,
 		typeName:       typeName,
 		importAttrName: importAttrName,
 		updaterFunc:    newUpdaterFunc,
+		options:        newResourceOptions(opts),
 	}
 }
 
+var (
+	_ resource.ResourceWithImportState = &resourceBinding{}
+	_ resource.ResourceWithIdentity    = &resourceBinding{}
+)
+
 type resourceBinding struct {
 	parentSchema   schema.Schema
 	typeName       string
 	importAttrName string
 	updaterFunc    NewResourceIamUpdaterFunc
+	options        resourceOptions
 	client         *clients.Client
 }
 
@@ -113,6 +126,56 @@ func (r *resourceBinding) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+// identityParts returns the parts of the identity of the binding: the scope of
+// the policy, the role and the principal ID.
+func (r *resourceBinding) identityParts() []hcpvalidator.ImportIDPart {
+	return []hcpvalidator.ImportIDPart{
+		scopePart(r.parentSchema, r.importAttrName),
+		attributePart(baseBindingSchema, "role"),
+		attributePart(baseBindingSchema, "principal_id"),
+	}
+}
+
+// IdentitySchema returns the identity of the binding, made of the scope of the
+// policy, the role and the principal ID.
+func (r *resourceBinding) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(r.identityParts()...)
+}
+
+// ImportState imports a binding from an ID in the format
+// <resource>:<role>:<principal_id>, where <resource> is the value of the import
+// attribute, or from its identity. Resources without an import attribute use
+// <role>:<principal_id>.
+func (r *resourceBinding) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := r.identityParts()
+	scope := parts[0]
+
+	// The scope is validated once converted into the value of the import
+	// attribute.
+	parts[0] = hcpvalidator.ImportIDPart{Name: scope.Name}
+	if r.importAttrName == "" && !identity.ImportedWithIdentity(req) {
+		parts = parts[1:]
+	}
+
+	values, diags := identity.ImportID(ctx, req, parts...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var scopeValue string
+	if len(values) == 3 {
+		scopeValue = values[0]
+	}
+	resp.Diagnostics.Append(importScope(ctx, r.client, r.importAttrName, scope, r.options, scopeValue, &resp.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), values[len(values)-2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_id"), values[len(values)-1])...)
+}
+
 func getBinding(ctx context.Context, d TerraformResourceData) (*models.HashicorpCloudResourcemanagerPolicyBinding, diag.Diagnostics) {
 	var p, role types.String
 	diags := d.GetAttribute(ctx, path.Root("principal_id"), &p)
@@ -160,9 +223,15 @@ func (r *resourceBinding) Create(ctx context.Context, req resource.CreateRequest
 
 	// Copy the existing state.
 	resp.State.Raw = req.Plan.Raw
+	resp.Diagnostics.Append(setIdentity(ctx, r.client, r.importAttrName, r.options, resp.State, resp.Identity)...)
 }
 
 func (r *resourceBinding) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(setIdentity(ctx, r.client, r.importAttrName, r.options, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updater, diags := r.updaterFunc(ctx, &req.State, r.client)
 	if diags.HasError() {
 		resp.Diagnostics.AddError(
@@ -237,6 +306,7 @@ func (r *resourceBinding) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Copy the existing state.
 	resp.State.Raw = req.Plan.Raw
+	resp.Diagnostics.Append(setIdentity(ctx, r.client, r.importAttrName, r.options, resp.State, resp.Identity)...)
 }
 
 func (r *resourceBinding) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
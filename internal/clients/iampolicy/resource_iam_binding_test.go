@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceIamBinding_ImportState(t *testing.T) {
+	expandName := WithImportIDFunc(func(_ context.Context, id string, _ *clients.Client) (string, diag.Diagnostics) {
+		return "resource/" + id, nil
+	})
+
+	cases := []struct {
+		name             string
+		importAttrName   string
+		opts             []ResourceOption
+		id               string
+		expectedResource string
+		expectedRole     string
+		expectedMember   string
+		expectedError    string
+	}{
+		{
+			name:             "With import attribute",
+			importAttrName:   "resource_name",
+			id:               "resource/my-app:roles/viewer:principal-1",
+			expectedResource: "resource/my-app",
+			expectedRole:     "roles/viewer",
+			expectedMember:   "principal-1",
+		},
+		{
+			name:             "With import ID function",
+			importAttrName:   "resource_name",
+			opts:             []ResourceOption{expandName},
+			id:               "my-app:roles/viewer:principal-1",
+			expectedResource: "resource/my-app",
+			expectedRole:     "roles/viewer",
+			expectedMember:   "principal-1",
+		},
+		{
+			name:           "Without import attribute",
+			id:             "roles/admin:principal-1",
+			expectedRole:   "roles/admin",
+			expectedMember: "principal-1",
+		},
+		{
+			name:           "Missing principal",
+			importAttrName: "resource_name",
+			id:             "resource/my-app:roles/viewer",
+			expectedError:  "Expected an import ID in the format {resource_name}:{role}:{principal_id}",
+		},
+		{
+			name:          "Empty role",
+			id:            ":principal-1",
+			expectedError: "Expected an import ID in the format {role}:{principal_id}",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			parentSchema := schema.Schema{}
+			if tc.importAttrName != "" {
+				parentSchema.Attributes = map[string]schema.Attribute{
+					tc.importAttrName: schema.StringAttribute{Required: true},
+				}
+			}
+			r := NewResourceIamBinding("test", parentSchema, tc.importAttrName, nil, tc.opts...).(*resourceBinding)
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tc.id}, resp)
+
+			if tc.expectedError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				require.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.expectedError)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var role, member types.String
+			resp.State.GetAttribute(ctx, path.Root("role"), &role)
+			resp.State.GetAttribute(ctx, path.Root("principal_id"), &member)
+			require.Equal(t, tc.expectedRole, role.ValueString())
+			require.Equal(t, tc.expectedMember, member.ValueString())
+
+			if tc.importAttrName != "" {
+				var resourceName types.String
+				resp.State.GetAttribute(ctx, path.Root(tc.importAttrName), &resourceName)
+				require.Equal(t, tc.expectedResource, resourceName.ValueString())
+			}
+		})
+	}
+}
+
+func TestResourceIamBinding_ImportStateIdentity(t *testing.T) {
+	const orgID = "b4ac9d8a-7f2e-4f5b-9c0d-2d3e4f5a6b7c"
+	client := &clients.Client{Config: clients.ClientConfig{OrganizationID: orgID}}
+
+	cases := []struct {
+		name           string
+		importAttrName string
+		identity       map[string]tftypes.Value
+		expectedError  string
+	}{
+		{
+			name:           "With import attribute",
+			importAttrName: "resource_name",
+			identity: map[string]tftypes.Value{
+				"resource_name": tftypes.NewValue(tftypes.String, "resource/my-app"),
+				"role":          tftypes.NewValue(tftypes.String, "roles/viewer"),
+				"principal_id":  tftypes.NewValue(tftypes.String, "principal-1"),
+			},
+		},
+		{
+			name: "Organization",
+			identity: map[string]tftypes.Value{
+				"organization_id": tftypes.NewValue(tftypes.String, orgID),
+				"role":            tftypes.NewValue(tftypes.String, "roles/viewer"),
+				"principal_id":    tftypes.NewValue(tftypes.String, "principal-1"),
+			},
+		},
+		{
+			name: "Other organization",
+			identity: map[string]tftypes.Value{
+				"organization_id": tftypes.NewValue(tftypes.String, "0a9e0b5c-2f3d-4c6e-8a1b-9d8c7b6a5f4e"),
+				"role":            tftypes.NewValue(tftypes.String, "roles/viewer"),
+				"principal_id":    tftypes.NewValue(tftypes.String, "principal-1"),
+			},
+			expectedError: "is not the organization configured on the provider",
+		},
+		{
+			name:           "Invalid role",
+			importAttrName: "resource_name",
+			identity: map[string]tftypes.Value{
+				"resource_name": tftypes.NewValue(tftypes.String, "resource/my-app"),
+				"role":          tftypes.NewValue(tftypes.String, "viewer"),
+				"principal_id":  tftypes.NewValue(tftypes.String, "principal-1"),
+			},
+			expectedError: "must reference a role name",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			parentSchema := schema.Schema{}
+			if tc.importAttrName != "" {
+				parentSchema.Attributes = map[string]schema.Attribute{
+					tc.importAttrName: schema.StringAttribute{Required: true},
+				}
+			}
+			r := NewResourceIamBinding("test", parentSchema, tc.importAttrName, nil).(*resourceBinding)
+			r.client = client
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			var identityResp resource.IdentitySchemaResponse
+			r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+			req := resource.ImportStateRequest{
+				Identity: &tfsdk.ResourceIdentity{
+					Schema: identityResp.IdentitySchema,
+					Raw:    tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(ctx), tc.identity),
+				},
+			}
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.ImportState(ctx, req, resp)
+
+			if tc.expectedError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				require.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.expectedError)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var role, member types.String
+			resp.State.GetAttribute(ctx, path.Root("role"), &role)
+			resp.State.GetAttribute(ctx, path.Root("principal_id"), &member)
+			require.Equal(t, "roles/viewer", role.ValueString())
+			require.Equal(t, "principal-1", member.ValueString())
+		})
+	}
+}
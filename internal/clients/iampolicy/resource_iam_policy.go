@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 )
 
 var (
@@ -50,25 +51,36 @@ var (
 // importAttrName allows specifying the attribute to be set when a user runs
 // `terraform import`. Subsequent calls to SetResourceIamPolicy can use this
 // information to populate the policy.
+//
+// opts allows customizing the optional behavior of the resource, such as the
+// format of the import ID.
 func NewResourceIamPolicy(
 	typeName string,
 	parentSpecificSchema schema.Schema,
 	importAttrName string,
 	newUpdaterFunc NewResourceIamUpdaterFunc,
+	opts ...ResourceOption,
 ) resource.Resource {
 	return &resourcePolicy{
 		parentSchema:   parentSpecificSchema,
 		typeName:       typeName,
 		importAttrName: importAttrName,
 		updaterFunc:    newUpdaterFunc,
+		options:        newResourceOptions(opts),
 	}
 }
 
+var (
+	_ resource.ResourceWithImportState = &resourcePolicy{}
+	_ resource.ResourceWithIdentity    = &resourcePolicy{}
+)
+
 type resourcePolicy struct {
 	parentSchema   schema.Schema
 	typeName       string
 	importAttrName string
 	updaterFunc    NewResourceIamUpdaterFunc
+	options        resourceOptions
 	client         *clients.Client
 }
 
@@ -76,6 +88,12 @@ func (r *resourcePolicy) Metadata(_ context.Context, req resource.MetadataReques
 	resp.TypeName = fmt.Sprintf("%s_%s_iam_policy", req.ProviderTypeName, r.typeName)
 }
 
+// IdentitySchema returns the identity of the policy, which is the import
+// attribute, or the organization ID for resources without one.
+func (r *resourcePolicy) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(scopePart(r.parentSchema, r.importAttrName))
+}
+
 func (r *resourcePolicy) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -134,9 +152,15 @@ func (r *resourcePolicy) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 	resp.State.Raw = req.Plan.Raw
+	resp.Diagnostics.Append(setIdentity(ctx, r.client, r.importAttrName, r.options, resp.State, resp.Identity)...)
 }
 
 func (r *resourcePolicy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(setIdentity(ctx, r.client, r.importAttrName, r.options, req.State, resp.Identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updater, diags := r.updaterFunc(ctx, &req.State, r.client)
 	if diags.HasError() {
 		resp.Diagnostics.AddError(
@@ -168,6 +192,7 @@ func (r *resourcePolicy) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 	resp.State.Raw = req.Plan.Raw
+	resp.Diagnostics.Append(setIdentity(ctx, r.client, r.importAttrName, r.options, resp.State, resp.Identity)...)
 }
 
 func (r *resourcePolicy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -195,10 +220,28 @@ func (r *resourcePolicy) Delete(ctx context.Context, req resource.DeleteRequest,
 	resp.Diagnostics.Append(storeIamPolicyData(ctx, &resp.State, newPolicy)...)
 }
 
+// ImportState imports a policy from the value of the import attribute, or
+// from its identity. Resources without an import attribute import the policy
+// of the organization configured on the provider.
 func (r *resourcePolicy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	if r.importAttrName != "" {
-		resource.ImportStatePassthroughID(ctx, path.Root(r.importAttrName), req, resp)
-	} else {
+	scope := scopePart(r.parentSchema, r.importAttrName)
+
+	value := req.ID
+	if identity.ImportedWithIdentity(req) {
+		values, diags := identity.ImportID(ctx, req, scope)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		value = values[0]
+	}
+
+	resp.Diagnostics.Append(importScope(ctx, r.client, r.importAttrName, scope, r.options, value, &resp.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.importAttrName == "" {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("etag"), "")...)
 	}
 }
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package packerv2
+
+import (
+	packerservice "github.com/hashicorp/hcp-sdk-go/clients/cloud-packer-service/stable/2023-01-01/client/packer_service"
+	packermodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-packer-service/stable/2023-01-01/models"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/utils/location"
+)
+
+type BucketAncestry = packermodels.HashicorpCloudPacker20230101BucketAncestry
+
+// ListVersionParents queries the HCP Packer registry for the parent versions
+// that were used to build the given version.
+func ListVersionParents(client *clients.Client, location location.VersionLocation) ([]*BucketAncestry, error) {
+	ancestryType := string(packermodels.HashicorpCloudPacker20230101BucketAncestryTypeANCESTRYTYPEPARENTS)
+	fingerprint := location.GetVersionFingerprint()
+
+	nextPage := ""
+	var relations []*BucketAncestry
+
+	for {
+		params := packerservice.NewPackerServiceListBucketAncestryParams()
+		params.SetLocationOrganizationID(location.GetOrganizationID())
+		params.SetLocationProjectID(location.GetProjectID())
+		params.SetBucketName(location.GetBucketName())
+		params.SetVersionFingerprint(&fingerprint)
+		params.SetType(&ancestryType)
+		if nextPage != "" {
+			params.SetPaginationNextPageToken(&nextPage)
+		}
+
+		resp, err := client.PackerV2.PackerServiceListBucketAncestry(params, nil)
+		if err != nil {
+			return nil, formatGRPCError[*packerservice.PackerServiceListBucketAncestryDefault](err)
+		}
+
+		relations = append(relations, resp.GetPayload().Relations...)
+		pagination := resp.GetPayload().Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return relations, nil
+		}
+
+		nextPage = pagination.NextPageToken
+	}
+}
@@ -3,6 +3,57 @@
 
 package packerv2
 
-import packermodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-packer-service/stable/2023-01-01/models"
+import (
+	packerservice "github.com/hashicorp/hcp-sdk-go/clients/cloud-packer-service/stable/2023-01-01/client/packer_service"
+	packermodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-packer-service/stable/2023-01-01/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/utils/location"
+)
 
 type Build = packermodels.HashicorpCloudPacker20230101Build
+
+// ListBuilds queries the HCP Packer registry for all builds of the given
+// version, following pagination until every page has been read.
+func ListBuilds(client *clients.Client, location location.VersionLocation) ([]*Build, error) {
+	nextPage := ""
+	var builds []*Build
+
+	for {
+		params := packerservice.NewPackerServiceListBuildsParams()
+		params.SetLocationOrganizationID(location.GetOrganizationID())
+		params.SetLocationProjectID(location.GetProjectID())
+		params.SetBucketName(location.GetBucketName())
+		params.SetFingerprint(location.GetVersionFingerprint())
+		if nextPage != "" {
+			params.SetPaginationNextPageToken(&nextPage)
+		}
+
+		resp, err := client.PackerV2.PackerServiceListBuilds(params, nil)
+		if err != nil {
+			return nil, formatGRPCError[*packerservice.PackerServiceListBuildsDefault](err)
+		}
+
+		builds = append(builds, resp.GetPayload().Builds...)
+		pagination := resp.GetPayload().Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return builds, nil
+		}
+
+		nextPage = pagination.NextPageToken
+	}
+}
+
+func ListBuildsDiags(client *clients.Client, location location.VersionLocation) ([]*Build, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	builds, err := ListBuilds(client, location)
+	if err != nil {
+		diags.AddError(
+			"failed to list Builds, received an error from the HCP Packer API",
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	return builds, diags
+}
@@ -91,6 +91,50 @@ func GetVersionByChannelNameDiags(client *clients.Client, location location.Buck
 	return version, nil
 }
 
+// ListVersions queries the HCP Packer registry for all versions in the given
+// bucket, following pagination until every page has been read.
+func ListVersions(client *clients.Client, location location.BucketLocation) ([]*Version, error) {
+	nextPage := ""
+	var versions []*Version
+
+	for {
+		params := packerservice.NewPackerServiceListVersionsParams()
+		params.SetLocationOrganizationID(location.GetOrganizationID())
+		params.SetLocationProjectID(location.GetProjectID())
+		params.SetBucketName(location.GetBucketName())
+		if nextPage != "" {
+			params.SetPaginationNextPageToken(&nextPage)
+		}
+
+		resp, err := client.PackerV2.PackerServiceListVersions(params, nil)
+		if err != nil {
+			return nil, formatGRPCError[*packerservice.PackerServiceListVersionsDefault](err)
+		}
+
+		versions = append(versions, resp.GetPayload().Versions...)
+		pagination := resp.GetPayload().Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return versions, nil
+		}
+
+		nextPage = pagination.NextPageToken
+	}
+}
+
+func ListVersionsDiags(client *clients.Client, location location.BucketLocation) ([]*Version, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	versions, err := ListVersions(client, location)
+	if err != nil {
+		diags.AddError(
+			"failed to list Versions, received an error from the HCP Packer API",
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	return versions, diags
+}
+
 func getVersionRaw(client *clients.Client, params *GetVersionParams) (*packerservice.PackerServiceGetVersionOK, error) {
 	resp, err := client.PackerV2.PackerServiceGetVersion(params, nil)
 	if err != nil {
@@ -166,6 +166,75 @@ func UpdateVaultMajorVersionUpgradeConfig(ctx context.Context, client *Client, l
 	return updateResp.Payload, nil
 }
 
+// UpdateVaultMinorVersionUpgradeConfig will make a call to the Vault service to update the minor and patch
+// version upgrade configuration of a Vault cluster.
+func UpdateVaultMinorVersionUpgradeConfig(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, clusterID string,
+	config *vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfig) (vaultmodels.HashicorpCloudVault20201125UpdateMinorVersionUpgradeConfigResponse, error) {
+
+	request := &vaultmodels.HashicorpCloudVault20201125UpdateMinorVersionUpgradeConfigRequest{
+		// ClusterID and Location are repeated because the values above are required to populate the URL,
+		// and the values below are required in the API request body
+		ClusterID:         clusterID,
+		Location:          internalLocation(loc),
+		UpgradeType:       config.UpgradeType,
+		MaintenanceWindow: config.MaintenanceWindow,
+	}
+	updateParams := vault_service.NewUpdateMinorVersionUpgradeConfigParams()
+	updateParams.Context = ctx
+	updateParams.ClusterID = clusterID
+	updateParams.LocationProjectID = loc.ProjectID
+	updateParams.LocationOrganizationID = loc.OrganizationID
+	updateParams.Body = request
+
+	updateResp, err := client.Vault.UpdateMinorVersionUpgradeConfig(updateParams, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateResp.Payload, nil
+}
+
+// UpdateVaultClusterVersion will make a call to the Vault service to upgrade a Vault cluster to the given
+// version immediately, regardless of its upgrade configuration.
+func UpdateVaultClusterVersion(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, clusterID string,
+	version string) (*vaultmodels.HashicorpCloudVault20201125UpdateVersionResponse, error) {
+
+	updateParams := vault_service.NewUpdateVersionParams()
+	updateParams.Context = ctx
+	updateParams.ClusterID = clusterID
+	updateParams.Version = version
+	updateParams.LocationProjectID = loc.ProjectID
+	updateParams.LocationOrganizationID = loc.OrganizationID
+	updateParams.Body = &vaultmodels.HashicorpCloudVault20201125UpdateVersionRequest{
+		ClusterID: clusterID,
+		Location:  internalLocation(loc),
+		Version:   version,
+	}
+
+	updateResp, err := client.Vault.UpdateVersion(updateParams, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateResp.Payload, nil
+}
+
+// internalLocation converts a shared location to the location model of the Vault service.
+func internalLocation(loc *sharedmodels.HashicorpCloudLocationLocation) *vaultmodels.HashicorpCloudInternalLocationLocation {
+	region := &sharedmodels.HashicorpCloudLocationRegion{}
+	if loc.Region != nil {
+		region = loc.Region
+	}
+	return &vaultmodels.HashicorpCloudInternalLocationLocation{
+		OrganizationID: loc.OrganizationID,
+		ProjectID:      loc.ProjectID,
+		Region: &vaultmodels.HashicorpCloudInternalLocationRegion{
+			Provider: region.Provider,
+			Region:   region.Region,
+		},
+	}
+}
+
 // UpdateVaultCluster will make a call to the Vault service to update the Vault cluster configuration.
 func UpdateVaultClusterConfig(
 	ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, clusterID string,
@@ -294,6 +363,34 @@ func DeleteVaultPathsFilter(ctx context.Context, client *Client, loc *sharedmode
 	return deleteResp.Payload, nil
 }
 
+// ListVaultPerformanceReplicationSecondaries will make a call to the Vault service to list the performance
+// replication secondaries of a primary cluster.
+func ListVaultPerformanceReplicationSecondaries(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation,
+	clusterID string) ([]*vaultmodels.HashicorpCloudVault20201125Cluster, error) {
+
+	params := vault_service.NewListPerformanceReplicationSecondariesParams()
+	params.Context = ctx
+	params.ClusterID = clusterID
+	params.LocationOrganizationID = loc.OrganizationID
+	params.LocationProjectID = loc.ProjectID
+
+	var secondaries []*vaultmodels.HashicorpCloudVault20201125Cluster
+	for {
+		listResp, err := client.Vault.ListPerformanceReplicationSecondaries(params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		secondaries = append(secondaries, listResp.Payload.Secondaries...)
+
+		pagination := listResp.Payload.Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return secondaries, nil
+		}
+		params.PaginationNextPageToken = &pagination.NextPageToken
+	}
+}
+
 // AddPlugin will make a call to the Vault service to add a plugin to a Vault cluster
 func AddPlugin(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, clusterID string,
 	request *vaultmodels.HashicorpCloudVault20201125AddPluginRequest) (vaultmodels.HashicorpCloudVault20201125AddPluginResponse, error) {
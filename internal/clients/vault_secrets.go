@@ -5,9 +5,11 @@ package clients
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 	"strconv"
 	"time"
 
@@ -18,6 +20,10 @@ import (
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
 )
 
+// bulkSecretsBatchSize is the maximum number of secrets sent in a single bulk
+// request.
+const bulkSecretsBatchSize = 100
+
 // CreateVaultSecretsApp will create a Vault Secrets application.
 func CreateVaultSecretsApp(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName string, description string) (*secretmodels.Secrets20231128App, error) {
 
@@ -111,6 +117,23 @@ func CreateVaultSecretsAppSecret(ctx context.Context, client *Client, loc *share
 	return createResp.Payload.Secret, nil
 }
 
+// GetVaultSecretsAppSecret will read the metadata of a Vault Secrets
+// application secret, without its value.
+func GetVaultSecretsAppSecret(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName, secretName string) (*secretmodels.Secrets20231128Secret, error) {
+	getParams := secret_service.NewGetAppSecretParamsWithContext(ctx).
+		WithAppName(appName).
+		WithSecretName(secretName).
+		WithOrganizationID(loc.OrganizationID).
+		WithProjectID(loc.ProjectID)
+
+	getResp, err := client.VaultSecrets.GetAppSecret(getParams, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return getResp.Payload.Secret, nil
+}
+
 // DeleteVaultSecretsAppSecret will delete a Vault Secrets application secret.
 func DeleteVaultSecretsAppSecret(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName, secretName string) error {
 
@@ -129,6 +152,98 @@ func DeleteVaultSecretsAppSecret(ctx context.Context, client *Client, loc *share
 	return nil
 }
 
+// BulkCreateVaultSecretsAppSecrets will create or, when overwrite is set,
+// update the given Vault Secrets application secrets. Secrets are sent in
+// batches, retrying batches that are rate limited. When a batch fails, the
+// secrets of the batches already sent are returned along with the error.
+func BulkCreateVaultSecretsAppSecrets(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName string, secrets map[string]string, overwrite bool) ([]*secretmodels.Secrets20231128Secret, error) {
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	conflicts := secretmodels.BulkCreateAppKVSecretsRequestNameConflictPolicyFAIL
+	if overwrite {
+		conflicts = secretmodels.BulkCreateAppKVSecretsRequestNameConflictPolicyUPDATE
+	}
+
+	var result []*secretmodels.Secrets20231128Secret
+	for start := 0; start < len(names); start += bulkSecretsBatchSize {
+		end := min(start+bulkSecretsBatchSize, len(names))
+
+		body := &secretmodels.SecretServiceBulkCreateAppKVSecretsBody{
+			Conflicts: conflicts.Pointer(),
+		}
+		for _, name := range names[start:end] {
+			body.Secrets = append(body.Secrets, &secretmodels.BulkCreateAppKVSecretsRequestKeyValue{
+				Name:  name,
+				Value: secrets[name],
+			})
+		}
+
+		params := secret_service.NewBulkCreateAppKVSecretsParamsWithContext(ctx).
+			WithAppName(appName).
+			WithOrganizationID(loc.OrganizationID).
+			WithProjectID(loc.ProjectID).
+			WithBody(body)
+
+		var resp *secret_service.BulkCreateAppKVSecretsOK
+		var err error
+		for attempt := 0; attempt < retryCount; attempt++ {
+			resp, err = client.VaultSecrets.BulkCreateAppKVSecrets(params, nil)
+			if err != nil {
+				var serviceErr *secret_service.BulkCreateAppKVSecretsDefault
+				if errors.As(err, &serviceErr) && shouldRetryWithSleep(ctx, serviceErr, attempt, []int{http.StatusTooManyRequests}) {
+					continue
+				}
+				return result, err
+			}
+			break
+		}
+		if err != nil {
+			return result, err
+		}
+		if resp == nil {
+			return result, errors.New("unable to create secrets")
+		}
+
+		result = append(result, resp.Payload.Secrets...)
+	}
+
+	return result, nil
+}
+
+// DeleteVaultSecretsAppSecrets will delete the given Vault Secrets application
+// secrets, retrying deletions that are rate limited. Secrets that no longer
+// exist are ignored.
+func DeleteVaultSecretsAppSecrets(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName string, secretNames []string) error {
+	for _, secretName := range secretNames {
+		params := secret_service.NewDeleteAppSecretParamsWithContext(ctx).
+			WithAppName(appName).
+			WithSecretName(secretName).
+			WithOrganizationID(loc.OrganizationID).
+			WithProjectID(loc.ProjectID)
+
+		var err error
+		for attempt := 0; attempt < retryCount; attempt++ {
+			_, err = client.VaultSecrets.DeleteAppSecret(params, nil)
+			if err != nil {
+				var serviceErr *secret_service.DeleteAppSecretDefault
+				if errors.As(err, &serviceErr) && shouldRetryWithSleep(ctx, serviceErr, attempt, []int{http.StatusTooManyRequests}) {
+					continue
+				}
+			}
+			break
+		}
+		if err != nil && !IsResponseCodeNotFound(err) {
+			return fmt.Errorf("unable to delete secret %q: %w", secretName, err)
+		}
+	}
+
+	return nil
+}
+
 func shouldRetryWithSleep(ctx context.Context, err ErrorWithCode, attemptNum int, expectedErrorCodes []int) bool {
 	if shouldRetryErrorCode(err.Code(), expectedErrorCodes) {
 		backOffDuration := getAPIBackoffDuration(err.Error())
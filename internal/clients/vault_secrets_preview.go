@@ -91,6 +91,99 @@ func OpenVaultSecretsAppSecrets(ctx context.Context, client *Client, loc *shared
 	}
 }
 
+// OpenVaultSecretsAppSecretVersion will retrieve the given version of a secret
+// for a Vault Secrets app, including it's value.
+func OpenVaultSecretsAppSecretVersion(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName, secretName string, version int64) (*secretmodels.Secrets20231128OpenSecret, error) {
+	params := secret_service.NewOpenAppSecretVersionParamsWithContext(ctx).
+		WithAppName(appName).
+		WithSecretName(secretName).
+		WithVersion(version).
+		WithOrganizationID(loc.OrganizationID).
+		WithProjectID(loc.ProjectID)
+
+	var resp *secret_service.OpenAppSecretVersionOK
+	var err error
+	for attempt := 0; attempt < retryCount; attempt++ {
+		resp, err = client.VaultSecrets.OpenAppSecretVersion(params, nil)
+		if err != nil {
+			var serviceErr *secret_service.OpenAppSecretVersionDefault
+			if errors.As(err, &serviceErr) && shouldRetryWithSleep(ctx, serviceErr, attempt, []int{http.StatusTooManyRequests}) {
+				continue
+			}
+			return nil, err
+		}
+		break
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, errors.New("unable to get secret version")
+	}
+
+	// The version response only holds the versioned fields of the secret.
+	return &secretmodels.Secrets20231128OpenSecret{
+		Name:            secretName,
+		Type:            resp.Payload.Type,
+		StaticVersion:   resp.Payload.StaticVersion,
+		RotatingVersion: resp.Payload.RotatingVersion,
+		DynamicInstance: resp.Payload.DynamicInstance,
+	}, nil
+}
+
+// ListVaultSecretsAppSecretVersions will list the versions of a secret for a
+// Vault Secrets app, without their values.
+func ListVaultSecretsAppSecretVersions(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName, secretName string) (*secretmodels.Secrets20231128ListAppSecretVersionsResponse, error) {
+	params := secret_service.NewListAppSecretVersionsParamsWithContext(ctx).
+		WithAppName(appName).
+		WithSecretName(secretName).
+		WithOrganizationID(loc.OrganizationID).
+		WithProjectID(loc.ProjectID)
+
+	result := &secretmodels.Secrets20231128ListAppSecretVersionsResponse{}
+	for {
+		var resp *secret_service.ListAppSecretVersionsOK
+		var err error
+		for attempt := 0; attempt < retryCount; attempt++ {
+			resp, err = client.VaultSecrets.ListAppSecretVersions(params, nil)
+			if err != nil {
+				var serviceErr *secret_service.ListAppSecretVersionsDefault
+				if errors.As(err, &serviceErr) && shouldRetryWithSleep(ctx, serviceErr, attempt, []int{http.StatusTooManyRequests}) {
+					continue
+				}
+				return nil, err
+			}
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil || resp.Payload == nil {
+			return nil, errors.New("unable to list secret versions")
+		}
+
+		payload := resp.Payload
+		result.Type = payload.Type
+		if payload.StaticVersions != nil {
+			if result.StaticVersions == nil {
+				result.StaticVersions = &secretmodels.Secrets20231128SecretStaticVersionList{}
+			}
+			result.StaticVersions.Versions = append(result.StaticVersions.Versions, payload.StaticVersions.Versions...)
+		}
+		if payload.RotatingVersions != nil {
+			if result.RotatingVersions == nil {
+				result.RotatingVersions = &secretmodels.Secrets20231128SecretRotatingVersionList{}
+			}
+			result.RotatingVersions.Versions = append(result.RotatingVersions.Versions, payload.RotatingVersions.Versions...)
+		}
+
+		if payload.Pagination == nil || payload.Pagination.NextPageToken == "" {
+			return result, nil
+		}
+		params.PaginationNextPageToken = &payload.Pagination.NextPageToken
+	}
+}
+
 func GetRotatingSecretState(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName, secretName string) (*secretmodels.Secrets20231128RotatingSecretState, error) {
 	params := secret_service.NewGetRotatingSecretStateParamsWithContext(ctx).
 		WithOrganizationID(loc.OrganizationID).
@@ -106,6 +199,19 @@ func GetRotatingSecretState(ctx context.Context, client *Client, loc *sharedmode
 	return resp.GetPayload().State, nil
 }
 
+// RotateSecret triggers an immediate rotation of a rotating secret.
+func RotateSecret(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName, secretName string) error {
+	params := secret_service.NewRotateSecretParamsWithContext(ctx).
+		WithOrganizationID(loc.OrganizationID).
+		WithProjectID(loc.ProjectID).
+		WithAppName(appName).
+		WithName(secretName).
+		WithBody(map[string]any{})
+
+	_, err := client.VaultSecrets.RotateSecret(params, nil)
+	return err
+}
+
 // CreateMongoDBAtlasRotationIntegration NOTE: currently just needed for tests
 func CreateMongoDBAtlasRotationIntegration(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, integrationName, mongodbAtlasPublicKey, mongodbAtlasPrivateKey string) (*secretmodels.Secrets20231128MongoDBAtlasIntegration, error) {
 	body := secretmodels.SecretServiceCreateMongoDBAtlasIntegrationBody{
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/client/vault_service"
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+)
+
+// vaultClusterResourceType is the resource type of a Vault cluster, which
+// snapshots are taken of.
+const vaultClusterResourceType = "hashicorp.vault.cluster"
+
+// CreateVaultSnapshot will make a call to the Vault service to initiate the create
+// snapshot workflow of a Vault cluster.
+func CreateVaultSnapshot(ctx context.Context, client *Client, cluster *vaultmodels.HashicorpCloudVault20201125Cluster,
+	snapshotName string) (*vaultmodels.HashicorpCloudVault20201125CreateSnapshotResponse, error) {
+
+	p := vault_service.NewCreateSnapshotParams()
+	p.Context = ctx
+	p.ResourceLocationOrganizationID = cluster.Location.OrganizationID
+	p.ResourceLocationProjectID = cluster.Location.ProjectID
+	p.Body = &vaultmodels.HashicorpCloudVault20201125CreateSnapshotRequest{
+		Name: snapshotName,
+		Resource: &vaultmodels.HashicorpCloudInternalLocationLink{
+			Type:     vaultClusterResourceType,
+			ID:       cluster.ID,
+			Location: cluster.Location,
+		},
+	}
+
+	resp, err := client.Vault.CreateSnapshot(p, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload, nil
+}
+
+// GetVaultSnapshotByID gets a Vault snapshot by its ID.
+func GetVaultSnapshotByID(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation,
+	snapshotID string) (*vaultmodels.HashicorpCloudVault20201125Snapshot, error) {
+
+	p := vault_service.NewGetSnapshotParams()
+	p.Context = ctx
+	p.LocationOrganizationID = loc.OrganizationID
+	p.LocationProjectID = loc.ProjectID
+	p.SnapshotID = snapshotID
+
+	resp, err := client.Vault.GetSnapshot(p, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload.Snapshot, nil
+}
+
+// ListVaultSnapshots lists the snapshots of a Vault cluster.
+func ListVaultSnapshots(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation,
+	clusterID string) ([]*vaultmodels.HashicorpCloudVault20201125Snapshot, error) {
+
+	resourceType := vaultClusterResourceType
+	p := vault_service.NewListSnapshotsParams()
+	p.Context = ctx
+	p.ResourceLocationOrganizationID = loc.OrganizationID
+	p.ResourceLocationProjectID = loc.ProjectID
+	p.ResourceID = &clusterID
+	p.ResourceType = &resourceType
+
+	var snapshots []*vaultmodels.HashicorpCloudVault20201125Snapshot
+	for {
+		resp, err := client.Vault.ListSnapshots(p, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshots = append(snapshots, resp.Payload.Snapshots...)
+
+		pagination := resp.Payload.Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return snapshots, nil
+		}
+		p.PaginationNextPageToken = &pagination.NextPageToken
+	}
+}
+
+// RenameVaultSnapshot renames a Vault snapshot by its ID.
+func RenameVaultSnapshot(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation,
+	snapshotID string, snapshotName string) (*vaultmodels.HashicorpCloudVault20201125Snapshot, error) {
+
+	p := vault_service.NewUpdateSnapshotParams()
+	p.Context = ctx
+	p.SnapshotLocationOrganizationID = loc.OrganizationID
+	p.SnapshotLocationProjectID = loc.ProjectID
+	p.SnapshotSnapshotID = snapshotID
+	p.Body = &vaultmodels.HashicorpCloudVault20201125UpdateSnapshotRequest{
+		Snapshot: &vaultmodels.HashicorpCloudVault20201125Snapshot{
+			SnapshotID: snapshotID,
+			Name:       snapshotName,
+		},
+		Mask: &sharedmodels.GoogleProtobufFieldMask{
+			Paths: []string{"name"},
+		},
+	}
+
+	resp, err := client.Vault.UpdateSnapshot(p, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload.Snapshot, nil
+}
+
+// DeleteVaultSnapshot deletes a Vault snapshot by its ID.
+func DeleteVaultSnapshot(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation,
+	snapshotID string) (*vaultmodels.HashicorpCloudVault20201125DeleteSnapshotResponse, error) {
+
+	p := vault_service.NewDeleteSnapshotParams()
+	p.Context = ctx
+	p.LocationOrganizationID = loc.OrganizationID
+	p.LocationProjectID = loc.ProjectID
+	p.SnapshotID = snapshotID
+
+	resp, err := client.Vault.DeleteSnapshot(p, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload, nil
+}
+
+// RestoreVaultSnapshot will make a call to the Vault service to restore a
+// snapshot to a Vault cluster. The data of the cluster is replaced by the data
+// of the snapshot.
+func RestoreVaultSnapshot(ctx context.Context, client *Client, cluster *vaultmodels.HashicorpCloudVault20201125Cluster,
+	snapshotID string) (*vaultmodels.HashicorpCloudVault20201125RestoreSnapshotResponse, error) {
+
+	p := vault_service.NewRestoreSnapshotParams()
+	p.Context = ctx
+	p.ClusterID = cluster.ID
+	p.LocationOrganizationID = cluster.Location.OrganizationID
+	p.LocationProjectID = cluster.Location.ProjectID
+	p.Body = &vaultmodels.HashicorpCloudVault20201125RestoreSnapshotRequest{
+		// ClusterID and Location are repeated because the values above are required to populate the URL,
+		// and the values below are required in the API request body
+		ClusterID:  cluster.ID,
+		Location:   cluster.Location,
+		SnapshotID: snapshotID,
+	}
+
+	resp, err := client.Vault.RestoreSnapshot(p, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload, nil
+}
@@ -5,6 +5,11 @@ package clients
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/client/waypoint_service"
@@ -43,6 +48,38 @@ func GetAgentGroup(ctx context.Context, client *Client, loc *sharedmodels.Hashic
 	return getResp.GetPayload().Group, nil
 }
 
+// ListAgentGroupActions will retrieve the agent Actions that are routed to
+// the given Agent Group.
+func ListAgentGroupActions(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, groupName string) ([]*waypoint_models.HashicorpCloudWaypointV20241122ActionConfig, error) {
+	params := &waypoint_service.WaypointServiceListActionConfigsParams{
+		NamespaceLocationOrganizationID: loc.OrganizationID,
+		NamespaceLocationProjectID:      loc.ProjectID,
+	}
+
+	var actions []*waypoint_models.HashicorpCloudWaypointV20241122ActionConfig
+	for {
+		listResp, err := client.Waypoint.WaypointServiceListActionConfigs(params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, action := range listResp.GetPayload().ActionConfigs {
+			if action == nil || action.Request == nil || action.Request.Agent == nil || action.Request.Agent.Op == nil {
+				continue
+			}
+			if action.Request.Agent.Op.Group == groupName {
+				actions = append(actions, action)
+			}
+		}
+
+		pagination := listResp.GetPayload().Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return actions, nil
+		}
+		params.PaginationNextPageToken = &pagination.NextPageToken
+	}
+}
+
 // GetApplicationTemplateByName will retrieve a template by name
 func GetApplicationTemplateByName(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName string) (*waypoint_models.HashicorpCloudWaypointV20241122ApplicationTemplate, error) {
 	params := &waypoint_service.WaypointServiceGetApplicationTemplate2Params{
@@ -176,3 +213,209 @@ func GetInputVariables(ctx context.Context, client *Client, workspaceName string
 	}
 	return getResp.GetPayload().InputVariables, nil
 }
+
+// GetModuleVariables will retrieve the variables of a Terraform no-code
+// module. The module source is expected to be in the format
+// "<registry>/<namespace>/<name>/<provider>", as used by Templates and Add-on
+// Definitions.
+func GetModuleVariables(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, moduleSource, moduleID string) ([]*waypoint_models.HashicorpCloudWaypointV20241122TFModuleVariable, error) {
+	parts := strings.Split(moduleSource, "/")
+	if len(parts) < 3 {
+		return nil, fmt.Errorf("invalid module source %q, expected <registry>/<namespace>/<name>/<provider>", moduleSource)
+	}
+	parts = parts[len(parts)-3:]
+
+	params := &waypoint_service.WaypointServiceGetTFModuleDetails3Params{
+		TfcNamespace:                    parts[0],
+		Name:                            parts[1],
+		Provider:                        parts[2],
+		ModuleID:                        moduleID,
+		NamespaceLocationOrganizationID: loc.OrganizationID,
+		NamespaceLocationProjectID:      loc.ProjectID,
+	}
+
+	getResp, err := client.Waypoint.WaypointServiceGetTFModuleDetails3(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	if getResp.GetPayload().ModuleDetails == nil {
+		return nil, nil
+	}
+	return getResp.GetPayload().ModuleDetails.Variables, nil
+}
+
+// UpgradeApplication will upgrade the HCP Terraform workspace of an
+// Application to the current version of its Template. The given variables
+// must be valid for the Application's Template.
+func UpgradeApplication(
+	ctx context.Context,
+	client *Client,
+	loc *sharedmodels.HashicorpCloudLocationLocation,
+	appID, appName string,
+	variables []*waypoint_models.HashicorpCloudWaypointV20241122InputVariable,
+) (*waypoint_models.HashicorpCloudWaypointV20241122Application, error) {
+	params := &waypoint_service.WaypointServiceUpgradeApplicationTFWorkspaceParams{
+		ApplicationName:                 appName,
+		NamespaceLocationOrganizationID: loc.OrganizationID,
+		NamespaceLocationProjectID:      loc.ProjectID,
+		Body: &waypoint_models.HashicorpCloudWaypointV20241122WaypointServiceUpgradeApplicationTFWorkspaceBody{
+			Application: &waypoint_models.HashicorpCloudWaypointV20241122WaypointServiceUpgradeApplicationTFWorkspaceBodyApplication{
+				ID: appID,
+			},
+			Variables: variables,
+		},
+	}
+
+	upgradeResp, err := client.Waypoint.WaypointServiceUpgradeApplicationTFWorkspace(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	return upgradeResp.GetPayload().Application, nil
+}
+
+const (
+	// ActionRunStateRunning is used for action runs whose background job has
+	// not reported a terminal status yet.
+	ActionRunStateRunning = string(waypoint_models.HashicorpCloudWaypointV20241122JobStatusSTATUSRUNNING)
+
+	// ActionRunStateQueued is used for action runs waiting on an agent.
+	ActionRunStateQueued = string(waypoint_models.HashicorpCloudWaypointV20241122JobStatusSTATUSQUEUED)
+
+	// ActionRunStateSuccess is used for action runs that completed successfully.
+	ActionRunStateSuccess = string(waypoint_models.HashicorpCloudWaypointV20241122JobStatusSTATUSSUCCESS)
+
+	// ActionRunStateErrored is used for action runs that ran but failed.
+	ActionRunStateErrored = string(waypoint_models.HashicorpCloudWaypointV20241122JobStatusSTATUSERRORED)
+
+	// ActionRunStateHalted is used for action runs that could not be launched.
+	ActionRunStateHalted = string(waypoint_models.HashicorpCloudWaypointV20241122JobStatusSTATUSHALTED)
+)
+
+// RunAction will invoke an Action by ID, or by name if the ID is not
+// provided. If applicationName is set the run is scoped to that application.
+func RunAction(
+	ctx context.Context,
+	client *Client,
+	loc *sharedmodels.HashicorpCloudLocationLocation,
+	actionID, actionName, applicationName string,
+	overrides []*waypoint_models.HashicorpCloudWaypointV20241122RunActionRequestVariableOverride,
+) (*waypoint_models.HashicorpCloudWaypointV20241122ActionRun, error) {
+	body := &waypoint_models.HashicorpCloudWaypointV20241122WaypointServiceRunActionBody{
+		ActionRef: &waypoint_models.HashicorpCloudWaypointV20241122ActionCfgRef{
+			ID:   actionID,
+			Name: actionName,
+		},
+		VariableOverrides: overrides,
+	}
+	if applicationName != "" {
+		body.Scope = &waypoint_models.HashicorpCloudWaypointV20241122ActionRunScope{
+			Application: &waypoint_models.HashicorpCloudWaypointV20241122RefApplication{
+				Name: applicationName,
+			},
+		}
+	}
+
+	params := &waypoint_service.WaypointServiceRunActionParams{
+		NamespaceLocationOrganizationID: loc.OrganizationID,
+		NamespaceLocationProjectID:      loc.ProjectID,
+		Body:                            body,
+	}
+
+	runResp, err := client.Waypoint.WaypointServiceRunAction(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	return runResp.GetPayload().ActionRun, nil
+}
+
+// GetActionRun will retrieve a single run of an Action by its sequence number.
+// The Action is looked up by ID, or by name if the ID is not provided.
+func GetActionRun(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, actionID, actionName, sequence string) (*waypoint_models.HashicorpCloudWaypointV20241122ActionRun, error) {
+	params := &waypoint_service.WaypointServiceGetActionRunParams{
+		NamespaceLocationOrganizationID: loc.OrganizationID,
+		NamespaceLocationProjectID:      loc.ProjectID,
+		Sequence:                        &sequence,
+	}
+	if actionID != "" {
+		params.ActionID = &actionID
+	}
+	if actionName != "" {
+		params.ActionName = &actionName
+	}
+
+	getResp, err := client.Waypoint.WaypointServiceGetActionRun(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	return getResp.GetPayload().ActionRun, nil
+}
+
+// ActionRunState returns the state of an Action run. Runs without a
+// background job, such as custom HTTP requests, are considered finished once
+// they record a completion time.
+func ActionRunState(run *waypoint_models.HashicorpCloudWaypointV20241122ActionRun) string {
+	if run == nil {
+		return ActionRunStateRunning
+	}
+
+	if run.BackgroundJob != nil && run.BackgroundJob.Status != nil {
+		switch status := *run.BackgroundJob.Status; status {
+		case waypoint_models.HashicorpCloudWaypointV20241122JobStatusSTATUSUNSPECIFIED,
+			waypoint_models.HashicorpCloudWaypointV20241122JobStatusSTATUSUNKNOWN:
+		default:
+			return string(status)
+		}
+	}
+
+	if run.CompletedAt.IsZero() {
+		return ActionRunStateRunning
+	}
+	if run.ResponseStatus != nil && *run.ResponseStatus == waypoint_models.HashicorpCloudWaypointV20241122ActionRunResponseStatusERROR {
+		return ActionRunStateErrored
+	}
+	return ActionRunStateSuccess
+}
+
+// actionRunRefreshState refreshes the state of an Action run
+func actionRunRefreshState(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, actionID, actionName, sequence string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		run, err := GetActionRun(ctx, client, loc, actionID, actionName, sequence)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return run, ActionRunState(run), nil
+	}
+}
+
+// WaitForActionRunToComplete will poll the GET Action run endpoint until the
+// run reaches a terminal state, ctx is canceled, or an error occurs. A run
+// that finishes unsuccessfully is returned without an error; callers should
+// inspect ActionRunState to decide how to surface it.
+func WaitForActionRunToComplete(ctx context.Context, client *Client,
+	loc *sharedmodels.HashicorpCloudLocationLocation,
+	actionID, actionName, sequence string,
+	timeout time.Duration) (*waypoint_models.HashicorpCloudWaypointV20241122ActionRun, error) {
+
+	stateChangeConf := retry.StateChangeConf{
+		Pending: []string{
+			ActionRunStateRunning,
+			ActionRunStateQueued,
+		},
+		Target: []string{
+			ActionRunStateSuccess,
+			ActionRunStateErrored,
+			ActionRunStateHalted,
+		},
+		Refresh:      actionRunRefreshState(ctx, client, loc, actionID, actionName, sequence),
+		Timeout:      timeout,
+		PollInterval: 5 * time.Second,
+	}
+
+	result, err := stateChangeConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for the Action run (%s) to complete: %+v", sequence, err)
+	}
+
+	return result.(*waypoint_models.HashicorpCloudWaypointV20241122ActionRun), nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hcpvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// importIDSeparator separates the parts of a composite import ID.
+const importIDSeparator = ":"
+
+// ImportIDPart describes one part of a composite import ID.
+type ImportIDPart struct {
+	// Name is the name of the part, usually the attribute it is imported into.
+	Name string
+
+	// Optional parts may be omitted from the import ID. Only leading parts,
+	// such as the project ID, can be optional.
+	Optional bool
+
+	// Validators are run against the value of the part.
+	Validators []validator.String
+}
+
+// ImportIDFormat returns the documented format of an import ID made of the
+// given parts, for example "[{project_id}:]{name}".
+func ImportIDFormat(parts ...ImportIDPart) string {
+	var b strings.Builder
+	for i, part := range parts {
+		if part.Optional {
+			fmt.Fprintf(&b, "[{%s}%s]", part.Name, importIDSeparator)
+			continue
+		}
+
+		fmt.Fprintf(&b, "{%s}", part.Name)
+		if i < len(parts)-1 {
+			b.WriteString(importIDSeparator)
+		}
+	}
+	return b.String()
+}
+
+// ParseImportID splits a colon separated import ID into the given parts and
+// validates each of them. The returned values are in the same order as the
+// parts; omitted optional parts are returned as empty strings.
+func ParseImportID(ctx context.Context, id string, parts ...ImportIDPart) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	optional := 0
+	for _, part := range parts {
+		if part.Optional {
+			optional++
+		}
+	}
+
+	values := strings.Split(id, importIDSeparator)
+	omitted := len(parts) - len(values)
+	if omitted < 0 || omitted > optional || hasEmptyValue(values) {
+		diags.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the format %s, got: %q", ImportIDFormat(parts...), id),
+		)
+		return nil, diags
+	}
+
+	result := make([]string, len(parts))
+	copy(result[omitted:], values)
+
+	for i, part := range parts {
+		if result[i] == "" {
+			continue
+		}
+		diags.Append(ValidateImportIDPart(ctx, part, result[i])...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return result, diags
+}
+
+// ValidateImportIDPart runs the validators of an import ID part against the
+// given value.
+func ValidateImportIDPart(ctx context.Context, part ImportIDPart, value string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	request := validator.StringRequest{
+		Path:           path.Root(part.Name),
+		PathExpression: path.MatchRoot(part.Name),
+		ConfigValue:    types.StringValue(value),
+	}
+	for _, v := range part.Validators {
+		response := validator.StringResponse{}
+		v.ValidateString(ctx, request, &response)
+		diags.Append(response.Diagnostics...)
+	}
+
+	return diags
+}
+
+func hasEmptyValue(values []string) bool {
+	for _, v := range values {
+		if v == "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hcpvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImportID(t *testing.T) {
+	t.Parallel()
+
+	parts := []hcpvalidator.ImportIDPart{
+		{Name: "project_id", Optional: true, Validators: []validator.String{hcpvalidator.UUID()}},
+		{Name: "name", Validators: []validator.String{hcpvalidator.ResourceNamePart()}},
+	}
+
+	type testCase struct {
+		id          string
+		expected    []string
+		expectError string
+	}
+	tests := map[string]testCase{
+		"name only": {
+			id:       "my-resource",
+			expected: []string{"", "my-resource"},
+		},
+		"project and name": {
+			id:       "b4ac9d8a-7f2e-4f5b-9c0d-2d3e4f5a6b7c:my-resource",
+			expected: []string{"b4ac9d8a-7f2e-4f5b-9c0d-2d3e4f5a6b7c", "my-resource"},
+		},
+		"empty": {
+			id:          "",
+			expectError: `Expected an import ID in the format [{project_id}:]{name}, got: ""`,
+		},
+		"too many parts": {
+			id:          "b4ac9d8a-7f2e-4f5b-9c0d-2d3e4f5a6b7c:my-resource:extra",
+			expectError: "Expected an import ID in the format [{project_id}:]{name}",
+		},
+		"invalid project": {
+			id:          "my-project:my-resource",
+			expectError: "must be a valid UUID",
+		},
+		"invalid name": {
+			id:          "$bad!",
+			expectError: "alphanumeric characters",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			values, diags := hcpvalidator.ParseImportID(context.TODO(), test.id, parts...)
+
+			if test.expectError != "" {
+				require.True(t, diags.HasError())
+				require.Contains(t, diags.Errors()[0].Detail(), test.expectError)
+				return
+			}
+
+			require.False(t, diags.HasError(), diags)
+			require.Equal(t, test.expected, values)
+		})
+	}
+}
+
+func TestImportIDFormat(t *testing.T) {
+	t.Parallel()
+
+	format := hcpvalidator.ImportIDFormat(
+		hcpvalidator.ImportIDPart{Name: "project_id", Optional: true},
+		hcpvalidator.ImportIDPart{Name: "app_name"},
+		hcpvalidator.ImportIDPart{Name: "role"},
+	)
+	require.Equal(t, "[{project_id}:]{app_name}:{role}", format)
+}
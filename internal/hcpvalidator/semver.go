@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hcpvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const invalidSemVerErr = "must be a valid semantic version"
+
+var _ validator.String = semVerValidator{}
+
+// semVerValidator validates that a string Attribute's value is a valid semantic
+// version.
+type semVerValidator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (v semVerValidator) Description(_ context.Context) string {
+	return invalidSemVerErr
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v semVerValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v semVerValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if _, err := version.NewSemver(value); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// SemVer returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a string.
+//   - Is a valid semantic version, such as `1.16.0` or `v1.16.0`.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func SemVer() validator.String {
+	return semVerValidator{}
+}
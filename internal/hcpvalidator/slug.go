@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hcpvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/input"
+)
+
+const invalidSlugErr = "must be between 3 and 36 characters in length and contains only letters, numbers or hyphens"
+
+var _ validator.String = slugValidator{}
+
+// slugValidator validates that a string Attribute's value is a valid HCP slug.
+type slugValidator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (v slugValidator) Description(_ context.Context) string {
+	return invalidSlugErr
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v slugValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v slugValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if !input.IsSlug(value) {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// Slug returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a string.
+//   - Is a valid HCP slug, such as the ID of an HCP Vault cluster: between 3
+//     and 36 characters in length, containing only letters, numbers or
+//     hyphens, and beginning and ending with a letter or number.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Slug() validator.String {
+	return slugValidator{}
+}
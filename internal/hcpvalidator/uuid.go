@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hcpvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const invalidUUIDErr = "must be a valid UUID"
+
+var _ validator.String = uuidValidator{}
+
+// uuidValidator validates that a string Attribute's value is a valid UUID.
+type uuidValidator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (v uuidValidator) Description(_ context.Context) string {
+	return invalidUUIDErr
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v uuidValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v uuidValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if _, err := uuid.ParseUUID(value); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// UUID returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a string.
+//   - Is a valid UUID, such as an HCP organization or project ID.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func UUID() validator.String {
+	return uuidValidator{}
+}
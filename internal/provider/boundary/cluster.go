@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boundary
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	boundarymodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-boundary-service/stable/2021-12-21/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/customtypes"
+)
+
+// boundaryClusterResourceType is the resource type of a Boundary cluster, used
+// in the links identifying clusters.
+const boundaryClusterResourceType = "hashicorp.boundary.cluster"
+
+const (
+	upgradeTypePrefix = "UPGRADE_TYPE_"
+	dayOfWeekPrefix   = "DAY_OF_WEEK_"
+	tierPrefix        = "CLUSTER_MARKETING_SKU_"
+)
+
+const (
+	// defaultAuthTokenTimeToLive is the time to live of the auth tokens of a
+	// cluster when it is not configured.
+	defaultAuthTokenTimeToLive = "1680h0m0s"
+
+	// defaultAuthTokenTimeToStale is the time to stale of the auth tokens of a
+	// cluster when it is not configured.
+	defaultAuthTokenTimeToStale = "24h0m0s"
+)
+
+// maintenanceWindowModel is the maintenance window configuration of a
+// Boundary cluster.
+type maintenanceWindowModel struct {
+	UpgradeType types.String `tfsdk:"upgrade_type"`
+	Day         types.String `tfsdk:"day"`
+	Start       types.Int64  `tfsdk:"start"`
+	End         types.Int64  `tfsdk:"end"`
+}
+
+var maintenanceWindowType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"upgrade_type": types.StringType,
+	"day":          types.StringType,
+	"start":        types.Int64Type,
+	"end":          types.Int64Type,
+}}
+
+// clusterLink returns the link URL of a Boundary cluster, which is the ID of
+// the cluster resource.
+func clusterLink(projectID, clusterID string) string {
+	return fmt.Sprintf("/project/%s/%s/%s", projectID, boundaryClusterResourceType, clusterID)
+}
+
+// hcpClusterID returns the ID HCP assigned to a Boundary cluster, which is the
+// first label of the host of its URL. Self-managed workers identify the
+// cluster they connect to with it.
+func hcpClusterID(clusterURL string) string {
+	u, err := url.Parse(clusterURL)
+	if err != nil {
+		return ""
+	}
+	id, _, _ := strings.Cut(u.Hostname(), ".")
+	return id
+}
+
+// expandTier returns the marketing SKU of a cluster tier, such as `PLUS`.
+func expandTier(tier string) *boundarymodels.HashicorpCloudBoundary20211221ClusterMarketingSKU {
+	return boundarymodels.HashicorpCloudBoundary20211221ClusterMarketingSKU(tierPrefix + strings.ToUpper(tier)).Pointer()
+}
+
+// flattenTier returns the tier of a cluster from its marketing SKU, such as
+// `PLUS`. Its case differs from the configured tier, which is kept as it is
+// semantically equal.
+func flattenTier(sku boundarymodels.HashicorpCloudBoundary20211221ClusterMarketingSKU) customtypes.CaseInsensitiveStringValue {
+	return customtypes.NewCaseInsensitiveStringValue(strings.TrimPrefix(string(sku), tierPrefix))
+}
+
+// expandMaintenanceWindow returns the upgrade type and maintenance window of a
+// cluster from its maintenance window configuration. Clusters with scheduled
+// upgrades must have a maintenance window, which other clusters cannot have.
+func expandMaintenanceWindow(config maintenanceWindowModel) (*boundarymodels.HashicorpCloudBoundary20211221UpgradeType, *boundarymodels.HashicorpCloudBoundary20211221MaintenanceWindow, error) {
+	upgradeType := boundarymodels.HashicorpCloudBoundary20211221UpgradeType(upgradeTypePrefix + strings.ToUpper(config.UpgradeType.ValueString()))
+
+	day := config.Day.ValueString()
+	start := config.Start.ValueInt64()
+	end := config.End.ValueInt64()
+
+	if upgradeType != boundarymodels.HashicorpCloudBoundary20211221UpgradeTypeUPGRADETYPESCHEDULED {
+		if day != "" || start != 0 || end != 0 {
+			return nil, nil, fmt.Errorf("maintenance window configuration is invalid: `day` is only allowed on SCHEDULED upgrade type")
+		}
+		return &upgradeType, &boundarymodels.HashicorpCloudBoundary20211221MaintenanceWindow{}, nil
+	}
+
+	if day == "" {
+		return nil, nil, fmt.Errorf("maintenance window configuration is invalid: `day` is required for SCHEDULED upgrade type")
+	}
+	if start < 0 || start > 23 || end < 0 || end > 23 {
+		return nil, nil, fmt.Errorf("maintenance window configuration is invalid: `start` and `end` must be between 0 - 24 (inclusive) for SCHEDULED upgrade type")
+	}
+	if start >= end {
+		return nil, nil, fmt.Errorf("maintenance window configuration is invalid: `start` should be less than `end` for SCHEDULED upgrade type")
+	}
+
+	dayOfWeek := boundarymodels.HashicorpCloudBoundary20211221MaintenanceWindowDayOfWeek(dayOfWeekPrefix + strings.ToUpper(day))
+	return &upgradeType, &boundarymodels.HashicorpCloudBoundary20211221MaintenanceWindow{
+		DayOfWeek: &dayOfWeek,
+		Start:     int32(start),
+		End:       int32(end),
+	}, nil
+}
+
+// flattenMaintenanceWindow returns the maintenance window configuration of a
+// cluster from its upgrade type and maintenance window. The prior upgrade type
+// and day are kept when they only differ in case.
+func flattenMaintenanceWindow(prior maintenanceWindowModel, upgradeType *boundarymodels.HashicorpCloudBoundary20211221UpgradeType,
+	window *boundarymodels.HashicorpCloudBoundary20211221MaintenanceWindow) (maintenanceWindowModel, error) {
+
+	config := maintenanceWindowModel{
+		UpgradeType: types.StringNull(),
+		Day:         types.StringNull(),
+		Start:       types.Int64Null(),
+		End:         types.Int64Null(),
+	}
+	if upgradeType == nil {
+		return config, nil
+	}
+
+	config.UpgradeType = keepCase(prior.UpgradeType, strings.TrimPrefix(string(*upgradeType), upgradeTypePrefix))
+	if *upgradeType != boundarymodels.HashicorpCloudBoundary20211221UpgradeTypeUPGRADETYPESCHEDULED {
+		return config, nil
+	}
+
+	if window == nil || window.DayOfWeek == nil {
+		return config, fmt.Errorf("invalid maintenance window: missing configuration for SCHEDULED upgrade type")
+	}
+	config.Day = keepCase(prior.Day, strings.TrimPrefix(string(*window.DayOfWeek), dayOfWeekPrefix))
+	config.Start = types.Int64Value(int64(window.Start))
+	config.End = types.Int64Value(int64(window.End))
+	return config, nil
+}
+
+// expandControllerConfig returns the controller configuration of a cluster
+// from the time to live and time to stale of its auth tokens. Auth tokens
+// cannot become stale after they expire.
+func expandControllerConfig(timeToLive, timeToStale string) (*boundarymodels.HashicorpCloudBoundary20211221ControllerConfiguration, error) {
+	ttl, err := time.ParseDuration(timeToLive)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse auth_token_time_to_live to time: %v", err)
+	}
+	tts, err := time.ParseDuration(timeToStale)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse auth_token_time_to_stale to time: %v", err)
+	}
+
+	if ttl < tts {
+		return nil, fmt.Errorf("controller configuration is invalid: `auth_token_time_to_live` should be greater than or equal to `auth_token_time_to_stale`")
+	}
+
+	return &boundarymodels.HashicorpCloudBoundary20211221ControllerConfiguration{
+		AuthTokenTimeToLive:  ttl.String(),
+		AuthTokenTimeToStale: tts.String(),
+	}, nil
+}
+
+// flattenDuration returns a duration of the controller configuration of a
+// cluster. The prior value is kept when it is the same duration written
+// differently.
+func flattenDuration(prior types.String, value string) (types.String, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return prior, err
+	}
+
+	if priorDuration, err := time.ParseDuration(prior.ValueString()); err == nil && priorDuration == d {
+		return prior, nil
+	}
+	return types.StringValue(d.String()), nil
+}
+
+// keepCase returns the prior value when it only differs from the value in
+// case, and the value otherwise.
+func keepCase(prior types.String, value string) types.String {
+	if strings.EqualFold(prior.ValueString(), value) {
+		return prior
+	}
+	return types.StringValue(value)
+}
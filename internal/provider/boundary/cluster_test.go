@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boundary
+
+import (
+	"testing"
+
+	boundarymodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-boundary-service/stable/2021-12-21/models"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/customtypes"
+)
+
+func TestClusterLink(t *testing.T) {
+	link := clusterLink("f709ec73-55d4-46d8-897d-816ebba28778", "boundary-cluster")
+	assert.Equal(t, "/project/f709ec73-55d4-46d8-897d-816ebba28778/hashicorp.boundary.cluster/boundary-cluster", link)
+}
+
+func TestHCPClusterID(t *testing.T) {
+	assert.Equal(t, "2b5c2a0e-3c2d-4f8e-9a3e-0e6e2b7c1d4f",
+		hcpClusterID("https://2b5c2a0e-3c2d-4f8e-9a3e-0e6e2b7c1d4f.boundary.hashicorp.cloud"))
+	assert.Equal(t, "", hcpClusterID("://invalid"))
+}
+
+func TestTier(t *testing.T) {
+	assert.Equal(t, boundarymodels.HashicorpCloudBoundary20211221ClusterMarketingSKUCLUSTERMARKETINGSKUPLUS, *expandTier("PluS"))
+
+	assert.Equal(t, customtypes.NewCaseInsensitiveStringValue("PLUS"), flattenTier(*expandTier("plus")))
+}
+
+func TestMaintenanceWindow(t *testing.T) {
+	cases := map[string]struct {
+		config      maintenanceWindowModel
+		expectError bool
+	}{
+		"automatic": {
+			config: maintenanceWindowModel{UpgradeType: types.StringValue("automatic")},
+		},
+		"automatic with day": {
+			config:      maintenanceWindowModel{UpgradeType: types.StringValue("AUTOMATIC"), Day: types.StringValue("MONDAY")},
+			expectError: true,
+		},
+		"scheduled": {
+			config: maintenanceWindowModel{UpgradeType: types.StringValue("Scheduled"), Day: types.StringValue("tuesday"),
+				Start: types.Int64Value(2), End: types.Int64Value(12)},
+		},
+		"scheduled without day": {
+			config:      maintenanceWindowModel{UpgradeType: types.StringValue("SCHEDULED"), Start: types.Int64Value(2), End: types.Int64Value(12)},
+			expectError: true,
+		},
+		"scheduled ending before start": {
+			config: maintenanceWindowModel{UpgradeType: types.StringValue("SCHEDULED"), Day: types.StringValue("MONDAY"),
+				Start: types.Int64Value(12), End: types.Int64Value(2)},
+			expectError: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			upgradeType, window, err := expandMaintenanceWindow(c.config)
+			if c.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			config, err := flattenMaintenanceWindow(c.config, upgradeType, window)
+			require.NoError(t, err)
+			assert.Equal(t, c.config.UpgradeType, config.UpgradeType)
+			assert.Equal(t, c.config.Day.ValueString(), config.Day.ValueString())
+			assert.Equal(t, c.config.Start.ValueInt64(), config.Start.ValueInt64())
+			assert.Equal(t, c.config.End.ValueInt64(), config.End.ValueInt64())
+		})
+	}
+
+	scheduled := boundarymodels.HashicorpCloudBoundary20211221UpgradeTypeUPGRADETYPESCHEDULED
+	_, err := flattenMaintenanceWindow(maintenanceWindowModel{}, &scheduled, nil)
+	assert.Error(t, err)
+}
+
+func TestControllerConfig(t *testing.T) {
+	config, err := expandControllerConfig("12h", "60m")
+	require.NoError(t, err)
+	assert.Equal(t, "12h0m0s", config.AuthTokenTimeToLive)
+	assert.Equal(t, "1h0m0s", config.AuthTokenTimeToStale)
+
+	_, err = expandControllerConfig("1h", "12h")
+	assert.Error(t, err)
+
+	_, err = expandControllerConfig("twelve hours", "1h")
+	assert.Error(t, err)
+
+	ttl, err := flattenDuration(types.StringValue("12h"), "12h0m0s")
+	require.NoError(t, err)
+	assert.Equal(t, types.StringValue("12h"), ttl)
+
+	ttl, err = flattenDuration(types.StringNull(), "720h")
+	require.NoError(t, err)
+	assert.Equal(t, types.StringValue("720h0m0s"), ttl)
+}
@@ -0,0 +1,690 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boundary
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	boundarymodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-boundary-service/stable/2021-12-21/models"
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/timeouts"
+)
+
+// defaultClusterTimeout is the amount of time that can elapse before a
+// cluster read or update operation should timeout.
+const defaultClusterTimeout = time.Minute * 5
+
+// createClusterTimeout is the amount of time that can elapse before a cluster
+// create operation should timeout.
+const createClusterTimeout = time.Minute * 25
+
+// deleteClusterTimeout is the amount of time that can elapse before a cluster
+// delete operation should timeout.
+const deleteClusterTimeout = time.Minute * 25
+
+// clusterImportID is the import ID of a Boundary cluster: its ID, optionally
+// prefixed by the ID of its project.
+var clusterImportID = []hcpvalidator.ImportIDPart{
+	{Name: "project_id", Optional: true, Validators: []validator.String{hcpvalidator.UUID()}},
+	{Name: "cluster_id", Validators: []validator.String{hcpvalidator.Slug()}},
+}
+
+// clusterTimeouts are the operations whose timeout can be set in the
+// timeouts block of the Boundary cluster resource.
+var clusterTimeouts = []string{timeouts.Create, timeouts.Delete}
+
+type clusterModel struct {
+	ID                      types.String                           `tfsdk:"id"`
+	ClusterID               types.String                           `tfsdk:"cluster_id"`
+	Username                types.String                           `tfsdk:"username"`
+	Password                types.String                           `tfsdk:"password"`
+	ProjectID               types.String                           `tfsdk:"project_id"`
+	CreatedAt               types.String                           `tfsdk:"created_at"`
+	ClusterURL              types.String                           `tfsdk:"cluster_url"`
+	State                   types.String                           `tfsdk:"state"`
+	Tier                    customtypes.CaseInsensitiveStringValue `tfsdk:"tier"`
+	MaintenanceWindowConfig types.List                             `tfsdk:"maintenance_window_config"`
+	Version                 types.String                           `tfsdk:"version"`
+	AuthTokenTimeToLive     types.String                           `tfsdk:"auth_token_time_to_live"`
+	AuthTokenTimeToStale    types.String                           `tfsdk:"auth_token_time_to_stale"`
+	Timeouts                types.Object                           `tfsdk:"timeouts"`
+}
+
+var _ resource.Resource = &resourceCluster{}
+var _ resource.ResourceWithConfigure = &resourceCluster{}
+var _ resource.ResourceWithValidateConfig = &resourceCluster{}
+var _ resource.ResourceWithModifyPlan = &resourceCluster{}
+var _ resource.ResourceWithImportState = &resourceCluster{}
+var _ resource.ResourceWithIdentity = &resourceCluster{}
+
+func NewClusterResource() resource.Resource {
+	return &resourceCluster{}
+}
+
+type resourceCluster struct {
+	client *clients.Client
+}
+
+func (r *resourceCluster) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_boundary_cluster"
+}
+
+func (r *resourceCluster) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource allows you to manage an HCP Boundary cluster",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the Boundary cluster resource, a link URL identifying the cluster.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the Boundary cluster",
+				Required:    true,
+				Validators: []validator.String{
+					hcpvalidator.Slug(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The username of the initial admin user. This must be at least 3 characters in length, alphanumeric, hyphen, or period.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9.-]{3,}$`),
+						"invalid boundary username; login name must be all-lowercase alphanumeric, period or hyphen, and at least 3 characters."),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "The password of the initial admin user. This must be at least 8 characters in length. Note that this may show up in logs, and it will be stored in the state file.",
+				Required:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the Boundary cluster is located. If not specified, the project configured in the HCP provider config block is used.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					hcpvalidator.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"created_at":  computedString("The time that the Boundary cluster was created."),
+			"cluster_url": computedString("A unique URL identifying the Boundary cluster."),
+			"state":       computedString("The state of the Boundary cluster."),
+			"tier": schema.StringAttribute{
+				Description: "The tier that the HCP Boundary cluster will be provisioned as, 'Standard' or 'Plus'. Changing the tier replaces the cluster.",
+				Required:    true,
+				CustomType:  customtypes.CaseInsensitiveStringType{},
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("STANDARD", "PLUS"),
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.CaseInsensitive(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": computedString("The version of the Boundary cluster."),
+			"auth_token_time_to_live": schema.StringAttribute{
+				Description: "The time to live for the auth token in golang's time.Duration string format.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultAuthTokenTimeToLive),
+				Validators: []validator.String{
+					durationValidator(),
+					stringvalidator.AlsoRequires(path.MatchRoot("auth_token_time_to_stale")),
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.DurationEquivalent(),
+				},
+			},
+			"auth_token_time_to_stale": schema.StringAttribute{
+				Description: "The time to stale for the auth token in golang's time.Duration string format.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultAuthTokenTimeToStale),
+				Validators: []validator.String{
+					durationValidator(),
+					stringvalidator.AlsoRequires(path.MatchRoot("auth_token_time_to_live")),
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.DurationEquivalent(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"maintenance_window_config": schema.ListNestedBlock{
+				Description: "The maintenance window configuration for when cluster upgrades can take place. If not specified, the configuration of the cluster is left unchanged. " +
+					"It is refreshed from the cluster when it is specified, and when the cluster is imported.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"upgrade_type": schema.StringAttribute{
+							Description: "The upgrade type for the cluster. Valid options for upgrade type - `AUTOMATIC`, `SCHEDULED`",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("AUTOMATIC"),
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive("SCHEDULED", "AUTOMATIC"),
+							},
+							PlanModifiers: []planmodifier.String{
+								modifiers.CaseInsensitive(),
+							},
+						},
+						"day": schema.StringAttribute{
+							Description: "The maintenance day of the week for scheduled upgrades. Valid options for maintenance window day - `MONDAY`, `TUESDAY`, `WEDNESDAY`, `THURSDAY`, `FRIDAY`, `SATURDAY`, `SUNDAY`",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive("MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"),
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("start")),
+							},
+							PlanModifiers: []planmodifier.String{
+								modifiers.CaseInsensitive(),
+							},
+						},
+						"start": schema.Int64Attribute{
+							Description: "The start time which upgrades can be performed. Uses 24H clock and must be in UTC time zone. Valid options include - 0 to 23 (inclusive)",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 23),
+								int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("day")),
+							},
+						},
+						"end": schema.Int64Attribute{
+							Description: "The end time which upgrades can be performed. Uses 24H clock and must be in UTC time zone. Valid options include - 1 to 24 (inclusive)",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 24),
+								int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("start")),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(clusterTimeouts...),
+		},
+	}
+}
+
+// computedString returns a computed string attribute which keeps its value
+// until the cluster is refreshed or replaced.
+func computedString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *resourceCluster) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(clusterImportID...)
+}
+
+func (r *resourceCluster) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig validates the maintenance window and the controller
+// configuration of the cluster.
+func (r *resourceCluster) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config clusterModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if window, ok, diags := maintenanceWindowConfig(ctx, config.MaintenanceWindowConfig); ok && !diags.HasError() {
+		if !window.UpgradeType.IsUnknown() && !window.Day.IsUnknown() && !window.Start.IsUnknown() && !window.End.IsUnknown() {
+			if window.UpgradeType.IsNull() {
+				window.UpgradeType = types.StringValue("AUTOMATIC")
+			}
+			if _, _, err := expandMaintenanceWindow(window); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("maintenance_window_config"), "Invalid maintenance window configuration", err.Error())
+			}
+		}
+	} else {
+		resp.Diagnostics.Append(diags...)
+	}
+
+	ttl, tts := config.AuthTokenTimeToLive, config.AuthTokenTimeToStale
+	if ttl.IsNull() || ttl.IsUnknown() || tts.IsNull() || tts.IsUnknown() {
+		return
+	}
+	if _, err := expandControllerConfig(ttl.ValueString(), tts.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("auth_token_time_to_live"), "Invalid controller configuration", err.Error())
+	}
+}
+
+func (r *resourceCluster) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	modifiers.ModifyPlanForDefaultProjectChange(ctx, r.client.Config.ProjectID, req.State, req.Config, req.Plan, resp)
+}
+
+func (r *resourceCluster) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan clusterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := timeouts.Get(ctx, plan.Timeouts, timeouts.Create, createClusterTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if plan.ProjectID.IsUnknown() {
+		plan.ProjectID = types.StringValue(r.client.Config.ProjectID)
+	}
+
+	clusterID := plan.ClusterID.ValueString()
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: r.client.Config.OrganizationID,
+		ProjectID:      plan.ProjectID.ValueString(),
+		// This is currently hardcoded, depending on decisions from PM
+		// around regionality this may have to turn into an input
+		Region: &sharedmodels.HashicorpCloudLocationRegion{
+			Provider: "aws",
+			Region:   "us-east-1",
+		},
+	}
+
+	controllerConfig, err := expandControllerConfig(plan.AuthTokenTimeToLive.ValueString(), plan.AuthTokenTimeToStale.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("auth_token_time_to_live"), "Invalid controller configuration", err.Error())
+		return
+	}
+
+	window, configured, diags := maintenanceWindowConfig(ctx, plan.MaintenanceWindowConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// check for an existing boundary cluster
+	_, err = clients.GetBoundaryClusterByID(ctx, r.client, loc, clusterID)
+	if err == nil {
+		resp.Diagnostics.AddError("Boundary cluster already exists",
+			fmt.Sprintf("A Boundary cluster with cluster_id=%q in project_id=%q already exists.", clusterID, loc.ProjectID))
+		return
+	}
+	if !clients.IsResponseCodeNotFound(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to check for presence of an existing Boundary cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Creating Boundary cluster", map[string]any{"cluster_id": clusterID})
+
+	createResp, err := clients.CreateBoundaryCluster(ctx, r.client, loc, &boundarymodels.HashicorpCloudBoundary20211221CreateRequest{
+		ClusterID:        clusterID,
+		Username:         plan.Username.ValueString(),
+		Password:         plan.Password.ValueString(),
+		Location:         loc,
+		MarketingSku:     expandTier(plan.Tier.ValueString()),
+		ControllerConfig: controllerConfig,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to create Boundary cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	if err := clients.WaitForOperation(ctx, r.client, "create Boundary cluster", loc, createResp.Operation.ID); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to create Boundary cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Created Boundary cluster", map[string]any{"cluster_id": clusterID})
+
+	// The cluster is kept in the state if its maintenance window cannot be
+	// set, so that it is not left unmanaged.
+	if configured {
+		if err := r.setMaintenanceWindow(ctx, loc, clusterID, window); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("maintenance_window_config"),
+				fmt.Sprintf("Error setting maintenance window configuration for Boundary cluster (%s)", clusterID), err.Error())
+		}
+	}
+
+	if _, diags := r.read(ctx, &plan, configured); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *resourceCluster) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
+	var state clusterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := timeouts.Get(ctx, state.Timeouts, timeouts.Read, defaultClusterTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// The maintenance window is refreshed if it is managed, or if the cluster
+	// was just imported and it is still unknown.
+	withMaintenanceWindow := state.MaintenanceWindowConfig.IsNull() || len(state.MaintenanceWindowConfig.Elements()) > 0
+
+	found, diags := r.read(ctx, &state, withMaintenanceWindow)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, "Boundary cluster not found, removing from state", map[string]any{"cluster_id": state.ClusterID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceCluster) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state clusterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := timeouts.Get(ctx, plan.Timeouts, timeouts.Update, defaultClusterTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	clusterID := state.ClusterID.ValueString()
+	loc := r.location(state.ProjectID)
+
+	cluster, err := clients.GetBoundaryClusterByID(ctx, r.client, loc, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Boundary cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	window, configured, diags := maintenanceWindowConfig(ctx, plan.MaintenanceWindowConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if configured && !plan.MaintenanceWindowConfig.Equal(state.MaintenanceWindowConfig) {
+		tflog.Info(ctx, "Updating maintenance window for Boundary cluster", map[string]any{"cluster_id": clusterID})
+
+		if err := r.setMaintenanceWindow(ctx, cluster.Location, clusterID, window); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("maintenance_window_config"),
+				fmt.Sprintf("Error setting maintenance window configuration for Boundary cluster (%s)", clusterID), err.Error())
+			return
+		}
+	}
+
+	if !plan.AuthTokenTimeToLive.Equal(state.AuthTokenTimeToLive) || !plan.AuthTokenTimeToStale.Equal(state.AuthTokenTimeToStale) {
+		controllerConfig, err := expandControllerConfig(plan.AuthTokenTimeToLive.ValueString(), plan.AuthTokenTimeToStale.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("auth_token_time_to_live"), "Invalid controller configuration", err.Error())
+			return
+		}
+
+		tflog.Info(ctx, "Updating controller configuration for Boundary cluster", map[string]any{"cluster_id": clusterID})
+
+		err = clients.UpdateBoundaryClusterControllerConfig(ctx, r.client, loc, clusterID, &boundarymodels.HashicorpCloudBoundary20211221UpdateControllerConfigurationRequest{
+			ClusterID: cluster.ClusterID,
+			Location:  cluster.Location,
+			Config:    controllerConfig,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error updating controller configuration for Boundary cluster (%s)", clusterID), err.Error())
+			return
+		}
+	}
+
+	if _, diags := r.read(ctx, &plan, configured); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceCluster) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state clusterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := timeouts.Get(ctx, state.Timeouts, timeouts.Delete, deleteClusterTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	clusterID := state.ClusterID.ValueString()
+	loc := r.location(state.ProjectID)
+
+	tflog.Info(ctx, "Deleting Boundary cluster", map[string]any{"cluster_id": clusterID})
+
+	deleteResp, err := clients.DeleteBoundaryCluster(ctx, r.client, loc, clusterID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			tflog.Warn(ctx, "Boundary cluster not found, so no action was taken", map[string]any{"cluster_id": clusterID})
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to delete Boundary cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	if err := clients.WaitForOperation(ctx, r.client, "delete Boundary cluster", loc, deleteResp.Operation.ID); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to delete Boundary cluster (%s)", clusterID), err.Error())
+	}
+}
+
+// ImportState imports a Boundary cluster from an import ID in the format
+// [{project_id}:]{cluster_id}, or from its identity. The credentials of the
+// initial admin user of an imported cluster are unknown.
+func (r *resourceCluster) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, diags := identity.ImportID(ctx, req, clusterImportID...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, clusterID := parts[0], parts[1]
+	if projectID == "" {
+		projectID = r.client.Config.ProjectID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), clusterID)...)
+}
+
+func (r *resourceCluster) location(projectID types.String) *sharedmodels.HashicorpCloudLocationLocation {
+	return &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: r.client.Config.OrganizationID,
+		ProjectID:      projectID.ValueString(),
+	}
+}
+
+// setMaintenanceWindow sets the upgrade type and maintenance window of a
+// cluster.
+func (r *resourceCluster) setMaintenanceWindow(ctx context.Context, loc *sharedmodels.HashicorpCloudLocationLocation, clusterID string, config maintenanceWindowModel) error {
+	upgradeType, window, err := expandMaintenanceWindow(config)
+	if err != nil {
+		return err
+	}
+
+	return clients.SetBoundaryClusterMaintenanceWindow(ctx, r.client, loc, clusterID, &boundarymodels.HashicorpCloudBoundary20211221MaintenanceWindowUpdateRequest{
+		ClusterID:         clusterID,
+		Location:          loc,
+		UpgradeType:       upgradeType,
+		MaintenanceWindow: window,
+	})
+}
+
+// read refreshes the model from the cluster, its maintenance window and its
+// controller configuration. It returns false if the cluster does not exist.
+// The maintenance window is only refreshed if withMaintenanceWindow is true,
+// so that it is left unset when it is not configured.
+func (r *resourceCluster) read(ctx context.Context, model *clusterModel, withMaintenanceWindow bool) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	loc := r.location(model.ProjectID)
+	clusterID := model.ClusterID.ValueString()
+
+	tflog.Info(ctx, "Reading Boundary cluster", map[string]any{"cluster_id": clusterID, "project_id": loc.ProjectID})
+
+	cluster, err := clients.GetBoundaryClusterByID(ctx, r.client, loc, clusterID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			return false, diags
+		}
+		diags.AddError(fmt.Sprintf("Unable to fetch Boundary cluster (%s)", clusterID), err.Error())
+		return false, diags
+	}
+
+	// The Boundary cluster was already deleted.
+	if cluster.State != nil && *cluster.State == boundarymodels.HashicorpCloudBoundary20211221ClusterStateSTATEDELETED {
+		return false, diags
+	}
+
+	model.ID = types.StringValue(clusterLink(loc.ProjectID, cluster.ClusterID))
+	model.ClusterID = types.StringValue(cluster.ClusterID)
+	model.CreatedAt = types.StringValue(cluster.CreatedAt.String())
+	model.ClusterURL = types.StringValue(cluster.ClusterURL)
+	model.Version = types.StringValue(cluster.BoundaryVersion)
+	if cluster.MarketingSku != nil {
+		model.Tier = flattenTier(*cluster.MarketingSku)
+	}
+	model.State = types.StringNull()
+	if cluster.State != nil {
+		model.State = types.StringValue(string(*cluster.State))
+	}
+
+	controllerConfig, err := clients.GetBoundaryClusterControllerConfigByID(ctx, r.client, loc, clusterID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to fetch controller configuration for Boundary cluster (%s)", clusterID), err.Error())
+		return true, diags
+	}
+	if model.AuthTokenTimeToLive, err = flattenDuration(model.AuthTokenTimeToLive, controllerConfig.AuthTokenTimeToLive); err != nil {
+		diags.AddError("Unable to parse auth_token_time_to_live to time", err.Error())
+	}
+	if model.AuthTokenTimeToStale, err = flattenDuration(model.AuthTokenTimeToStale, controllerConfig.AuthTokenTimeToStale); err != nil {
+		diags.AddError("Unable to parse auth_token_time_to_stale to time", err.Error())
+	}
+
+	if !withMaintenanceWindow {
+		return true, diags
+	}
+
+	upgradeType, window, err := clients.GetBoundaryClusterMaintenanceWindow(ctx, r.client, loc, clusterID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to fetch maintenance window for Boundary cluster (%s)", clusterID), err.Error())
+		return true, diags
+	}
+
+	prior, _, d := maintenanceWindowConfig(ctx, model.MaintenanceWindowConfig)
+	diags.Append(d...)
+	config, err := flattenMaintenanceWindow(prior, upgradeType, window)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to read maintenance window for Boundary cluster (%s)", clusterID), err.Error())
+		return true, diags
+	}
+
+	model.MaintenanceWindowConfig, d = types.ListValueFrom(ctx, maintenanceWindowType, []maintenanceWindowModel{config})
+	diags.Append(d...)
+	return true, diags
+}
+
+// maintenanceWindowConfig returns the maintenance window configuration of the
+// maintenance_window_config block, and whether it is set.
+func maintenanceWindowConfig(ctx context.Context, list types.List) (maintenanceWindowModel, bool, diag.Diagnostics) {
+	var config maintenanceWindowModel
+	if list.IsNull() || list.IsUnknown() || len(list.Elements()) == 0 {
+		return config, false, nil
+	}
+
+	object, ok := list.Elements()[0].(types.Object)
+	if !ok || object.IsNull() || object.IsUnknown() {
+		return config, false, nil
+	}
+
+	diags := object.As(ctx, &config, basetypes.ObjectAsOptions{})
+	return config, !diags.HasError(), diags
+}
+
+// durationValidator returns a validator of Go duration strings.
+func durationValidator() validator.String {
+	return stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`),
+		"must be a valid duration, such as 24h0m0s")
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boundary_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+)
+
+// TestAccBoundaryCluster_Migration checks that clusters created with the last
+// release of the provider, where hcp_boundary_cluster used the SDKv2, do not
+// change once managed by the framework resource.
+func TestAccBoundaryCluster_Migration(t *testing.T) {
+	name := "boundary-migration-" + acctest.RandString(8)
+	config := testAccBoundaryClusterConfig(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"hcp": {
+						VersionConstraint: "~> 0.114.0",
+						Source:            "hashicorp/hcp",
+					},
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hcp_boundary_cluster.test", "cluster_id", name),
+					resource.TestCheckResourceAttr("hcp_boundary_cluster.test", "maintenance_window_config.0.upgrade_type", "SCHEDULED"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Config:                   config,
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
+
+func testAccBoundaryClusterConfig(name string) string {
+	return fmt.Sprintf(`
+resource "hcp_boundary_cluster" "test" {
+  cluster_id = "%[1]s"
+  username   = "test-user"
+  password   = "password123!"
+  tier       = "PLUS"
+
+  auth_token_time_to_live  = "12h0m0s"
+  auth_token_time_to_stale = "1h0m0s"
+
+  maintenance_window_config {
+    day          = "TUESDAY"
+    start        = 2
+    end          = 12
+    upgrade_type = "SCHEDULED"
+  }
+}
+`, name)
+}
@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boundary
+
+import (
+	"context"
+	"fmt"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
+)
+
+type workerModel struct {
+	ID                   types.String `tfsdk:"id"`
+	ProjectID            types.String `tfsdk:"project_id"`
+	ClusterID            types.String `tfsdk:"cluster_id"`
+	AuthMethodID         types.String `tfsdk:"auth_method_id"`
+	LoginName            types.String `tfsdk:"login_name"`
+	Password             types.String `tfsdk:"password"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	ActivationToken      types.String `tfsdk:"activation_token"`
+	HCPBoundaryClusterID types.String `tfsdk:"hcp_boundary_cluster_id"`
+	Type                 types.String `tfsdk:"type"`
+	Address              types.String `tfsdk:"address"`
+	ReleaseVersion       types.String `tfsdk:"release_version"`
+}
+
+var _ resource.Resource = &resourceWorker{}
+var _ resource.ResourceWithConfigure = &resourceWorker{}
+var _ resource.ResourceWithModifyPlan = &resourceWorker{}
+
+func NewWorkerResource() resource.Resource {
+	return &resourceWorker{}
+}
+
+type resourceWorker struct {
+	client *clients.Client
+}
+
+func (r *resourceWorker) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_boundary_worker"
+}
+
+func (r *resourceWorker) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource registers a self-managed worker in an HCP Boundary cluster. " +
+			"The worker authenticates to the cluster the first time it connects with the returned activation token.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the worker in the Boundary cluster.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the Boundary cluster is located. If not specified, the project configured in the HCP provider config block is used.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					hcpvalidator.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the Boundary cluster the worker is registered in.",
+				Required:    true,
+				Validators: []validator.String{
+					hcpvalidator.Slug(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auth_method_id": schema.StringAttribute{
+				Description: "The ID of the password auth method used to authenticate to the Boundary cluster. If not specified, the primary password auth method of the global scope is used.",
+				Optional:    true,
+			},
+			"login_name": schema.StringAttribute{
+				Description: "The login name of the user used to authenticate to the Boundary cluster, such as the username of the initial admin user.",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The password of the user used to authenticate to the Boundary cluster. Note that it will be stored in the state file.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the worker.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the worker.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"activation_token": schema.StringAttribute{
+				Description: "The activation token of the worker, set as `controller_generated_activation_token` in the worker configuration. It can only be used once.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hcp_boundary_cluster_id": schema.StringAttribute{
+				Description: "The ID of the Boundary cluster to set as `hcp_boundary_cluster_id` in the worker configuration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the worker.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Description: "The address of the worker, once it has connected to the Boundary cluster.",
+				Computed:    true,
+			},
+			"release_version": schema.StringAttribute{
+				Description: "The version of Boundary the worker runs, once it has connected to the Boundary cluster.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *resourceWorker) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *resourceWorker) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	modifiers.ModifyPlanForDefaultProjectChange(ctx, r.client.Config.ProjectID, req.State, req.Config, req.Plan, resp)
+}
+
+func (r *resourceWorker) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan workerModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectID.IsUnknown() {
+		plan.ProjectID = types.StringValue(r.client.Config.ProjectID)
+	}
+
+	client, clusterURL, diags := r.boundaryClient(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if clusterURL == "" {
+		resp.Diagnostics.AddError("Boundary cluster not found",
+			fmt.Sprintf("No Boundary cluster with cluster_id=%q exists in project_id=%q.", plan.ClusterID.ValueString(), plan.ProjectID.ValueString()))
+		return
+	}
+
+	tflog.Info(ctx, "Registering Boundary worker", map[string]any{"cluster_id": plan.ClusterID.ValueString()})
+
+	worker, err := client.CreateControllerLedBoundaryWorker(ctx, plan.Name.ValueString(), plan.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to register worker in Boundary cluster (%s)", plan.ClusterID.ValueString()), err.Error())
+		return
+	}
+
+	plan.ActivationToken = types.StringValue(worker.ControllerGeneratedActivationToken)
+	plan.HCPBoundaryClusterID = types.StringValue(hcpClusterID(clusterURL))
+	flattenWorker(&plan, worker)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceWorker) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workerModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, clusterURL, diags := r.boundaryClient(ctx, state)
+	if clusterURL == "" && !diags.HasError() {
+		tflog.Warn(ctx, "Boundary cluster not found, removing worker from state", map[string]any{"cluster_id": state.ClusterID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	worker, err := client.GetBoundaryWorker(ctx, state.ID.ValueString())
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			tflog.Warn(ctx, "Boundary worker not found, removing from state", map[string]any{"worker_id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Boundary worker (%s)", state.ID.ValueString()), err.Error())
+		return
+	}
+
+	state.HCPBoundaryClusterID = types.StringValue(hcpClusterID(clusterURL))
+	flattenWorker(&state, worker)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceWorker) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state workerModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, clusterURL, diags := r.boundaryClient(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if clusterURL == "" {
+		resp.Diagnostics.AddError("Boundary cluster not found",
+			fmt.Sprintf("No Boundary cluster with cluster_id=%q exists in project_id=%q.", plan.ClusterID.ValueString(), plan.ProjectID.ValueString()))
+		return
+	}
+
+	workerID := state.ID.ValueString()
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		worker, err := client.GetBoundaryWorker(ctx, workerID)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Boundary worker (%s)", workerID), err.Error())
+			return
+		}
+
+		tflog.Info(ctx, "Updating Boundary worker", map[string]any{"worker_id": workerID})
+
+		worker, err = client.UpdateBoundaryWorker(ctx, workerID, worker.Version, plan.Name.ValueString(), plan.Description.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to update Boundary worker (%s)", workerID), err.Error())
+			return
+		}
+		flattenWorker(&plan, worker)
+	} else {
+		plan.Address = state.Address
+		plan.ReleaseVersion = state.ReleaseVersion
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceWorker) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state workerModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, clusterURL, diags := r.boundaryClient(ctx, state)
+	if clusterURL == "" && !diags.HasError() {
+		tflog.Warn(ctx, "Boundary cluster not found, so no action was taken", map[string]any{"cluster_id": state.ClusterID.ValueString()})
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workerID := state.ID.ValueString()
+	tflog.Info(ctx, "Deleting Boundary worker", map[string]any{"worker_id": workerID})
+
+	if err := client.DeleteBoundaryWorker(ctx, workerID); err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			tflog.Warn(ctx, "Boundary worker not found, so no action was taken", map[string]any{"worker_id": workerID})
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to delete Boundary worker (%s)", workerID), err.Error())
+	}
+}
+
+// boundaryClient returns a client of the API of the Boundary cluster of the
+// worker, authenticated with the configured credentials, and the URL of the
+// cluster. The URL is empty if the cluster does not exist.
+func (r *resourceWorker) boundaryClient(ctx context.Context, model workerModel) (*clients.BoundaryAPIClient, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	clusterID := model.ClusterID.ValueString()
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: r.client.Config.OrganizationID,
+		ProjectID:      model.ProjectID.ValueString(),
+	}
+
+	cluster, err := clients.GetBoundaryClusterByID(ctx, r.client, loc, clusterID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			return nil, "", diags
+		}
+		diags.AddError(fmt.Sprintf("Unable to fetch Boundary cluster (%s)", clusterID), err.Error())
+		return nil, "", diags
+	}
+
+	client, err := clients.NewBoundaryAPIClient(ctx, cluster.ClusterURL, model.AuthMethodID.ValueString(), model.LoginName.ValueString(), model.Password.ValueString())
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to authenticate to Boundary cluster (%s)", clusterID), err.Error())
+		return nil, cluster.ClusterURL, diags
+	}
+	return client, cluster.ClusterURL, diags
+}
+
+// flattenWorker sets the attributes of the model from a worker. The
+// activation token is only returned when the worker is registered, so it is
+// left as it is.
+func flattenWorker(model *workerModel, worker *clients.BoundaryWorker) {
+	model.ID = types.StringValue(worker.ID)
+	model.Type = types.StringValue(worker.Type)
+	model.Address = types.StringValue(worker.Address)
+	model.ReleaseVersion = types.StringValue(worker.ReleaseVersion)
+	model.Name = optionalString(worker.Name)
+	model.Description = optionalString(worker.Description)
+}
+
+// optionalString returns a null string for empty values of optional
+// attributes.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
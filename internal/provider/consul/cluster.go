@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package consul
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	semver "github.com/hashicorp/go-version"
+	consulmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-consul-service/stable/2021-02-04/models"
+
+	consulversions "github.com/hashicorp/terraform-provider-hcp/internal/consul"
+	"github.com/hashicorp/terraform-provider-hcp/internal/input"
+)
+
+// consulClusterResourceType is the resource type of a Consul cluster, used in
+// the links identifying clusters.
+const consulClusterResourceType = "hashicorp.consul.cluster"
+
+// consulSnapshotResourceType is the resource type of a Consul snapshot, used in
+// the links identifying snapshots.
+const consulSnapshotResourceType = "hashicorp.consul.snapshot"
+
+// clusterLinkRegexp matches the link URL of a Consul cluster, capturing the ID
+// of its project and its ID.
+var clusterLinkRegexp = regexp.MustCompile(`^/project/([^/]+)/hashicorp\.consul\.cluster/([^/]+)$`)
+
+// snapshotIntervalRegexp matches the interval between the scheduled snapshots
+// of a Consul cluster, in hours.
+var snapshotIntervalRegexp = regexp.MustCompile(`^[1-9][0-9]*h$`)
+
+// snapshotRetentionRegexp matches the retention of the scheduled snapshots of
+// a Consul cluster, in days.
+var snapshotRetentionRegexp = regexp.MustCompile(`^[1-9][0-9]*d$`)
+
+const (
+	// defaultSnapshotInterval is the interval between the scheduled snapshots
+	// of a Consul cluster when it is not configured.
+	defaultSnapshotInterval = "24h"
+
+	// defaultSnapshotRetention is the retention of the scheduled snapshots of
+	// a Consul cluster when it is not configured.
+	defaultSnapshotRetention = "30d"
+)
+
+// clusterTiers are the tiers of the Consul clusters.
+var clusterTiers = []string{
+	string(consulmodels.HashicorpCloudConsul20210204ClusterConfigTierDEVELOPMENT),
+	string(consulmodels.HashicorpCloudConsul20210204ClusterConfigTierSTANDARD),
+	string(consulmodels.HashicorpCloudConsul20210204ClusterConfigTierPLUS),
+	string(consulmodels.HashicorpCloudConsul20210204ClusterConfigTierPREMIUM),
+}
+
+// clusterSizes are the sizes of the Consul clusters, from the smallest to the
+// largest.
+var clusterSizes = []string{
+	string(consulmodels.HashicorpCloudConsul20210204CapacityConfigSizeXSMALL),
+	string(consulmodels.HashicorpCloudConsul20210204CapacityConfigSizeSMALL),
+	string(consulmodels.HashicorpCloudConsul20210204CapacityConfigSizeMEDIUM),
+	string(consulmodels.HashicorpCloudConsul20210204CapacityConfigSizeLARGE),
+}
+
+// clusterLink returns the link URL of a Consul cluster, which is both the ID
+// and the self link of the cluster resource.
+func clusterLink(projectID, clusterID string) string {
+	return fmt.Sprintf("/project/%s/%s/%s", projectID, consulClusterResourceType, clusterID)
+}
+
+// parseClusterLink returns the ID of the project and the ID of the Consul
+// cluster identified by a link URL.
+func parseClusterLink(link string) (string, string, error) {
+	matches := clusterLinkRegexp.FindStringSubmatch(link)
+	if matches == nil {
+		return "", "", fmt.Errorf("url %q is not in the correct format: /project/{project_id}/%s/{id}", link, consulClusterResourceType)
+	}
+	return matches[1], matches[2], nil
+}
+
+// validateTierSize returns an error if a Consul cluster of the given tier
+// cannot have the given size: development clusters are extra small, and
+// clusters of the other tiers are small, medium or large.
+func validateTierSize(tier, size string) error {
+	development := strings.EqualFold(tier, string(consulmodels.HashicorpCloudConsul20210204ClusterConfigTierDEVELOPMENT))
+	xSmall := strings.EqualFold(size, string(consulmodels.HashicorpCloudConsul20210204CapacityConfigSizeXSMALL))
+
+	switch {
+	case development && !xSmall:
+		return fmt.Errorf("development tier clusters must be of size x_small, got %s", strings.ToLower(size))
+	case !development && xSmall:
+		return fmt.Errorf("%s tier clusters must be of size small, medium or large, got x_small", strings.ToLower(tier))
+	}
+	return nil
+}
+
+// validateSizeChange returns an error if an existing Consul cluster cannot be
+// scaled from the prior size to the planned size. Clusters can only be scaled
+// up.
+func validateSizeChange(prior, planned string) error {
+	priorIndex := slices.Index(clusterSizes, strings.ToUpper(prior))
+	plannedIndex := slices.Index(clusterSizes, strings.ToUpper(planned))
+	if priorIndex < 0 || plannedIndex < 0 || plannedIndex >= priorIndex {
+		return nil
+	}
+
+	return fmt.Errorf("the size of a cluster can only be increased, it cannot be scaled down from %s to %s; "+
+		"replace the cluster to reduce its size", strings.ToLower(prior), strings.ToLower(planned))
+}
+
+// upgradeRequested returns whether the configured minimum Consul version is
+// greater than the current version of a cluster, in which case the cluster
+// is upgraded to it. Lower minimum versions are already satisfied.
+func upgradeRequested(minVersion, currentVersion string) bool {
+	if minVersion == "" {
+		return false
+	}
+
+	minimum, err := semver.NewVersion(minVersion)
+	if err != nil {
+		return false
+	}
+	current, err := semver.NewVersion(currentVersion)
+	if err != nil {
+		return true
+	}
+	return minimum.GreaterThan(current)
+}
+
+// targetVersion returns the Consul version a cluster is created with or
+// upgraded to, among the given available versions: the latest patch of the
+// minimum version if one is given, or the recommended version otherwise.
+func targetVersion(minVersion string, versions []*consulmodels.HashicorpCloudConsul20210204Version) (string, error) {
+	if len(versions) == 0 {
+		return "", fmt.Errorf("no Consul versions are available; the cluster may already be on the latest Consul version supported by HCP")
+	}
+
+	version := consulversions.RecommendedVersion(versions)
+	if minVersion != "" {
+		version = input.NormalizeVersion(minVersion)
+
+		// Attempt to get the latest patch version of the given minimum version.
+		if patch := consulversions.GetLatestPatch(version, versions); patch != "" {
+			version = input.NormalizeVersion(patch)
+		}
+	}
+
+	if !consulversions.IsValidVersion(version, versions) {
+		return "", fmt.Errorf("specified Consul version (%s) is unavailable; must be one of: [%s]", version, consulversions.VersionsToString(versions))
+	}
+	return version, nil
+}
+
+// expandSnapshotConfig returns the snapshot configuration of a Consul cluster
+// from the interval between its scheduled snapshots, in hours, and their
+// retention, in days. The API takes durations in seconds. Empty values are
+// omitted, so that HCP keeps their current value.
+func expandSnapshotConfig(interval, retention string) (consulmodels.HashicorpCloudConsul20210204SnapshotConfig, error) {
+	config := map[string]any{}
+
+	if interval != "" {
+		hours, err := parseDurationUnits(interval, snapshotIntervalRegexp, "h")
+		if err != nil {
+			return nil, fmt.Errorf("invalid snapshot interval %q, must be a number of hours such as 24h", interval)
+		}
+		config["interval"] = fmt.Sprintf("%.0fs", (time.Duration(hours) * time.Hour).Seconds())
+	}
+
+	if retention != "" {
+		days, err := parseDurationUnits(retention, snapshotRetentionRegexp, "d")
+		if err != nil {
+			return nil, fmt.Errorf("invalid snapshot retention %q, must be a number of days such as 30d", retention)
+		}
+		config["retention"] = fmt.Sprintf("%.0fs", (time.Duration(days) * 24 * time.Hour).Seconds())
+	}
+
+	return config, nil
+}
+
+// flattenSnapshotConfig returns the interval between the scheduled snapshots
+// of a Consul cluster, in hours, and their retention, in days, from its
+// snapshot configuration. Values that are not reported, or that are not a
+// whole number of hours or days, are returned empty.
+func flattenSnapshotConfig(config consulmodels.HashicorpCloudConsul20210204SnapshotConfig) (string, string) {
+	fields, ok := config.(map[string]any)
+	if !ok {
+		return "", ""
+	}
+
+	duration := func(key string, unit time.Duration) int64 {
+		value, ok := fields[key].(string)
+		if !ok {
+			return 0
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil || d <= 0 || d%unit != 0 {
+			return 0
+		}
+		return int64(d / unit)
+	}
+
+	var interval, retention string
+	if hours := duration("interval", time.Hour); hours > 0 {
+		interval = fmt.Sprintf("%dh", hours)
+	}
+	if days := duration("retention", 24*time.Hour); days > 0 {
+		retention = fmt.Sprintf("%dd", days)
+	}
+	return interval, retention
+}
+
+// parseDurationUnits returns the number of units of a duration such as 24h or
+// 30d, which must match the given regular expression.
+func parseDurationUnits(value string, re *regexp.Regexp, unit string) (int64, error) {
+	if !re.MatchString(value) {
+		return 0, fmt.Errorf("%q does not match %s", value, re)
+	}
+	return strconv.ParseInt(strings.TrimSuffix(value, unit), 10, 64)
+}
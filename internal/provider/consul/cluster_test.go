@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package consul
+
+import (
+	"testing"
+
+	consulmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-consul-service/stable/2021-02-04/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterLink(t *testing.T) {
+	link := clusterLink("f709ec73-55d4-46d8-897d-816ebba28778", "consul-cluster")
+	assert.Equal(t, "/project/f709ec73-55d4-46d8-897d-816ebba28778/hashicorp.consul.cluster/consul-cluster", link)
+
+	projectID, clusterID, err := parseClusterLink(link)
+	require.NoError(t, err)
+	assert.Equal(t, "f709ec73-55d4-46d8-897d-816ebba28778", projectID)
+	assert.Equal(t, "consul-cluster", clusterID)
+
+	_, _, err = parseClusterLink("/project/f709ec73-55d4-46d8-897d-816ebba28778/hashicorp.vault.cluster/vault-cluster")
+	assert.Error(t, err)
+}
+
+func TestValidateTierSize(t *testing.T) {
+	cases := map[string]struct {
+		tier        string
+		size        string
+		expectError bool
+	}{
+		"development x_small":        {tier: "development", size: "x_small"},
+		"development small":          {tier: "development", size: "small", expectError: true},
+		"standard small":             {tier: "standard", size: "small"},
+		"standard x_small":           {tier: "standard", size: "x_small", expectError: true},
+		"upper case plus large":      {tier: "PLUS", size: "LARGE"},
+		"upper case development":     {tier: "DEVELOPMENT", size: "X_SMALL"},
+		"mixed case premium x_small": {tier: "Premium", size: "X_Small", expectError: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateTierSize(c.tier, c.size)
+			if c.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSizeChange(t *testing.T) {
+	cases := map[string]struct {
+		prior       string
+		planned     string
+		expectError bool
+	}{
+		"unchanged":             {prior: "SMALL", planned: "small"},
+		"scale up":              {prior: "SMALL", planned: "medium"},
+		"scale up to large":     {prior: "small", planned: "LARGE"},
+		"scale down":            {prior: "LARGE", planned: "medium", expectError: true},
+		"scale down to x_small": {prior: "SMALL", planned: "x_small", expectError: true},
+		"unknown prior size":    {prior: "UNSET", planned: "small"},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateSizeChange(c.prior, c.planned)
+			if c.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpgradeRequested(t *testing.T) {
+	cases := map[string]struct {
+		minVersion string
+		current    string
+		expected   bool
+	}{
+		"no minimum version":      {minVersion: "", current: "v1.16.3"},
+		"lower patch version":     {minVersion: "1.16.0", current: "v1.16.3"},
+		"same version":            {minVersion: "v1.16.3", current: "v1.16.3"},
+		"greater patch version":   {minVersion: "1.16.4", current: "v1.16.3", expected: true},
+		"greater minor version":   {minVersion: "1.17.0", current: "v1.16.3", expected: true},
+		"lower minor version":     {minVersion: "1.15.5", current: "v1.16.3"},
+		"unknown current version": {minVersion: "1.17.0", current: "", expected: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expected, upgradeRequested(c.minVersion, c.current))
+		})
+	}
+}
+
+func TestTargetVersion(t *testing.T) {
+	version := func(v string, status consulmodels.HashicorpCloudConsul20210204VersionStatus) *consulmodels.HashicorpCloudConsul20210204Version {
+		return &consulmodels.HashicorpCloudConsul20210204Version{Version: v, Status: status.Pointer()}
+	}
+	versions := []*consulmodels.HashicorpCloudConsul20210204Version{
+		version("v1.15.8", consulmodels.HashicorpCloudConsul20210204VersionStatusAVAILABLE),
+		version("v1.16.2", consulmodels.HashicorpCloudConsul20210204VersionStatusAVAILABLE),
+		version("v1.16.3", consulmodels.HashicorpCloudConsul20210204VersionStatusRECOMMENDED),
+		version("v1.17.1", consulmodels.HashicorpCloudConsul20210204VersionStatusAVAILABLE),
+	}
+
+	cases := map[string]struct {
+		minVersion  string
+		versions    []*consulmodels.HashicorpCloudConsul20210204Version
+		expected    string
+		expectError bool
+	}{
+		"recommended version": {
+			versions: versions,
+			expected: "v1.16.3",
+		},
+		"latest patch of the minimum version": {
+			minVersion: "1.16.0",
+			versions:   versions,
+			expected:   "v1.16.3",
+		},
+		"exact minimum version": {
+			minVersion: "v1.17.1",
+			versions:   versions,
+			expected:   "v1.17.1",
+		},
+		"unavailable minor version": {
+			minVersion:  "1.18.0",
+			versions:    versions,
+			expectError: true,
+		},
+		"unavailable patch version": {
+			minVersion:  "1.17.2",
+			versions:    versions,
+			expectError: true,
+		},
+		"no versions available": {
+			minVersion:  "1.17.0",
+			expectError: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			target, err := targetVersion(c.minVersion, c.versions)
+			if c.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, target)
+		})
+	}
+}
+
+func TestExpandSnapshotConfig(t *testing.T) {
+	cases := map[string]struct {
+		interval    string
+		retention   string
+		expected    map[string]any
+		expectError bool
+	}{
+		"interval and retention": {
+			interval:  "12h",
+			retention: "7d",
+			expected:  map[string]any{"interval": "43200s", "retention": "604800s"},
+		},
+		"interval only": {
+			interval: "24h",
+			expected: map[string]any{"interval": "86400s"},
+		},
+		"retention only": {
+			retention: "30d",
+			expected:  map[string]any{"retention": "2592000s"},
+		},
+		"nothing set": {
+			expected: map[string]any{},
+		},
+		"interval in minutes": {
+			interval:    "90m",
+			expectError: true,
+		},
+		"retention in hours": {
+			retention:   "48h",
+			expectError: true,
+		},
+		"zero interval": {
+			interval:    "0h",
+			expectError: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			config, err := expandSnapshotConfig(c.interval, c.retention)
+			if c.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, config)
+		})
+	}
+}
+
+func TestFlattenSnapshotConfig(t *testing.T) {
+	cases := map[string]struct {
+		config            consulmodels.HashicorpCloudConsul20210204SnapshotConfig
+		expectedInterval  string
+		expectedRetention string
+	}{
+		"interval and retention": {
+			config:            map[string]any{"interval": "43200s", "retention": "604800s"},
+			expectedInterval:  "12h",
+			expectedRetention: "7d",
+		},
+		"round trip": {
+			config:            map[string]any{"interval": "86400s", "retention": "2592000s"},
+			expectedInterval:  "24h",
+			expectedRetention: "30d",
+		},
+		"partial days": {
+			config:           map[string]any{"interval": "3600s", "retention": "90000s"},
+			expectedInterval: "1h",
+		},
+		"invalid durations": {
+			config: map[string]any{"interval": "daily", "retention": 30},
+		},
+		"not reported": {
+			config: nil,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			interval, retention := flattenSnapshotConfig(c.config)
+			assert.Equal(t, c.expectedInterval, interval)
+			assert.Equal(t, c.expectedRetention, retention)
+		})
+	}
+}
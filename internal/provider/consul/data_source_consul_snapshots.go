@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package consul
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+)
+
+type DataSourceSnapshots struct {
+	client *clients.Client
+}
+
+type DataSourceSnapshotsModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	ClusterID types.String `tfsdk:"cluster_id"`
+	Snapshots []snapshot   `tfsdk:"snapshots"`
+}
+
+type snapshot struct {
+	SnapshotID    types.String `tfsdk:"snapshot_id"`
+	SnapshotName  types.String `tfsdk:"snapshot_name"`
+	Type          types.String `tfsdk:"type"`
+	State         types.String `tfsdk:"state"`
+	Size          types.Int64  `tfsdk:"size"`
+	ConsulVersion types.String `tfsdk:"consul_version"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	FinishedAt    types.String `tfsdk:"finished_at"`
+	RestoredAt    types.String `tfsdk:"restored_at"`
+}
+
+func NewSnapshotsDataSource() datasource.DataSource {
+	return &DataSourceSnapshots{}
+}
+
+func (d *DataSourceSnapshots) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_consul_snapshots"
+}
+
+func (d *DataSourceSnapshots) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Consul snapshots data source lists the snapshots of an HCP Consul cluster, " +
+			"including the snapshots taken on the schedule of the cluster.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the HCP Consul cluster is located. If not specified, the project configured in the HCP provider config block is used.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					hcpvalidator.UUID(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the HCP Consul cluster.",
+				Required:    true,
+				Validators: []validator.String{
+					hcpvalidator.Slug(),
+				},
+			},
+			"snapshots": schema.ListNestedAttribute{
+				Description: "The snapshots of the cluster, from oldest to latest.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"snapshot_id": schema.StringAttribute{
+							Description: "The ID of the snapshot.",
+							Computed:    true,
+						},
+						"snapshot_name": schema.StringAttribute{
+							Description: "The name of the snapshot.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the snapshot: `MANUAL`, `SCHEDULED` or `AUTOMATIC`.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The state of the snapshot, such as `READY`.",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "The size of the snapshot in bytes.",
+							Computed:    true,
+						},
+						"consul_version": schema.StringAttribute{
+							Description: "The version of Consul at the time of the snapshot creation.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The time the snapshot was requested, in RFC3339 format.",
+							Computed:    true,
+						},
+						"finished_at": schema.StringAttribute{
+							Description: "The time the snapshot was stored, in RFC3339 format.",
+							Computed:    true,
+						},
+						"restored_at": schema.StringAttribute{
+							Description: "The time the snapshot was last restored, in RFC3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataSourceSnapshots) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *DataSourceSnapshots) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceSnapshotsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ProjectID.IsNull() {
+		data.ProjectID = types.StringValue(d.client.Config.ProjectID)
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: d.client.Config.OrganizationID,
+		ProjectID:      data.ProjectID.ValueString(),
+	}
+
+	clusterID := data.ClusterID.ValueString()
+	snapshots, err := clients.ListSnapshots(ctx, d.client, loc, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to list snapshots of Consul cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return time.Time(snapshots[i].CreatedAt).Before(time.Time(snapshots[j].CreatedAt))
+	})
+
+	data.Snapshots = make([]snapshot, 0, len(snapshots))
+	for _, s := range snapshots {
+		snap := snapshot{
+			SnapshotID:    types.StringValue(s.ID),
+			SnapshotName:  types.StringValue(s.Name),
+			Type:          types.StringNull(),
+			State:         types.StringNull(),
+			Size:          types.Int64Null(),
+			ConsulVersion: types.StringNull(),
+			CreatedAt:     snapshotTime(s.CreatedAt),
+			FinishedAt:    snapshotTime(s.FinishedAt),
+			RestoredAt:    types.StringNull(),
+		}
+		if s.Type != nil {
+			snap.Type = types.StringValue(string(*s.Type))
+		}
+		if s.State != nil {
+			snap.State = types.StringValue(string(*s.State))
+		}
+		if s.Meta != nil {
+			snap.Size = snapshotSize(s.Meta.Size)
+			snap.ConsulVersion = types.StringValue(s.Meta.ProductVersion)
+			snap.RestoredAt = snapshotTime(s.Meta.RestoredAt)
+		}
+		data.Snapshots = append(data.Snapshots, snap)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// snapshotSize parses the size of a snapshot, which the API returns as a
+// string, and is empty until the snapshot is stored.
+func snapshotSize(bytes string) types.Int64 {
+	size, err := strconv.ParseInt(bytes, 10, 64)
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(size)
+}
+
+// snapshotTime formats a snapshot timestamp, omitted by the API when unset.
+func snapshotTime(t strfmt.DateTime) types.String {
+	if time.Time(t).IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Time(t).Format(time.RFC3339))
+}
@@ -0,0 +1,1215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package consul
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+	"time"
+
+	consulmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-consul-service/stable/2021-02-04/models"
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/timeouts"
+)
+
+// defaultClusterTimeout is the amount of time that can elapse before a
+// cluster read operation should timeout.
+const defaultClusterTimeout = time.Minute * 5
+
+// createUpdateClusterTimeout is the amount of time that can elapse before a
+// cluster create or update operation should timeout.
+const createUpdateClusterTimeout = time.Minute * 35
+
+// deleteClusterTimeout is the amount of time that can elapse before a cluster
+// delete operation should timeout.
+const deleteClusterTimeout = time.Minute * 35
+
+// clusterImportID is the import ID of a Consul cluster: its ID, optionally
+// prefixed by the ID of its project.
+var clusterImportID = []hcpvalidator.ImportIDPart{
+	{Name: "project_id", Optional: true, Validators: []validator.String{hcpvalidator.UUID()}},
+	{Name: "cluster_id", Validators: []validator.String{hcpvalidator.Slug()}},
+}
+
+// clusterTimeouts are the operations whose timeout can be set in the
+// timeouts block of the Consul cluster resource.
+var clusterTimeouts = []string{timeouts.Create, timeouts.Update, timeouts.Delete}
+
+type clusterModel struct {
+	ID                        types.String                           `tfsdk:"id"`
+	ClusterID                 types.String                           `tfsdk:"cluster_id"`
+	HvnID                     types.String                           `tfsdk:"hvn_id"`
+	Tier                      customtypes.CaseInsensitiveStringValue `tfsdk:"tier"`
+	PublicEndpoint            types.Bool                             `tfsdk:"public_endpoint"`
+	MinConsulVersion          types.String                           `tfsdk:"min_consul_version"`
+	Datacenter                types.String                           `tfsdk:"datacenter"`
+	ConnectEnabled            types.Bool                             `tfsdk:"connect_enabled"`
+	PrimaryLink               types.String                           `tfsdk:"primary_link"`
+	Size                      customtypes.CaseInsensitiveStringValue `tfsdk:"size"`
+	AutoHvnToHvnPeering       types.Bool                             `tfsdk:"auto_hvn_to_hvn_peering"`
+	ProjectID                 types.String                           `tfsdk:"project_id"`
+	OrganizationID            types.String                           `tfsdk:"organization_id"`
+	CloudProvider             types.String                           `tfsdk:"cloud_provider"`
+	Region                    types.String                           `tfsdk:"region"`
+	State                     types.String                           `tfsdk:"state"`
+	ConsulAutomaticUpgrades   types.Bool                             `tfsdk:"consul_automatic_upgrades"`
+	ConsulSnapshotInterval    types.String                           `tfsdk:"consul_snapshot_interval"`
+	ConsulSnapshotRetention   types.String                           `tfsdk:"consul_snapshot_retention"`
+	RestoreFromSnapshotID     types.String                           `tfsdk:"restore_from_snapshot_id"`
+	ConsulConfigFile          types.String                           `tfsdk:"consul_config_file"`
+	ConsulCAFile              types.String                           `tfsdk:"consul_ca_file"`
+	ConsulVersion             types.String                           `tfsdk:"consul_version"`
+	ConsulPublicEndpointURL   types.String                           `tfsdk:"consul_public_endpoint_url"`
+	ConsulPrivateEndpointURL  types.String                           `tfsdk:"consul_private_endpoint_url"`
+	IPAllowlist               types.List                             `tfsdk:"ip_allowlist"`
+	ConsulRootTokenAccessorID types.String                           `tfsdk:"consul_root_token_accessor_id"`
+	ConsulRootTokenSecretID   types.String                           `tfsdk:"consul_root_token_secret_id"`
+	Scale                     types.Int64                            `tfsdk:"scale"`
+	SelfLink                  types.String                           `tfsdk:"self_link"`
+	Timeouts                  types.Object                           `tfsdk:"timeouts"`
+}
+
+type ipAllowlistModel struct {
+	Address     types.String `tfsdk:"address"`
+	Description types.String `tfsdk:"description"`
+}
+
+var ipAllowlistType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"address":     types.StringType,
+	"description": types.StringType,
+}}
+
+var _ resource.Resource = &resourceCluster{}
+var _ resource.ResourceWithConfigure = &resourceCluster{}
+var _ resource.ResourceWithValidateConfig = &resourceCluster{}
+var _ resource.ResourceWithModifyPlan = &resourceCluster{}
+var _ resource.ResourceWithImportState = &resourceCluster{}
+var _ resource.ResourceWithIdentity = &resourceCluster{}
+
+func NewClusterResource() resource.Resource {
+	return &resourceCluster{}
+}
+
+type resourceCluster struct {
+	client *clients.Client
+}
+
+func (r *resourceCluster) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_consul_cluster"
+}
+
+func (r *resourceCluster) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		DeprecationMessage:  "HashiCorp plans to sunset HashiCorp Consul Dedicated (HCD) in November 2025, more information about the EOL will be provided to existing customers directly",
+		MarkdownDescription: "The Consul cluster resource allows you to manage an HCP Consul cluster.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the HCP Consul cluster resource, which is its self link.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the HCP Consul cluster.",
+				Required:    true,
+				Validators: []validator.String{
+					hcpvalidator.Slug(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hvn_id": schema.StringAttribute{
+				Description: "The ID of the HVN this HCP Consul cluster is associated to.",
+				Required:    true,
+				Validators: []validator.String{
+					hcpvalidator.Slug(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tier": schema.StringAttribute{
+				Description: "The tier that the HCP Consul cluster will be provisioned as.  Only `development`, `standard`, `plus`, and `premium` are available at this time. " +
+					"See [pricing information](https://www.hashicorp.com/products/consul/pricing). Changing the tier replaces the cluster.",
+				Required:   true,
+				CustomType: customtypes.CaseInsensitiveStringType{},
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(clusterTiers...),
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.CaseInsensitive(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_endpoint": schema.BoolAttribute{
+				Description: "Denotes that the cluster has a public endpoint for the Consul UI. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"min_consul_version": schema.StringAttribute{
+				Description: "The minimum Consul patch version of the cluster. Allows only the rightmost version component to increment " +
+					"(E.g: `1.13.0` will allow installation of `1.13.2` and `1.13.3` etc., but not `1.14.0`). " +
+					"If not specified, it is defaulted to the version that is currently recommended by HCP. " +
+					"Setting it above the current version of the cluster upgrades the cluster: the version must be one of the versions the cluster can be upgraded to, " +
+					"and the plan shows the version the cluster is upgraded to in `consul_version`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					hcpvalidator.SemVer(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"datacenter": schema.StringAttribute{
+				Description: "The Consul data center name of the cluster. If not specified, it is defaulted to the value of `cluster_id`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[-_\da-z]{3,36}$`),
+						"must be between 3 and 36 characters in length and contains only lowercase letters, numbers, hyphens, or underscores"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connect_enabled": schema.BoolAttribute{
+				Description: "Denotes the Consul connect feature should be enabled for this cluster.  Default to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"primary_link": schema.StringAttribute{
+				Description: "The `self_link` of the HCP Consul cluster which is the primary in the federation setup with this HCP Consul cluster. If not specified, it is a standalone cluster.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(clusterLinkRegexp,
+						fmt.Sprintf("must be the self link of an HCP Consul cluster, in the format /project/{project_id}/%s/{id}", consulClusterResourceType)),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.StringAttribute{
+				Description: "The t-shirt size representation of each server VM that this Consul cluster is provisioned with. Valid option for development tier - `x_small`. " +
+					"Valid options for other tiers - `small`, `medium`, `large`. For more details - https://cloud.hashicorp.com/pricing/consul. " +
+					"Upgrading the size of a cluster after creation is allowed, reducing it is rejected.",
+				Optional:   true,
+				Computed:   true,
+				CustomType: customtypes.CaseInsensitiveStringType{},
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(clusterSizes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					modifiers.CaseInsensitive(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_hvn_to_hvn_peering": schema.BoolAttribute{
+				Description: "Enables automatic HVN to HVN peering when creating a secondary cluster in a federation. " +
+					"The alternative to using the auto-accept feature is to create an [`hcp_hvn_peering_connection`](hvn_peering_connection.md) resource " +
+					"that explicitly defines the HVN resources that are allowed to communicate with each other.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the HCP Consul cluster is located. If not specified, the project configured in the HCP provider config block is used.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					hcpvalidator.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"organization_id": computedString("The ID of the organization this HCP Consul cluster is located in."),
+			"cloud_provider":  computedString("The provider where the HCP Consul cluster is located."),
+			"region":          computedString("The region where the HCP Consul cluster is located."),
+			"state":           computedString("The state of the HCP Consul cluster."),
+			"consul_automatic_upgrades": schema.BoolAttribute{
+				Description: "Denotes that automatic Consul upgrades are enabled.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"consul_snapshot_interval": schema.StringAttribute{
+				Description: "The interval between the scheduled snapshots of the cluster, in hours, such as `24h`. If not specified when the cluster is created, HCP takes a snapshot every 24 hours.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(snapshotIntervalRegexp, "must be a number of hours, such as 24h"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"consul_snapshot_retention": schema.StringAttribute{
+				Description: "How long the scheduled snapshots of the cluster are retained, in days, such as `30d`. If not specified when the cluster is created, HCP retains them for 30 days.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(snapshotRetentionRegexp, "must be a number of days, such as 30d"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"restore_from_snapshot_id": schema.StringAttribute{
+				Description: "The ID of a snapshot to restore to the cluster, such as a snapshot of another cluster of the project. " +
+					"The snapshot is restored when the cluster is created, and whenever this value changes. " +
+					"Restoring a snapshot replaces the data of the cluster with the data of the snapshot, including its ACL tokens, so a new root ACL token is created. " +
+					"Removing this value has no effect on the cluster.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"consul_config_file":          computedString("The cluster config encoded as a Base64 string."),
+			"consul_ca_file":              computedString("The cluster CA file encoded as a Base64 string."),
+			"consul_public_endpoint_url":  computedString("The public URL for the Consul UI. This will be empty if `public_endpoint` is `false`."),
+			"consul_private_endpoint_url": computedString("The private URL for the Consul UI."),
+			"consul_version": schema.StringAttribute{
+				Description: "The Consul version of the cluster. When the cluster is created or upgraded, the plan shows the version it is created with or upgraded to.",
+				Computed:    true,
+			},
+			"consul_root_token_accessor_id": computedString("The accessor ID of the root ACL token that is generated upon cluster creation."),
+			"consul_root_token_secret_id": schema.StringAttribute{
+				Description: "The secret ID of the root ACL token that is generated upon cluster creation.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scale": schema.Int64Attribute{
+				Description: "The number of Consul server nodes in the cluster.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"self_link": computedString("A unique URL identifying the HCP Consul cluster."),
+		},
+		Blocks: map[string]schema.Block{
+			"ip_allowlist": schema.ListNestedBlock{
+				Description: "Allowed IPV4 address ranges (CIDRs) for inbound traffic. Each entry must be a unique CIDR. Maximum 3 CIDRS supported at this time.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(3),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Description: "IP address range in CIDR notation.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description to help identify source (maximum 255 chars).",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(""),
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(255),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(clusterTimeouts...),
+		},
+	}
+}
+
+// computedString returns a computed string attribute which keeps its value
+// until the cluster is replaced.
+func computedString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *resourceCluster) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(clusterImportID...)
+}
+
+func (r *resourceCluster) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig validates the size of the cluster against its tier, and the
+// CIDRs of its IP allowlist.
+func (r *resourceCluster) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var tier, size types.String
+	var ipAllowlist types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tier"), &tier)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("size"), &size)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ip_allowlist"), &ipAllowlist)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !tier.IsNull() && !tier.IsUnknown() && !size.IsNull() && !size.IsUnknown() {
+		if err := validateTierSize(tier.ValueString(), size.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("size"), "Invalid Consul cluster size", err.Error())
+		}
+	}
+
+	if ipAllowlist.IsNull() || ipAllowlist.IsUnknown() {
+		return
+	}
+
+	seen := make(map[netip.Prefix]bool, len(ipAllowlist.Elements()))
+	for i, element := range ipAllowlist.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+
+		var cidr ipAllowlistModel
+		resp.Diagnostics.Append(object.As(ctx, &cidr, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if cidr.Address.IsNull() || cidr.Address.IsUnknown() {
+			continue
+		}
+
+		addressPath := path.Root("ip_allowlist").AtListIndex(i).AtName("address")
+		prefix, err := netip.ParsePrefix(cidr.Address.ValueString())
+		if err != nil || !prefix.Addr().Is4() {
+			resp.Diagnostics.AddAttributeError(addressPath, "Invalid IP allowlist address",
+				fmt.Sprintf("invalid address (%s) of ip_allowlist (must be a valid IPV4 CIDR).", cidr.Address.ValueString()))
+			continue
+		}
+		if seen[prefix.Masked()] {
+			resp.Diagnostics.AddAttributeError(addressPath, "Duplicate IP allowlist address",
+				fmt.Sprintf("The CIDR %s is declared more than once in the ip_allowlist.", cidr.Address.ValueString()))
+		}
+		seen[prefix.Masked()] = true
+	}
+}
+
+// ModifyPlan resolves the Consul version the cluster is created with or
+// upgraded to, so that the plan shows it in consul_version and unavailable
+// versions are reported at plan time. It also rejects scaling the cluster
+// down, which HCP does not support.
+func (r *resourceCluster) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	modifiers.ModifyPlanForDefaultProjectChange(ctx, r.client.Config.ProjectID, req.State, req.Config, req.Plan, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan clusterModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		var projectID, minVersion types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("min_consul_version"), &minVersion)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if projectID.IsNull() {
+			projectID = types.StringValue(r.client.Config.ProjectID)
+		}
+		r.modifyCreatePlan(ctx, &plan, projectID, minVersion, &resp.Diagnostics)
+	} else {
+		var state clusterModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		r.modifyUpdatePlan(ctx, &plan, &state, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// modifyCreatePlan plans the version a new cluster is created with, among the
+// versions available in its project.
+func (r *resourceCluster) modifyCreatePlan(ctx context.Context, plan *clusterModel, projectID, minVersion types.String, diags *diag.Diagnostics) {
+	if projectID.IsUnknown() || minVersion.IsUnknown() {
+		return
+	}
+
+	versions, err := clients.GetAvailableHCPConsulVersionsForLocation(ctx, r.location(projectID), r.client)
+	if err != nil {
+		diags.AddError("Unable to fetch available HCP Consul versions", err.Error())
+		return
+	}
+
+	version, err := targetVersion(minVersion.ValueString(), versions)
+	if err != nil {
+		diags.AddAttributeError(path.Root("min_consul_version"), "Unavailable Consul version", err.Error())
+		return
+	}
+
+	plan.ConsulVersion = types.StringValue(version)
+	if minVersion.IsNull() {
+		plan.MinConsulVersion = types.StringValue(version)
+	}
+}
+
+// modifyUpdatePlan plans the version an existing cluster is upgraded to, when
+// its minimum version is raised above its current version, and validates
+// changes of its size.
+func (r *resourceCluster) modifyUpdatePlan(ctx context.Context, plan, state *clusterModel, diags *diag.Diagnostics) {
+	// Replacements are planned again as creations.
+	if replacesCluster(plan, state) {
+		plan.ConsulVersion = types.StringUnknown()
+		return
+	}
+
+	if !plan.Size.IsUnknown() && !plan.Size.IsNull() && !state.Size.IsNull() {
+		if err := validateSizeChange(state.Size.ValueString(), plan.Size.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("size"), "Invalid Consul cluster size change", err.Error())
+		}
+	}
+
+	// Restoring a snapshot replaces the root ACL token of the cluster.
+	if restoreRequested(plan, state) {
+		plan.ConsulRootTokenAccessorID = types.StringUnknown()
+		plan.ConsulRootTokenSecretID = types.StringUnknown()
+	}
+
+	plan.ConsulVersion = state.ConsulVersion
+	if plan.MinConsulVersion.IsUnknown() {
+		plan.ConsulVersion = types.StringUnknown()
+		return
+	}
+
+	// A minimum version lower than or equal to the current version is already
+	// satisfied, so the cluster is not upgraded.
+	if !upgradeRequested(plan.MinConsulVersion.ValueString(), state.ConsulVersion.ValueString()) {
+		return
+	}
+
+	version, err := r.upgradeVersion(ctx, state.ProjectID, state.ClusterID.ValueString(), plan.MinConsulVersion.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("min_consul_version"), "Unavailable Consul version", err.Error())
+		return
+	}
+	plan.ConsulVersion = types.StringValue(version)
+}
+
+// restoreRequested returns whether the planned changes restore a snapshot to
+// the cluster.
+func restoreRequested(plan, state *clusterModel) bool {
+	return !plan.RestoreFromSnapshotID.IsNull() && !plan.RestoreFromSnapshotID.Equal(state.RestoreFromSnapshotID)
+}
+
+// replacesCluster returns whether the planned changes replace the cluster.
+func replacesCluster(plan, state *clusterModel) bool {
+	return !plan.ProjectID.Equal(state.ProjectID) ||
+		!plan.ClusterID.Equal(state.ClusterID) ||
+		!plan.HvnID.Equal(state.HvnID) ||
+		!plan.Tier.Equal(state.Tier) ||
+		!plan.PublicEndpoint.Equal(state.PublicEndpoint) ||
+		!plan.Datacenter.Equal(state.Datacenter) ||
+		!plan.ConnectEnabled.Equal(state.ConnectEnabled) ||
+		!plan.PrimaryLink.Equal(state.PrimaryLink) ||
+		!plan.AutoHvnToHvnPeering.Equal(state.AutoHvnToHvnPeering)
+}
+
+func (r *resourceCluster) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan clusterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := timeouts.Get(ctx, plan.Timeouts, timeouts.Create, createUpdateClusterTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if plan.ProjectID.IsUnknown() {
+		plan.ProjectID = types.StringValue(r.client.Config.ProjectID)
+	}
+
+	clusterID := plan.ClusterID.ValueString()
+	hvnID := plan.HvnID.ValueString()
+	loc := r.location(plan.ProjectID)
+
+	// Use the hvn to get provider and region.
+	hvn, err := clients.GetHvnByID(ctx, r.client, loc, hvnID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to find existing HVN (%s)", hvnID), err.Error())
+		return
+	}
+	loc.Region = &sharedmodels.HashicorpCloudLocationRegion{
+		Provider: hvn.Location.Region.Provider,
+		Region:   hvn.Location.Region.Region,
+	}
+
+	// Check for an existing Consul cluster
+	_, err = clients.GetConsulClusterByID(ctx, r.client, loc, clusterID)
+	if err == nil {
+		resp.Diagnostics.AddError("Consul cluster already exists",
+			fmt.Sprintf("A Consul cluster with cluster_id=%q in project_id=%q already exists - to be managed via Terraform this resource needs to be imported into the State. "+
+				"Please see the resource documentation for hcp_consul_cluster for more information.", clusterID, loc.ProjectID))
+		return
+	}
+	if !clients.IsResponseCodeNotFound(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to check for presence of an existing Consul cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	// The version is resolved at plan time, unless the project was not known
+	// yet.
+	consulVersion := plan.ConsulVersion.ValueString()
+	if plan.ConsulVersion.IsUnknown() {
+		minVersion := plan.MinConsulVersion.ValueString()
+		if plan.MinConsulVersion.IsUnknown() {
+			minVersion = ""
+		}
+
+		versions, err := clients.GetAvailableHCPConsulVersionsForLocation(ctx, loc, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch available HCP Consul versions", err.Error())
+			return
+		}
+		consulVersion, err = targetVersion(minVersion, versions)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("min_consul_version"), "Unavailable Consul version", err.Error())
+			return
+		}
+	}
+
+	// If specified, parse the primary link provided for federation.
+	var primary *sharedmodels.HashicorpCloudLocationLink
+	if !plan.PrimaryLink.IsNull() {
+		primary, diags = r.getPrimary(ctx, plan.PrimaryLink.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	datacenter := strings.ToLower(clusterID)
+	if !plan.Datacenter.IsUnknown() {
+		datacenter = plan.Datacenter.ValueString()
+	}
+
+	ipAllowlist, diags := expandIPAllowlist(ctx, plan.IPAllowlist)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var size *consulmodels.HashicorpCloudConsul20210204CapacityConfigSize
+	if !plan.Size.IsUnknown() {
+		size = consulmodels.HashicorpCloudConsul20210204CapacityConfigSize(strings.ToUpper(plan.Size.ValueString())).Pointer()
+	}
+
+	snapshotConfig, err := expandSnapshotConfig(knownString(plan.ConsulSnapshotInterval), knownString(plan.ConsulSnapshotRetention))
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Consul snapshot configuration", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Creating Consul cluster", map[string]any{"cluster_id": clusterID, "consul_version": consulVersion})
+
+	createResp, err := clients.CreateConsulCluster(ctx, r.client, loc, &consulmodels.HashicorpCloudConsul20210204Cluster{
+		Config: &consulmodels.HashicorpCloudConsul20210204ClusterConfig{
+			Tier: consulmodels.HashicorpCloudConsul20210204ClusterConfigTier(strings.ToUpper(plan.Tier.ValueString())).Pointer(),
+			CapacityConfig: &consulmodels.HashicorpCloudConsul20210204CapacityConfig{
+				Size: size,
+			},
+			ConsulConfig: &consulmodels.HashicorpCloudConsul20210204ConsulConfig{
+				ConnectEnabled: plan.ConnectEnabled.ValueBool(),
+				Datacenter:     datacenter,
+				Primary:        primary,
+			},
+			NetworkConfig: &consulmodels.HashicorpCloudConsul20210204NetworkConfig{
+				Network: &sharedmodels.HashicorpCloudLocationLink{
+					Type:     "hvn",
+					ID:       hvnID,
+					Location: loc,
+				},
+				Private:     !plan.PublicEndpoint.ValueBool(),
+				IPAllowlist: ipAllowlist,
+			},
+			// Enabling auto peering will peer this cluster's HVN with every
+			// other HVN with members in this federation. The peering happens
+			// within the secondary cluster create operation.
+			AutoHvnToHvnPeering: plan.AutoHvnToHvnPeering.ValueBool(),
+			SnapshotConfig:      snapshotConfig,
+		},
+		ConsulVersion: consulVersion,
+		ID:            clusterID,
+		Location:      loc,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to create Consul cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	if err := clients.WaitForOperation(ctx, r.client, "create Consul cluster", loc, createResp.Operation.ID); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to create Consul cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Created Consul cluster", map[string]any{"cluster_id": clusterID})
+
+	// The snapshot is restored before the root ACL token is created, since
+	// restoring it replaces the ACL tokens of the cluster. The cluster is kept
+	// in the state if it fails, so that it is not left unmanaged, without the
+	// snapshot so that the restore is planned again.
+	if !plan.RestoreFromSnapshotID.IsNull() {
+		if err := r.restoreSnapshot(ctx, loc, clusterID, plan.RestoreFromSnapshotID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to restore snapshot to Consul cluster (%s)", clusterID), err.Error())
+			plan.RestoreFromSnapshotID = types.StringNull()
+		}
+	}
+
+	if _, diags := r.read(ctx, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if plan.MinConsulVersion.IsUnknown() {
+		plan.MinConsulVersion = plan.ConsulVersion
+	}
+
+	// The root ACL token is only available when it is created, so it is
+	// created along with the cluster.
+	resp.Diagnostics.Append(r.createRootACLToken(ctx, loc, &plan)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *resourceCluster) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
+	var state clusterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := timeouts.Get(ctx, state.Timeouts, timeouts.Read, defaultClusterTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	found, diags := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, "Consul cluster not found, removing from state", map[string]any{"cluster_id": state.ClusterID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The configured minimum version is kept as it is, even once the cluster
+	// has been upgraded past it. It is only unset after an import.
+	if state.MinConsulVersion.IsNull() || state.MinConsulVersion.IsUnknown() {
+		state.MinConsulVersion = state.ConsulVersion
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceCluster) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state clusterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := timeouts.Get(ctx, plan.Timeouts, timeouts.Update, createUpdateClusterTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	clusterID := state.ClusterID.ValueString()
+	cluster, err := clients.GetConsulClusterByID(ctx, r.client, r.location(state.ProjectID), clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Consul cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	targetCluster := consulmodels.HashicorpCloudConsul20210204Cluster{
+		ID: clusterID,
+		Location: &sharedmodels.HashicorpCloudLocationLocation{
+			ProjectID:      cluster.Location.ProjectID,
+			OrganizationID: cluster.Location.OrganizationID,
+			Region: &sharedmodels.HashicorpCloudLocationRegion{
+				Region:   cluster.Location.Region.Region,
+				Provider: cluster.Location.Region.Provider,
+			},
+		},
+		Config: &consulmodels.HashicorpCloudConsul20210204ClusterConfig{},
+	}
+	changed := false
+
+	// The version is resolved at plan time, unless the minimum version was not
+	// known yet.
+	if plan.ConsulVersion.IsUnknown() && upgradeRequested(plan.MinConsulVersion.ValueString(), state.ConsulVersion.ValueString()) {
+		version, err := r.upgradeVersion(ctx, state.ProjectID, clusterID, plan.MinConsulVersion.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("min_consul_version"), "Unavailable Consul version", err.Error())
+			return
+		}
+		plan.ConsulVersion = types.StringValue(version)
+	}
+	if !plan.ConsulVersion.IsUnknown() && !plan.ConsulVersion.Equal(state.ConsulVersion) {
+		targetCluster.ConsulVersion = plan.ConsulVersion.ValueString()
+		changed = true
+	}
+
+	if !plan.Size.IsUnknown() && !plan.Size.Equal(state.Size) {
+		targetCluster.Config.CapacityConfig = &consulmodels.HashicorpCloudConsul20210204CapacityConfig{
+			Size: consulmodels.HashicorpCloudConsul20210204CapacityConfigSize(strings.ToUpper(plan.Size.ValueString())).Pointer(),
+		}
+		changed = true
+	}
+
+	if !plan.IPAllowlist.Equal(state.IPAllowlist) {
+		ipAllowlist, diags := expandIPAllowlist(ctx, plan.IPAllowlist)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		targetCluster.Config.NetworkConfig = &consulmodels.HashicorpCloudConsul20210204NetworkConfig{
+			IPAllowlist: ipAllowlist,
+		}
+		changed = true
+	}
+
+	if !plan.ConsulSnapshotInterval.Equal(state.ConsulSnapshotInterval) || !plan.ConsulSnapshotRetention.Equal(state.ConsulSnapshotRetention) {
+		snapshotConfig, err := expandSnapshotConfig(knownString(plan.ConsulSnapshotInterval), knownString(plan.ConsulSnapshotRetention))
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Consul snapshot configuration", err.Error())
+			return
+		}
+		targetCluster.Config.SnapshotConfig = snapshotConfig
+		changed = true
+	}
+
+	// Changes of the timeouts only apply to the next operations.
+	if changed {
+		updateResp, err := clients.UpdateConsulCluster(ctx, r.client, &targetCluster)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error updating Consul cluster (%s)", clusterID), err.Error())
+			return
+		}
+
+		if err := clients.WaitForOperation(ctx, r.client, "update Consul cluster", cluster.Location, updateResp.Operation.ID); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to update Consul cluster (%s)", clusterID), err.Error())
+			return
+		}
+	}
+
+	restored := restoreRequested(&plan, &state)
+	if restored {
+		if err := r.restoreSnapshot(ctx, cluster.Location, clusterID, plan.RestoreFromSnapshotID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to restore snapshot to Consul cluster (%s)", clusterID), err.Error())
+			return
+		}
+	}
+
+	if _, diags := r.read(ctx, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if plan.MinConsulVersion.IsUnknown() {
+		plan.MinConsulVersion = plan.ConsulVersion
+	}
+
+	// Restoring a snapshot replaces the ACL tokens of the cluster, so the root
+	// ACL token is created again.
+	if restored {
+		resp.Diagnostics.Append(r.createRootACLToken(ctx, cluster.Location, &plan)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceCluster) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state clusterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := timeouts.Get(ctx, state.Timeouts, timeouts.Delete, deleteClusterTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	clusterID := state.ClusterID.ValueString()
+	loc := r.location(state.ProjectID)
+
+	tflog.Info(ctx, "Deleting Consul cluster", map[string]any{"cluster_id": clusterID})
+
+	deleteResp, err := clients.DeleteConsulCluster(ctx, r.client, loc, clusterID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			tflog.Warn(ctx, "Consul cluster not found, so no action was taken", map[string]any{"cluster_id": clusterID})
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to delete Consul cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	if err := clients.WaitForOperation(ctx, r.client, "delete Consul cluster", loc, deleteResp.Operation.ID); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to delete Consul cluster (%s)", clusterID), err.Error())
+	}
+}
+
+// ImportState imports a Consul cluster from an import ID in the format
+// [{project_id}:]{cluster_id}, or from its identity. The root ACL token of an
+// imported cluster is unknown, since it is only available when it is created.
+func (r *resourceCluster) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, diags := identity.ImportID(ctx, req, clusterImportID...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, clusterID := parts[0], parts[1]
+	if projectID == "" {
+		projectID = r.client.Config.ProjectID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), clusterID)...)
+}
+
+func (r *resourceCluster) location(projectID types.String) *sharedmodels.HashicorpCloudLocationLocation {
+	return &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: r.client.Config.OrganizationID,
+		ProjectID:      projectID.ValueString(),
+	}
+}
+
+// upgradeVersion returns the version an existing cluster is upgraded to for
+// the given minimum version, among the versions it can be upgraded to.
+func (r *resourceCluster) upgradeVersion(ctx context.Context, projectID types.String, clusterID, minVersion string) (string, error) {
+	versions, err := clients.ListConsulUpgradeVersions(ctx, r.client, r.location(projectID), clusterID)
+	if err != nil {
+		return "", fmt.Errorf("unable to list Consul upgrade versions (%s): %w", clusterID, err)
+	}
+	return targetVersion(minVersion, versions)
+}
+
+// restoreSnapshot restores a snapshot of the project of a cluster to the
+// cluster, and waits for the restore to complete.
+func (r *resourceCluster) restoreSnapshot(ctx context.Context, loc *sharedmodels.HashicorpCloudLocationLocation, clusterID, snapshotID string) error {
+	cluster, err := clients.GetConsulClusterByID(ctx, r.client, loc, clusterID)
+	if err != nil {
+		return fmt.Errorf("unable to fetch Consul cluster (%s): %w", clusterID, err)
+	}
+
+	tflog.Info(ctx, "Restoring snapshot to Consul cluster", map[string]any{"cluster_id": cluster.ID, "snapshot_id": snapshotID})
+
+	restoreResp, err := clients.RestoreSnapshot(ctx, r.client, cluster, &sharedmodels.HashicorpCloudLocationLink{
+		Type: consulSnapshotResourceType,
+		ID:   snapshotID,
+		Location: &sharedmodels.HashicorpCloudLocationLocation{
+			OrganizationID: cluster.Location.OrganizationID,
+			ProjectID:      cluster.Location.ProjectID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := clients.WaitForOperation(ctx, r.client, "restore Consul snapshot", cluster.Location, restoreResp.Operation.ID); err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "Restored snapshot to Consul cluster", map[string]any{"cluster_id": cluster.ID, "snapshot_id": snapshotID})
+	return nil
+}
+
+// createRootACLToken creates a root ACL token for a cluster and sets it in
+// the model. The token is unset if it cannot be created.
+func (r *resourceCluster) createRootACLToken(ctx context.Context, loc *sharedmodels.HashicorpCloudLocationLocation, model *clusterModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ConsulRootTokenAccessorID = types.StringNull()
+	model.ConsulRootTokenSecretID = types.StringNull()
+
+	clusterID := model.ClusterID.ValueString()
+	rootACLToken, err := clients.CreateCustomerRootACLToken(ctx, r.client, loc, clusterID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to create root ACL token for cluster (%s)", clusterID), err.Error())
+		return diags
+	}
+
+	model.ConsulRootTokenAccessorID = types.StringValue(rootACLToken.ACLToken.AccessorID)
+	model.ConsulRootTokenSecretID = types.StringValue(rootACLToken.ACLToken.SecretID)
+	return diags
+}
+
+// getPrimary returns the location link of the primary cluster of a federation
+// from its self link.
+func (r *resourceCluster) getPrimary(ctx context.Context, primaryLink string) (*sharedmodels.HashicorpCloudLocationLink, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	projectID, clusterID, err := parseClusterLink(primaryLink)
+	if err != nil {
+		diags.AddAttributeError(path.Root("primary_link"), "Invalid primary link", err.Error())
+		return nil, diags
+	}
+
+	organizationID, err := clients.GetParentOrganizationIDByProjectID(ctx, r.client, projectID)
+	if err != nil {
+		diags.AddError("Error determining organization of primary cluster", err.Error())
+		return nil, diags
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: organizationID,
+		ProjectID:      projectID,
+	}
+	primary, err := clients.GetConsulClusterByID(ctx, r.client, loc, clusterID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to check for presence of an existing primary Consul cluster (%s)", clusterID), err.Error())
+		return nil, diags
+	}
+	loc.Region = primary.Location.Region
+
+	return &sharedmodels.HashicorpCloudLocationLink{
+		Type:     consulClusterResourceType,
+		ID:       clusterID,
+		Location: loc,
+	}, diags
+}
+
+// read refreshes the model from the cluster and its client configuration. It
+// returns false if the cluster does not exist. The minimum version and the
+// root ACL token are left unchanged.
+func (r *resourceCluster) read(ctx context.Context, model *clusterModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	loc := r.location(model.ProjectID)
+	clusterID := model.ClusterID.ValueString()
+
+	tflog.Info(ctx, "Reading Consul cluster", map[string]any{"cluster_id": clusterID, "project_id": loc.ProjectID})
+
+	cluster, err := clients.GetConsulClusterByID(ctx, r.client, loc, clusterID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			return false, diags
+		}
+		diags.AddError(fmt.Sprintf("Unable to fetch Consul cluster (%s)", clusterID), err.Error())
+		return false, diags
+	}
+
+	// we should only ever get a CodeNotFound response if the cluster is
+	// deleted. The below is precautionary
+	if cluster.State != nil && *cluster.State == consulmodels.HashicorpCloudConsul20210204ClusterStateDELETED {
+		return false, diags
+	}
+
+	diags.Append(flattenCluster(ctx, model, cluster)...)
+	if diags.HasError() {
+		return true, diags
+	}
+
+	clientConfigFiles, err := clients.GetConsulClientConfigFiles(ctx, r.client, loc, clusterID)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to retrieve Consul cluster client config files", map[string]any{"cluster_id": clusterID, "error": err.Error()})
+		if model.ConsulConfigFile.IsUnknown() {
+			model.ConsulConfigFile = types.StringNull()
+		}
+		if model.ConsulCAFile.IsUnknown() {
+			model.ConsulCAFile = types.StringNull()
+		}
+		return true, diags
+	}
+
+	model.ConsulConfigFile = types.StringValue(clientConfigFiles.ConsulConfigFile.String())
+	model.ConsulCAFile = types.StringValue(clientConfigFiles.CaFile.String())
+
+	return true, diags
+}
+
+// flattenCluster sets the attributes of the model from the cluster. The tier
+// and size are kept as configured when they only differ in case.
+func flattenCluster(ctx context.Context, model *clusterModel, cluster *consulmodels.HashicorpCloudConsul20210204Cluster) diag.Diagnostics {
+	link := clusterLink(cluster.Location.ProjectID, cluster.ID)
+	model.ID = types.StringValue(link)
+	model.SelfLink = types.StringValue(link)
+	model.ClusterID = types.StringValue(cluster.ID)
+	model.HvnID = types.StringValue(cluster.Config.NetworkConfig.Network.ID)
+	model.OrganizationID = types.StringValue(cluster.Location.OrganizationID)
+	model.ProjectID = types.StringValue(cluster.Location.ProjectID)
+	model.CloudProvider = types.StringValue(cluster.Location.Region.Provider)
+	model.Region = types.StringValue(cluster.Location.Region.Region)
+	model.State = types.StringNull()
+	if cluster.State != nil {
+		model.State = types.StringValue(string(*cluster.State))
+	}
+
+	publicEndpoint := !cluster.Config.NetworkConfig.Private
+	model.PublicEndpoint = types.BoolValue(publicEndpoint)
+	model.ConsulPublicEndpointURL = types.StringValue("")
+	if publicEndpoint {
+		// No port needed to communicate with HCP Consul via HTTPS
+		model.ConsulPublicEndpointURL = types.StringValue(fmt.Sprintf("https://%s", cluster.DNSNames.Public))
+	}
+	model.ConsulPrivateEndpointURL = types.StringValue(fmt.Sprintf("https://%s", cluster.DNSNames.Private))
+
+	model.Datacenter = types.StringValue(cluster.Config.ConsulConfig.Datacenter)
+	model.ConnectEnabled = types.BoolValue(cluster.Config.ConsulConfig.ConnectEnabled)
+	model.AutoHvnToHvnPeering = types.BoolValue(cluster.Config.AutoHvnToHvnPeering)
+	model.ConsulVersion = types.StringValue(cluster.ConsulVersion)
+	model.Scale = types.Int64Value(int64(cluster.Config.CapacityConfig.Scale))
+
+	if cluster.Config.Tier != nil {
+		model.Tier = customtypes.NewCaseInsensitiveStringValue(string(*cluster.Config.Tier))
+	}
+	if cluster.Config.CapacityConfig.Size != nil {
+		model.Size = customtypes.NewCaseInsensitiveStringValue(string(*cluster.Config.CapacityConfig.Size))
+	}
+
+	// HCP does not report the automatic upgrades of the cluster, which it
+	// manages.
+	model.ConsulAutomaticUpgrades = types.BoolValue(false)
+
+	// The snapshot configuration is kept as configured when HCP does not
+	// report it, and defaults to the schedule HCP applies otherwise.
+	interval, retention := flattenSnapshotConfig(cluster.Config.SnapshotConfig)
+	switch {
+	case interval != "":
+		model.ConsulSnapshotInterval = types.StringValue(interval)
+	case model.ConsulSnapshotInterval.IsNull() || model.ConsulSnapshotInterval.IsUnknown():
+		model.ConsulSnapshotInterval = types.StringValue(defaultSnapshotInterval)
+	}
+	switch {
+	case retention != "":
+		model.ConsulSnapshotRetention = types.StringValue(retention)
+	case model.ConsulSnapshotRetention.IsNull() || model.ConsulSnapshotRetention.IsUnknown():
+		model.ConsulSnapshotRetention = types.StringValue(defaultSnapshotRetention)
+	}
+
+	if primary := cluster.Config.ConsulConfig.Primary; primary != nil {
+		model.PrimaryLink = types.StringValue(clusterLink(primary.Location.ProjectID, primary.ID))
+	}
+
+	ipAllowlist := make([]ipAllowlistModel, 0, len(cluster.Config.NetworkConfig.IPAllowlist))
+	for _, cidr := range cluster.Config.NetworkConfig.IPAllowlist {
+		ipAllowlist = append(ipAllowlist, ipAllowlistModel{
+			Address:     types.StringValue(cidr.Address),
+			Description: types.StringValue(cidr.Description),
+		})
+	}
+
+	var diags diag.Diagnostics
+	model.IPAllowlist, diags = types.ListValueFrom(ctx, ipAllowlistType, ipAllowlist)
+	return diags
+}
+
+// knownString returns the value of a string attribute, or an empty string if
+// it is null or unknown.
+func knownString(value types.String) string {
+	if value.IsNull() || value.IsUnknown() {
+		return ""
+	}
+	return value.ValueString()
+}
+
+func expandIPAllowlist(ctx context.Context, list types.List) ([]*consulmodels.HashicorpCloudConsul20210204CidrRange, diag.Diagnostics) {
+	var cidrs []ipAllowlistModel
+	diags := list.ElementsAs(ctx, &cidrs, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ipAllowlist := make([]*consulmodels.HashicorpCloudConsul20210204CidrRange, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		ipAllowlist = append(ipAllowlist, &consulmodels.HashicorpCloudConsul20210204CidrRange{
+			Address:     cidr.Address.ValueString(),
+			Description: cidr.Description.ValueString(),
+		})
+	}
+	return ipAllowlist, diags
+}
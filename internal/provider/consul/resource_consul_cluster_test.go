@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package consul_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+)
+
+// TestAccConsulCluster_Migration checks that clusters created with the last
+// release of the provider, where hcp_consul_cluster used the SDKv2, do not
+// change once managed by the framework resource.
+func TestAccConsulCluster_Migration(t *testing.T) {
+	name := "consul-migration-" + acctest.RandString(8)
+	config := testAccConsulClusterConfig(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"hcp": {
+						VersionConstraint: "~> 0.114.0",
+						Source:            "hashicorp/hcp",
+					},
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hcp_consul_cluster.test", "cluster_id", name),
+					resource.TestCheckResourceAttrSet("hcp_consul_cluster.test", "consul_version"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Config:                   config,
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
+
+func testAccConsulClusterConfig(name string) string {
+	return fmt.Sprintf(`
+resource "hcp_hvn" "test" {
+  hvn_id         = "%[1]s"
+  cidr_block     = "172.25.16.0/20"
+  cloud_provider = "aws"
+  region         = "us-west-2"
+}
+
+resource "hcp_consul_cluster" "test" {
+  cluster_id = "%[1]s"
+  hvn_id     = hcp_hvn.test.hvn_id
+  tier       = "development"
+}
+`, name)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = &CaseInsensitiveStringType{}
+)
+
+// CaseInsensitiveStringType is the type of string attributes whose values are
+// case-insensitive, such as the enums of the HCP APIs.
+type CaseInsensitiveStringType struct {
+	basetypes.StringType
+}
+
+func (t CaseInsensitiveStringType) String() string {
+	return "CaseInsensitiveStringType"
+}
+
+func (t CaseInsensitiveStringType) ValueType(context.Context) attr.Value {
+	return CaseInsensitiveStringValue{}
+}
+
+func (t CaseInsensitiveStringType) Equal(o attr.Type) bool {
+	other, ok := o.(CaseInsensitiveStringType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t CaseInsensitiveStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CaseInsensitiveStringValue{
+		StringValue: in,
+	}, nil
+}
+
+func (t CaseInsensitiveStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	value, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to CaseInsensitiveStringValue: %v", diags)
+	}
+
+	return value, nil
+}
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = &CaseInsensitiveStringValue{}
+)
+
+// CaseInsensitiveStringValue is a string value whose case is not significant.
+// Values returned by the API that only differ in case from the prior value are
+// semantically equal to it, so that the prior value is kept.
+type CaseInsensitiveStringValue struct {
+	basetypes.StringValue
+}
+
+func (v CaseInsensitiveStringValue) Type(context.Context) attr.Type {
+	return CaseInsensitiveStringType{}
+}
+
+func (v CaseInsensitiveStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(CaseInsensitiveStringValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v CaseInsensitiveStringValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(CaseInsensitiveStringValue)
+	if !ok {
+		diags.Append(newSemanticEqualityCheckTypeError[basetypes.StringValuable](v, newValuable))
+		return false, diags
+	}
+
+	return strings.EqualFold(v.ValueString(), newValue.ValueString()), diags
+}
+
+func NewCaseInsensitiveStringValue(value string) CaseInsensitiveStringValue {
+	return CaseInsensitiveStringValue{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
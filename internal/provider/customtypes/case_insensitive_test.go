@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestCaseInsensitiveStringSemanticEquals(t *testing.T) {
+	testCases := map[string]struct {
+		prior    CaseInsensitiveStringValue
+		new      basetypes.StringValuable
+		expected bool
+		err      bool
+	}{
+		"equal": {
+			prior:    NewCaseInsensitiveStringValue("PLUS"),
+			new:      NewCaseInsensitiveStringValue("PLUS"),
+			expected: true,
+		},
+		"different case": {
+			prior:    NewCaseInsensitiveStringValue("PluS"),
+			new:      NewCaseInsensitiveStringValue("PLUS"),
+			expected: true,
+		},
+		"different value": {
+			prior: NewCaseInsensitiveStringValue("Plus"),
+			new:   NewCaseInsensitiveStringValue("STANDARD"),
+		},
+		"different type": {
+			prior: NewCaseInsensitiveStringValue("PLUS"),
+			new:   basetypes.NewStringValue("PLUS"),
+			err:   true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := testCase.prior.StringSemanticEquals(context.Background(), testCase.new)
+			if diags.HasError() != testCase.err {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+			if equal != testCase.expected {
+				t.Errorf("StringSemanticEquals() = %t; want %t", equal, testCase.expected)
+			}
+		})
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	clients "github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 )
 
 func NewGroupResource() resource.Resource {
@@ -131,12 +133,14 @@ func (r *resourceGroup) Create(ctx context.Context, req resource.CreateRequest,
 	plan.DisplayName = types.StringValue(group.DisplayName)
 	plan.Description = types.StringValue(group.Description)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
 func (r *resourceGroup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state Group
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -232,6 +236,10 @@ func (r *resourceGroup) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+func (r *resourceGroup) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(hcpvalidator.ImportIDPart{Name: "resource_name"})
+}
+
 func (r *resourceGroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("resource_name"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("resource_name"), path.Root("resource_name"), req, resp)
 }
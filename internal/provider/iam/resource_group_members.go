@@ -16,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 )
 
 func NewGroupMembersResource() resource.Resource {
@@ -112,11 +114,13 @@ func (r *resourceGroupMembers) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourceGroupMembers) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state GroupMembers
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -226,6 +230,10 @@ func (r *resourceGroupMembers) Delete(ctx context.Context, req resource.DeleteRe
 	}
 }
 
+func (r *resourceGroupMembers) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(hcpvalidator.ImportIDPart{Name: "group"})
+}
+
 func (r *resourceGroupMembers) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("group"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("group"), path.Root("group"), req, resp)
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	clients "github.com/hashicorp/terraform-provider-hcp/internal/clients"
 	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 )
 
 func NewServicePrincipalResource() resource.Resource {
@@ -148,6 +149,7 @@ func (r *resourceServicePrincipal) Create(ctx context.Context, req resource.Crea
 	plan.Name = types.StringValue(sp.Name)
 	plan.Parent = types.StringValue(parent)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -167,6 +169,7 @@ func spParent(resourceName string) (string, error) {
 func (r *resourceServicePrincipal) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state ServicePrincipal
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -225,6 +228,10 @@ func (r *resourceServicePrincipal) Delete(ctx context.Context, req resource.Dele
 	}
 }
 
+func (r *resourceServicePrincipal) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(hcpvalidator.ImportIDPart{Name: "resource_name"})
+}
+
 func (r *resourceServicePrincipal) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("resource_name"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("resource_name"), path.Root("resource_name"), req, resp)
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	clients "github.com/hashicorp/terraform-provider-hcp/internal/clients"
 	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 )
 
 func NewWorkloadIdentityProviderResource() resource.Resource {
@@ -306,11 +307,13 @@ func (r *resourceWorkloadIdentityProvider) Create(ctx context.Context, req resou
 	wip := res.GetPayload().Provider
 	resp.Diagnostics.Append(plan.fromModel(ctx, wip)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourceWorkloadIdentityProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state WorkloadIdentityProvider
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -390,6 +393,10 @@ func (r *resourceWorkloadIdentityProvider) Delete(ctx context.Context, req resou
 	}
 }
 
+func (r *resourceWorkloadIdentityProvider) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(hcpvalidator.ImportIDPart{Name: "resource_name"})
+}
+
 func (r *resourceWorkloadIdentityProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("resource_name"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("resource_name"), path.Root("resource_name"), req, resp)
 }
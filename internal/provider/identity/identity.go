@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package identity provides helpers for resources supporting resource
+// identity, which allows import blocks to reference a resource with a
+// structured identity instead of an import ID.
+//
+// The identity attributes of a resource share their names with the state
+// attributes and the parts of the import ID of the resource.
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+)
+
+// Schema returns an identity schema with a string attribute for each of the
+// given import ID parts. Optional parts are optional for import, all other
+// parts are required.
+func Schema(parts ...hcpvalidator.ImportIDPart) identityschema.Schema {
+	attributes := make(map[string]identityschema.Attribute, len(parts))
+	for _, part := range parts {
+		attributes[part.Name] = identityschema.StringAttribute{
+			RequiredForImport: !part.Optional,
+			OptionalForImport: part.Optional,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// ImportID returns the values of the given parts when importing a resource.
+// The values are parsed from the import ID, or read from the identity when
+// the resource is imported with an identity. Omitted optional parts are
+// returned as empty strings.
+func ImportID(ctx context.Context, req resource.ImportStateRequest, parts ...hcpvalidator.ImportIDPart) ([]string, diag.Diagnostics) {
+	if !ImportedWithIdentity(req) {
+		return hcpvalidator.ParseImportID(ctx, req.ID, parts...)
+	}
+
+	var diags diag.Diagnostics
+	values := make([]string, len(parts))
+	for i, part := range parts {
+		var value types.String
+		diags.Append(req.Identity.GetAttribute(ctx, path.Root(part.Name), &value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if value.ValueString() == "" {
+			if !part.Optional {
+				diags.AddAttributeError(
+					path.Root(part.Name),
+					"Invalid import identity",
+					fmt.Sprintf("The identity attribute %q is required to import this resource.", part.Name),
+				)
+			}
+			continue
+		}
+
+		values[i] = value.ValueString()
+		diags.Append(hcpvalidator.ValidateImportIDPart(ctx, part, values[i])...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return values, diags
+}
+
+// ImportedWithIdentity reports whether the resource is imported with an
+// identity, using an import block, rather than with an import ID.
+func ImportedWithIdentity(req resource.ImportStateRequest) bool {
+	return req.ID == "" && req.Identity != nil && !req.Identity.Raw.IsNull()
+}
+
+// SetFromState sets each attribute of the identity to the value of the state
+// attribute with the same name. It does nothing if the identity is nil, which
+// happens when the resource doesn't support identity.
+func SetFromState(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+
+	for name := range identity.Schema.GetAttributes() {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...)
+		diags.Append(identity.SetAttribute(ctx, path.Root(name), value)...)
+	}
+
+	return diags
+}
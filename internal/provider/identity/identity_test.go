@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+)
+
+const testProjectID = "b4ac9d8a-7f2e-4f5b-9c0d-2d3e4f5a6b7c"
+
+var testParts = []hcpvalidator.ImportIDPart{
+	{Name: "project_id", Optional: true, Validators: []validator.String{hcpvalidator.UUID()}},
+	{Name: "name", Validators: []validator.String{hcpvalidator.ResourceNamePart()}},
+}
+
+func newIdentity(ctx context.Context, values interface{}) *tfsdk.ResourceIdentity {
+	s := Schema(testParts...)
+	return &tfsdk.ResourceIdentity{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), values),
+	}
+}
+
+func TestImportID(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name          string
+		req           resource.ImportStateRequest
+		expected      []string
+		expectedError string
+	}{
+		{
+			name:     "Import ID",
+			req:      resource.ImportStateRequest{ID: testProjectID + ":my-resource"},
+			expected: []string{testProjectID, "my-resource"},
+		},
+		{
+			name: "Identity",
+			req: resource.ImportStateRequest{Identity: newIdentity(ctx, map[string]tftypes.Value{
+				"project_id": tftypes.NewValue(tftypes.String, testProjectID),
+				"name":       tftypes.NewValue(tftypes.String, "my-resource"),
+			})},
+			expected: []string{testProjectID, "my-resource"},
+		},
+		{
+			name: "Identity without optional part",
+			req: resource.ImportStateRequest{Identity: newIdentity(ctx, map[string]tftypes.Value{
+				"project_id": tftypes.NewValue(tftypes.String, nil),
+				"name":       tftypes.NewValue(tftypes.String, "my-resource"),
+			})},
+			expected: []string{"", "my-resource"},
+		},
+		{
+			name: "Identity without required part",
+			req: resource.ImportStateRequest{Identity: newIdentity(ctx, map[string]tftypes.Value{
+				"project_id": tftypes.NewValue(tftypes.String, testProjectID),
+				"name":       tftypes.NewValue(tftypes.String, nil),
+			})},
+			expectedError: `The identity attribute "name" is required to import this resource.`,
+		},
+		{
+			name: "Invalid identity",
+			req: resource.ImportStateRequest{Identity: newIdentity(ctx, map[string]tftypes.Value{
+				"project_id": tftypes.NewValue(tftypes.String, "my-project"),
+				"name":       tftypes.NewValue(tftypes.String, "my-resource"),
+			})},
+			expectedError: "must be a valid UUID",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			values, diags := ImportID(ctx, tc.req, testParts...)
+			if tc.expectedError != "" {
+				require.True(t, diags.HasError())
+				require.Contains(t, diags.Errors()[0].Detail(), tc.expectedError)
+				return
+			}
+
+			require.False(t, diags.HasError(), diags)
+			require.Equal(t, tc.expected, values)
+		})
+	}
+}
+
+func TestSetFromState(t *testing.T) {
+	ctx := context.Background()
+
+	stateSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id":  schema.StringAttribute{Computed: true},
+			"name":        schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true},
+		},
+	}
+	state := tfsdk.State{
+		Schema: stateSchema,
+		Raw: tftypes.NewValue(stateSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"project_id":  tftypes.NewValue(tftypes.String, testProjectID),
+			"name":        tftypes.NewValue(tftypes.String, "my-resource"),
+			"description": tftypes.NewValue(tftypes.String, "ignored"),
+		}),
+	}
+
+	identity := newIdentity(ctx, nil)
+	diags := SetFromState(ctx, state, identity)
+	require.False(t, diags.HasError(), diags)
+
+	var projectID, name types.String
+	identity.GetAttribute(ctx, path.Root("project_id"), &projectID)
+	identity.GetAttribute(ctx, path.Root("name"), &name)
+	require.Equal(t, testProjectID, projectID.ValueString())
+	require.Equal(t, "my-resource", name.ValueString())
+
+	require.False(t, SetFromState(ctx, state, nil).HasError())
+}
@@ -27,10 +27,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 )
 
 const TFProviderSourceChannel = "TERRAFORM"
 
+// streamingDestinationImportID is the import ID of a streaming destination,
+// which lives in the organization configured on the provider.
+var streamingDestinationImportID = []hcpvalidator.ImportIDPart{
+	{Name: "streaming_destination_id", Validators: []validator.String{hcpvalidator.UUID()}},
+}
+
+var _ resource.Resource = &resourceHCPLogStreamingDestination{}
+var _ resource.ResourceWithConfigure = &resourceHCPLogStreamingDestination{}
+var _ resource.ResourceWithImportState = &resourceHCPLogStreamingDestination{}
+var _ resource.ResourceWithIdentity = &resourceHCPLogStreamingDestination{}
+
 func NewHCPLogStreamingDestinationResource() resource.Resource {
 	return &resourceHCPLogStreamingDestination{}
 }
@@ -43,6 +56,10 @@ func (r *resourceHCPLogStreamingDestination) Metadata(_ context.Context, req res
 	resp.TypeName = req.ProviderTypeName + "_log_streaming_destination"
 }
 
+func (r *resourceHCPLogStreamingDestination) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(streamingDestinationImportID...)
+}
+
 func (r *resourceHCPLogStreamingDestination) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Streaming Destination resource allows users to configure an external log system to stream HCP logs to.",
@@ -388,11 +405,13 @@ func (r *resourceHCPLogStreamingDestination) Create(ctx context.Context, req res
 
 	resp.Diagnostics.Append(plan.fromModel(ctx, logStreamingDest)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourceHCPLogStreamingDestination) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state HCPLogStreamingDestination
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -525,3 +544,16 @@ func (r *resourceHCPLogStreamingDestination) Delete(ctx context.Context, req res
 		return
 	}
 }
+
+// ImportState imports a streaming destination by its ID. Sensitive values,
+// such as the Splunk Cloud token or the Datadog API key, are not returned by
+// the API and have to be set in the configuration after the import.
+func (r *resourceHCPLogStreamingDestination) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, diags := identity.ImportID(ctx, req, streamingDestinationImportID...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("streaming_destination_id"), parts[0])...)
+}
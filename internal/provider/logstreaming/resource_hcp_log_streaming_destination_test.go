@@ -140,10 +140,35 @@ func TestAccHCPLogStreamingDestinationCloudWatch(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch.log_group_name", "a-log-group-name"),
 				),
 			},
+			// Tests import by ID
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccHCPLogStreamingDestinationImportID(resourceName),
+				ImportStateVerifyIdentifierAttribute: "streaming_destination_id",
+			},
+			// Tests import by identity
+			{
+				ResourceName:    resourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 		},
 	})
 }
 
+func testAccHCPLogStreamingDestinationImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["streaming_destination_id"], nil
+	}
+}
+
 func testAccCloudWatchLogsConfig(name string) string {
 	return fmt.Sprintf(`
   		resource "hcp_log_streaming_destination" "test_cloudwatch" {
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package modifiers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+var _ planmodifier.String = caseInsensitiveModifier{}
+
+// caseInsensitiveModifier keeps the prior state value of a string attribute
+// when the planned value only differs from it in case.
+type caseInsensitiveModifier struct{}
+
+// CaseInsensitive returns a plan modifier for string attributes whose values
+// are case-insensitive, such as the enums of the HCP APIs. It keeps the prior
+// state value when the planned value only differs from it in case, so that no
+// update or replacement is planned. It must come before RequiresReplace in the
+// plan modifiers of the attribute.
+func CaseInsensitive() planmodifier.String {
+	return caseInsensitiveModifier{}
+}
+
+func (m caseInsensitiveModifier) Description(_ context.Context) string {
+	return "Changes of case of the value do not change the resource."
+}
+
+func (m caseInsensitiveModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m caseInsensitiveModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if strings.EqualFold(req.PlanValue.ValueString(), req.StateValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
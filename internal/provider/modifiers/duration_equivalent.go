@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package modifiers
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+var _ planmodifier.String = durationEquivalentModifier{}
+
+// durationEquivalentModifier keeps the prior state value of a duration string
+// attribute when the planned value is the same duration.
+type durationEquivalentModifier struct{}
+
+// DurationEquivalent returns a plan modifier for string attributes holding Go
+// durations, such as `24h` or `24h0m0s`. It keeps the prior state value when
+// the planned value is the same duration written differently, so that no
+// update is planned.
+func DurationEquivalent() planmodifier.String {
+	return durationEquivalentModifier{}
+}
+
+func (m durationEquivalentModifier) Description(_ context.Context) string {
+	return "Changes of the format of the duration do not change the resource."
+}
+
+func (m durationEquivalentModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m durationEquivalentModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	planned, err := time.ParseDuration(req.PlanValue.ValueString())
+	if err != nil {
+		return
+	}
+	prior, err := time.ParseDuration(req.StateValue.ValueString())
+	if err != nil {
+		return
+	}
+
+	if planned == prior {
+		resp.PlanValue = req.StateValue
+	}
+}
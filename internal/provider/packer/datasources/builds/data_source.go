@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package builds
+
+import (
+	"context"
+
+	packermodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-packer-service/stable/2023-01-01/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients/packerv2"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/utils"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/utils/base"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/utils/location"
+)
+
+func NewDataSource() datasource.DataSource {
+	params := base.DataSourceParams{
+		TypeName:   "builds",
+		PrettyName: "Builds",
+		Schema: schema.Schema{
+			Description: "The HCP Packer Builds data source lists the Builds of a Version.",
+			Attributes: map[string]schema.Attribute{
+				// Required Inputs
+				"bucket_name": schema.StringAttribute{
+					CustomType:  customtypes.SlugType{},
+					Description: "The name of the HCP Packer Bucket where the Version is located.",
+					Required:    true,
+				},
+				"version_fingerprint": schema.StringAttribute{
+					CustomType:  customtypes.PackerFingerprintType{},
+					Description: "The fingerprint of the HCP Packer Version to list Builds for.",
+					Required:    true,
+				},
+				// Optional Inputs
+				"status": schema.StringAttribute{
+					Description: "Only return Builds with this status.",
+					MarkdownDescription: "Only return Builds with this status. One of " +
+						"`BUILD_RUNNING`, `BUILD_DONE`, `BUILD_CANCELLED` or `BUILD_FAILED`.",
+					Optional:   true,
+					Validators: []validator.String{StatusValidator()},
+				},
+				"created_after": schema.StringAttribute{
+					Description: "Only return Builds created at or after this RFC3339 timestamp.",
+					Optional:    true,
+					Validators:  []validator.String{utils.RFC3339Validator()},
+				},
+				"created_before": schema.StringAttribute{
+					Description: "Only return Builds created at or before this RFC3339 timestamp.",
+					Optional:    true,
+					Validators:  []validator.String{utils.RFC3339Validator()},
+				},
+				"labels": schema.MapAttribute{
+					ElementType: types.StringType,
+					Description: "Only return Builds whose labels contain every key/value pair in this map.",
+					Optional:    true,
+				},
+				// Computed Outputs
+				"builds": schema.ListNestedAttribute{
+					Description: "The Builds of the HCP Packer Version that match the given filters.",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: Attributes(),
+					},
+				},
+			},
+		},
+	}
+
+	return &dataSource{
+		DataSourceBase: base.NewPackerDataSource(params),
+	}
+}
+
+// StatusValidator validates that a string is a filterable HCP Packer Build
+// status.
+func StatusValidator() validator.String {
+	return stringvalidator.OneOf(
+		string(packermodels.HashicorpCloudPacker20230101BuildStatusBUILDRUNNING),
+		string(packermodels.HashicorpCloudPacker20230101BuildStatusBUILDDONE),
+		string(packermodels.HashicorpCloudPacker20230101BuildStatusBUILDCANCELLED),
+		string(packermodels.HashicorpCloudPacker20230101BuildStatusBUILDFAILED),
+	)
+}
+
+// Attributes returns the computed schema of a single HCP Packer Build. It is
+// shared with data sources that embed Builds, such as `hcp_packer_versions`.
+func Attributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			CustomType:  customtypes.ULIDType{},
+			Description: "The ULID of the HCP Packer Build.",
+			Computed:    true,
+		},
+		"version_id": schema.StringAttribute{
+			CustomType:  customtypes.ULIDType{},
+			Description: "The ULID of the HCP Packer Version the Build belongs to.",
+			Computed:    true,
+		},
+		"component_type": schema.StringAttribute{
+			Description: "Name of the Packer builder or post-processor that produced the Build. Ex: `amazon-ebs.example`.",
+			Computed:    true,
+		},
+		"platform": schema.StringAttribute{
+			Description: "Name of the platform the Build produced Artifacts for.",
+			Computed:    true,
+		},
+		"packer_run_uuid": schema.StringAttribute{
+			CustomType:  customtypes.UUIDType{},
+			Description: "The UUID of the `packer build` run that produced the Build.",
+			Computed:    true,
+		},
+		"status": schema.StringAttribute{
+			Description: "The status of the Build.",
+			Computed:    true,
+		},
+		"source_external_identifier": schema.StringAttribute{
+			Description: "The ID or URL of the remote cloud source Artifact the Build was created from, if any.",
+			Computed:    true,
+		},
+		"labels": schema.MapAttribute{
+			ElementType: types.StringType,
+			Description: "Labels associated with the Build.",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The creation time of the Build.",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "The last time the Build was updated.",
+			Computed:    true,
+		},
+		"artifacts": schema.ListNestedAttribute{
+			Description: "The Artifacts produced by the Build.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						CustomType:  customtypes.ULIDType{},
+						Description: "The ULID of the HCP Packer Artifact.",
+						Computed:    true,
+					},
+					"external_identifier": schema.StringAttribute{
+						Description: "An external identifier for the HCP Packer Artifact.",
+						Computed:    true,
+					},
+					"region": schema.StringAttribute{
+						Description: "The Region where the HCP Packer Artifact is stored, if any.",
+						Computed:    true,
+					},
+					"created_at": schema.StringAttribute{
+						Description: "The creation time of the HCP Packer Artifact.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+type dataSource struct {
+	base.DataSourceBase
+}
+
+var _ datasource.DataSource = &dataSource{}
+
+type dataSourceModel struct {
+	OrganizationID customtypes.UUIDValue `tfsdk:"organization_id"`
+	ProjectID      customtypes.UUIDValue `tfsdk:"project_id"`
+
+	BucketName         customtypes.SlugValue              `tfsdk:"bucket_name"`
+	VersionFingerprint customtypes.PackerFingerprintValue `tfsdk:"version_fingerprint"`
+
+	Status        basetypes.StringValue `tfsdk:"status"`
+	CreatedAfter  basetypes.StringValue `tfsdk:"created_after"`
+	CreatedBefore basetypes.StringValue `tfsdk:"created_before"`
+	Labels        map[string]string     `tfsdk:"labels"`
+
+	Builds []BuildModel `tfsdk:"builds"`
+}
+
+var _ location.VersionLocation = dataSourceModel{}
+
+func (m dataSourceModel) GetOrganizationID() string {
+	return m.OrganizationID.ValueString()
+}
+
+func (m dataSourceModel) GetProjectID() string {
+	return m.ProjectID.ValueString()
+}
+
+func (m dataSourceModel) GetBucketName() string {
+	return m.BucketName.ValueString()
+}
+
+func (m dataSourceModel) GetVersionFingerprint() string {
+	return m.VersionFingerprint.ValueString()
+}
+
+func (m *dataSourceModel) populateFromLocationIfEmpty(location location.Location) {
+	if m.ProjectID.IsNull() || m.ProjectID.ValueString() == "" {
+		m.ProjectID = customtypes.NewUUIDValue(location.GetProjectID())
+	}
+	if m.OrganizationID.IsNull() || m.OrganizationID.ValueString() == "" {
+		m.OrganizationID = customtypes.NewUUIDValue(location.GetOrganizationID())
+	}
+}
+
+// BuildModel is the Terraform representation of a single HCP Packer Build.
+type BuildModel struct {
+	ID                       customtypes.ULIDValue `tfsdk:"id"`
+	VersionID                customtypes.ULIDValue `tfsdk:"version_id"`
+	ComponentType            basetypes.StringValue `tfsdk:"component_type"`
+	Platform                 basetypes.StringValue `tfsdk:"platform"`
+	PackerRunUUID            customtypes.UUIDValue `tfsdk:"packer_run_uuid"`
+	Status                   basetypes.StringValue `tfsdk:"status"`
+	SourceExternalIdentifier basetypes.StringValue `tfsdk:"source_external_identifier"`
+	Labels                   map[string]string     `tfsdk:"labels"`
+	CreatedAt                basetypes.StringValue `tfsdk:"created_at"`
+	UpdatedAt                basetypes.StringValue `tfsdk:"updated_at"`
+	Artifacts                []ArtifactModel       `tfsdk:"artifacts"`
+}
+
+// ArtifactModel is the Terraform representation of an Artifact nested in a
+// BuildModel.
+type ArtifactModel struct {
+	ID                 customtypes.ULIDValue `tfsdk:"id"`
+	ExternalIdentifier basetypes.StringValue `tfsdk:"external_identifier"`
+	Region             basetypes.StringValue `tfsdk:"region"`
+	CreatedAt          basetypes.StringValue `tfsdk:"created_at"`
+}
+
+// NewBuildModel converts an HCP Packer Build into its Terraform representation.
+func NewBuildModel(build *packerv2.Build) BuildModel {
+	if build == nil {
+		build = &packerv2.Build{}
+	}
+
+	status := ""
+	if build.Status != nil {
+		status = string(*build.Status)
+	}
+
+	labels := map[string]string{}
+	for k, v := range build.Labels {
+		labels[k] = v
+	}
+
+	artifacts := make([]ArtifactModel, 0, len(build.Artifacts))
+	for _, artifact := range build.Artifacts {
+		if artifact == nil {
+			continue
+		}
+		artifacts = append(artifacts, ArtifactModel{
+			ID:                 customtypes.NewULIDValue(artifact.ID),
+			ExternalIdentifier: types.StringValue(artifact.ExternalIdentifier),
+			Region:             types.StringValue(artifact.Region),
+			CreatedAt:          types.StringValue(artifact.CreatedAt.String()),
+		})
+	}
+
+	return BuildModel{
+		ID:                       customtypes.NewULIDValue(build.ID),
+		VersionID:                customtypes.NewULIDValue(build.VersionID),
+		ComponentType:            types.StringValue(build.ComponentType),
+		Platform:                 types.StringValue(build.Platform),
+		PackerRunUUID:            customtypes.NewUUIDValue(build.PackerRunUUID),
+		Status:                   types.StringValue(status),
+		SourceExternalIdentifier: types.StringValue(build.SourceExternalIdentifier),
+		Labels:                   labels,
+		CreatedAt:                types.StringValue(build.CreatedAt.String()),
+		UpdatedAt:                types.StringValue(build.UpdatedAt.String()),
+		Artifacts:                artifacts,
+	}
+}
+
+// Filter selects HCP Packer Builds by status, creation time and labels.
+// Zero-valued fields do not filter.
+type Filter struct {
+	Status  string
+	Created utils.TimeRange
+	Labels  map[string]string
+}
+
+// Matches reports whether build satisfies every condition of the filter.
+func (f Filter) Matches(build *packerv2.Build) bool {
+	if build == nil {
+		return false
+	}
+	if f.Status != "" && (build.Status == nil || string(*build.Status) != f.Status) {
+		return false
+	}
+	if !f.Created.Contains(build.CreatedAt) {
+		return false
+	}
+	return utils.LabelsMatch(build.Labels, f.Labels)
+}
+
+func (d *dataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get and validate config model from the request
+	var model dataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	// Get and validate client
+	client := d.Client()
+	resp.Diagnostics.Append(utils.CheckClient(client)...)
+
+	// Check for errors from previous steps
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.populateFromLocationIfEmpty(client)
+
+	created, diags := utils.NewTimeRange(path.Root("created_after"), model.CreatedAfter, path.Root("created_before"), model.CreatedBefore)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	filter := Filter{
+		Status:  model.Status.ValueString(),
+		Created: created,
+		Labels:  model.Labels,
+	}
+
+	builds, listDiags := packerv2.ListBuildsDiags(client, model)
+	resp.Diagnostics.Append(listDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Builds = []BuildModel{}
+	for _, build := range builds {
+		if filter.Matches(build) {
+			model.Builds = append(model.Builds, NewBuildModel(build))
+		}
+	}
+
+	// Set the state from the data source model and append any errors to the response
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package builds_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients/packerv2"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/testutils"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/testutils/configbuilder"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/testutils/configbuilder/packerconfig"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/testutils/testcheck"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/testutils/testclient"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/utils/location"
+)
+
+func TestAcc_Packer_Data_Builds_Simple(t *testing.T) {
+	// This is also checked further inside resource.ParallelTest, but we need to
+	// check it here because the next like DefaultProjectLocation tries to create the provider
+	// client, which it doesn't work in all evirnoments.
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set",
+			resource.EnvTfAcc)
+		return
+	}
+
+	loc := acctest.DefaultProjectLocation(t)
+
+	bucketName := testutils.CreateTestSlug("BuildsSimple")
+	fingerprint := acctest.RandString(32)
+
+	bucketLoc := location.GenericBucketLocation{
+		Location:   loc,
+		BucketName: bucketName,
+	}
+	versionLoc := location.GenericVersionLocation{
+		BucketLocation:     bucketLoc,
+		VersionFingerprint: fingerprint,
+	}
+
+	baseConfig := packerconfig.NewBuildsDataSourceBuilder("Simple")
+	baseConfig.SetBucketName(fmt.Sprintf("%q", bucketName))
+	baseConfig.SetVersionFingerprint(fmt.Sprintf("%q", fingerprint))
+
+	labeledConfig := packerconfig.CloneBuildsDataSourceBuilder(baseConfig)
+	labeledConfig.SetLabels(`{ "os" = "ubuntu" }`)
+
+	var labeledBuild *packerv2.Build
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testclient.UpsertRegistry(t, loc, nil)
+			testclient.UpsertBucket(t, loc, bucketName)
+			testclient.UpsertVersion(t, bucketLoc, fingerprint)
+			labeledBuild = testclient.InsertBuild(t, versionLoc, &testclient.UpsertBuildOptions{
+				Labels:        map[string]string{"os": "ubuntu"},
+				Platform:      "aws",
+				ComponentType: "amazon-ebs.ubuntu",
+				Artifacts: []*packerv2.CreateArtifactBody{
+					{
+						ExternalIdentifier: "ami-1234",
+						Region:             "us-east-1",
+					},
+				},
+				Complete: true,
+			})
+			testclient.InsertBuild(t, versionLoc, &testclient.UpsertBuildOptions{
+				Labels:        map[string]string{"os": "debian"},
+				Platform:      "aws",
+				ComponentType: "amazon-ebs.debian",
+				Complete:      true,
+			})
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(state *terraform.State) error {
+			loc := acctest.DefaultProjectLocation(t)
+			if err := testclient.DeleteBucket(t, loc, bucketName); err != nil {
+				return err
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{ // Check that the data source lists every build of the version
+				Config: configbuilder.BuildersToString(baseConfig),
+				Check: resource.ComposeTestCheckFunc(
+					testcheck.Attribute(baseConfig, "organization_id", loc.GetOrganizationID()),
+					testcheck.Attribute(baseConfig, "project_id", loc.GetProjectID()),
+					testcheck.Attribute(baseConfig, "builds.#", "2"),
+				),
+			},
+			{ // Check that the data source filters builds by labels
+				Config: configbuilder.BuildersToString(labeledConfig),
+				Check: resource.ComposeTestCheckFunc(
+					testcheck.Attribute(labeledConfig, "builds.#", "1"),
+					testcheck.Attribute(labeledConfig, "builds.0.component_type", "amazon-ebs.ubuntu"),
+					testcheck.Attribute(labeledConfig, "builds.0.status", "BUILD_DONE"),
+					testcheck.Attribute(labeledConfig, "builds.0.artifacts.#", "1"),
+					testcheck.Attribute(labeledConfig, "builds.0.artifacts.0.external_identifier", "ami-1234"),
+					func(state *terraform.State) error {
+						return testcheck.Attribute(labeledConfig, "builds.0.packer_run_uuid", labeledBuild.PackerRunUUID)(state)
+					},
+				),
+			},
+		},
+	})
+}
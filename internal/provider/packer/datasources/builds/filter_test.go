@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package builds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	packermodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-packer-service/stable/2023-01-01/models"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients/packerv2"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/utils"
+)
+
+func TestFilterMatches(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	before := created.Add(time.Hour)
+	after := created.Add(time.Minute)
+
+	build := &packerv2.Build{
+		Status:    packermodels.HashicorpCloudPacker20230101BuildStatusBUILDDONE.Pointer(),
+		CreatedAt: strfmt.DateTime(created),
+		Labels:    map[string]string{"os": "ubuntu", "arch": "arm64"},
+	}
+
+	cases := map[string]struct {
+		filter Filter
+		build  *packerv2.Build
+		want   bool
+	}{
+		"empty filter": {
+			filter: Filter{},
+			build:  build,
+			want:   true,
+		},
+		"nil build": {
+			filter: Filter{},
+			build:  nil,
+			want:   false,
+		},
+		"matching status": {
+			filter: Filter{Status: "BUILD_DONE"},
+			build:  build,
+			want:   true,
+		},
+		"other status": {
+			filter: Filter{Status: "BUILD_FAILED"},
+			build:  build,
+			want:   false,
+		},
+		"missing status": {
+			filter: Filter{Status: "BUILD_DONE"},
+			build:  &packerv2.Build{},
+			want:   false,
+		},
+		"inside time range": {
+			filter: Filter{Created: utils.TimeRange{Before: &before}},
+			build:  build,
+			want:   true,
+		},
+		"outside time range": {
+			filter: Filter{Created: utils.TimeRange{After: &after}},
+			build:  build,
+			want:   false,
+		},
+		"zero creation time with range": {
+			filter: Filter{Created: utils.TimeRange{Before: &before}},
+			build:  &packerv2.Build{},
+			want:   false,
+		},
+		"subset of labels": {
+			filter: Filter{Labels: map[string]string{"os": "ubuntu"}},
+			build:  build,
+			want:   true,
+		},
+		"mismatched label": {
+			filter: Filter{Labels: map[string]string{"os": "debian"}},
+			build:  build,
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.filter.Matches(tc.build); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
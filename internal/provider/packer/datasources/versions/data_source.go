@@ -0,0 +1,398 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package versions
+
+import (
+	"context"
+
+	packermodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-packer-service/stable/2023-01-01/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients/packerv2"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/datasources/builds"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/utils"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/utils/base"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/utils/location"
+)
+
+func NewDataSource() datasource.DataSource {
+	params := base.DataSourceParams{
+		TypeName:   "versions",
+		PrettyName: "Versions",
+		Schema: schema.Schema{
+			Description: "The HCP Packer Versions data source lists the Versions of a Bucket, " +
+				"including their Builds and parent Versions.",
+			Attributes: map[string]schema.Attribute{
+				// Required Inputs
+				"bucket_name": schema.StringAttribute{
+					CustomType:  customtypes.SlugType{},
+					Description: "The name of the HCP Packer Bucket to list Versions for.",
+					Required:    true,
+				},
+				// Optional Inputs
+				"status": schema.StringAttribute{
+					Description: "Only return Versions with this status.",
+					MarkdownDescription: "Only return Versions with this status. One of " +
+						"`VERSION_RUNNING`, `VERSION_CANCELLED`, `VERSION_FAILED`, `VERSION_REVOKED`, " +
+						"`VERSION_REVOCATION_SCHEDULED`, `VERSION_ACTIVE` or `VERSION_INCOMPLETE`.",
+					Optional:   true,
+					Validators: []validator.String{statusValidator()},
+				},
+				"created_after": schema.StringAttribute{
+					Description: "Only return Versions created at or after this RFC3339 timestamp.",
+					Optional:    true,
+					Validators:  []validator.String{utils.RFC3339Validator()},
+				},
+				"created_before": schema.StringAttribute{
+					Description: "Only return Versions created at or before this RFC3339 timestamp.",
+					Optional:    true,
+					Validators:  []validator.String{utils.RFC3339Validator()},
+				},
+				"labels": schema.MapAttribute{
+					ElementType: types.StringType,
+					Description: "Only return Versions with at least one Build whose labels contain every key/value pair in this map.",
+					Optional:    true,
+				},
+				// Computed Outputs
+				"versions": schema.ListNestedAttribute{
+					Description: "The Versions of the HCP Packer Bucket that match the given filters.",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								CustomType:  customtypes.ULIDType{},
+								Description: "The ULID of the HCP Packer Version.",
+								Computed:    true,
+							},
+							"name": schema.StringAttribute{
+								Description: "The name of the HCP Packer Version.",
+								Computed:    true,
+							},
+							"fingerprint": schema.StringAttribute{
+								CustomType:  customtypes.PackerFingerprintType{},
+								Description: "The fingerprint of the HCP Packer Version.",
+								Computed:    true,
+							},
+							"author_id": schema.StringAttribute{
+								Description: "The name of the person who created the HCP Packer Version.",
+								Computed:    true,
+							},
+							"status": schema.StringAttribute{
+								Description: "The status of the HCP Packer Version.",
+								Computed:    true,
+							},
+							"template_type": schema.StringAttribute{
+								Description: "The type of Packer configuration template used to build the HCP Packer Version.",
+								Computed:    true,
+							},
+							"has_descendants": schema.BoolAttribute{
+								Description: "Whether other HCP Packer Versions were built from this one.",
+								Computed:    true,
+							},
+							"created_at": schema.StringAttribute{
+								Description: "The creation time of the HCP Packer Version.",
+								Computed:    true,
+							},
+							"updated_at": schema.StringAttribute{
+								Description: "The last time the HCP Packer Version was updated.",
+								Computed:    true,
+							},
+							"revoke_at": schema.StringAttribute{
+								Description: "The revocation time of the HCP Packer Version. " +
+									"This field will be null for any Version that has not been revoked or scheduled for revocation.",
+								Computed: true,
+							},
+							"parents_status": schema.StringAttribute{
+								Description: "The overall status of the HCP Packer Version's parents. " +
+									"`OUT_OF_DATE` if at least one parent is out of date.",
+								Computed: true,
+							},
+							"parents": schema.ListNestedAttribute{
+								Description: "The parent Versions the HCP Packer Version was built from.",
+								Computed:    true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"bucket_name": schema.StringAttribute{
+											Description: "The name of the parent HCP Packer Bucket.",
+											Computed:    true,
+										},
+										"channel_name": schema.StringAttribute{
+											Description: "The name of the parent HCP Packer Channel the Version was built from.",
+											Computed:    true,
+										},
+										"version_id": schema.StringAttribute{
+											Description: "The ULID of the parent HCP Packer Version.",
+											Computed:    true,
+										},
+										"version_fingerprint": schema.StringAttribute{
+											Description: "The fingerprint of the parent HCP Packer Version.",
+											Computed:    true,
+										},
+										"version_name": schema.StringAttribute{
+											Description: "The name of the parent HCP Packer Version.",
+											Computed:    true,
+										},
+										"status": schema.StringAttribute{
+											Description: "The status of the relationship with the parent. " +
+												"`OUT_OF_DATE` if the parent Channel has since been assigned a different Version.",
+											Computed: true,
+										},
+									},
+								},
+							},
+							"builds": schema.ListNestedAttribute{
+								Description: "The Builds of the HCP Packer Version.",
+								Computed:    true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: builds.Attributes(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return &dataSource{
+		DataSourceBase: base.NewPackerDataSource(params),
+	}
+}
+
+func statusValidator() validator.String {
+	return stringvalidator.OneOf(
+		string(packermodels.HashicorpCloudPacker20230101VersionStatusVERSIONRUNNING),
+		string(packermodels.HashicorpCloudPacker20230101VersionStatusVERSIONCANCELLED),
+		string(packermodels.HashicorpCloudPacker20230101VersionStatusVERSIONFAILED),
+		string(packermodels.HashicorpCloudPacker20230101VersionStatusVERSIONREVOKED),
+		string(packermodels.HashicorpCloudPacker20230101VersionStatusVERSIONREVOCATIONSCHEDULED),
+		string(packermodels.HashicorpCloudPacker20230101VersionStatusVERSIONACTIVE),
+		string(packermodels.HashicorpCloudPacker20230101VersionStatusVERSIONINCOMPLETE),
+	)
+}
+
+type dataSource struct {
+	base.DataSourceBase
+}
+
+var _ datasource.DataSource = &dataSource{}
+
+type dataSourceModel struct {
+	OrganizationID customtypes.UUIDValue `tfsdk:"organization_id"`
+	ProjectID      customtypes.UUIDValue `tfsdk:"project_id"`
+
+	BucketName customtypes.SlugValue `tfsdk:"bucket_name"`
+
+	Status        basetypes.StringValue `tfsdk:"status"`
+	CreatedAfter  basetypes.StringValue `tfsdk:"created_after"`
+	CreatedBefore basetypes.StringValue `tfsdk:"created_before"`
+	Labels        map[string]string     `tfsdk:"labels"`
+
+	Versions []versionModel `tfsdk:"versions"`
+}
+
+type versionModel struct {
+	ID             customtypes.ULIDValue              `tfsdk:"id"`
+	Name           basetypes.StringValue              `tfsdk:"name"`
+	Fingerprint    customtypes.PackerFingerprintValue `tfsdk:"fingerprint"`
+	AuthorID       basetypes.StringValue              `tfsdk:"author_id"`
+	Status         basetypes.StringValue              `tfsdk:"status"`
+	TemplateType   basetypes.StringValue              `tfsdk:"template_type"`
+	HasDescendants basetypes.BoolValue                `tfsdk:"has_descendants"`
+	CreatedAt      basetypes.StringValue              `tfsdk:"created_at"`
+	UpdatedAt      basetypes.StringValue              `tfsdk:"updated_at"`
+	RevokeAt       basetypes.StringValue              `tfsdk:"revoke_at"`
+	ParentsStatus  basetypes.StringValue              `tfsdk:"parents_status"`
+	Parents        []parentModel                      `tfsdk:"parents"`
+	Builds         []builds.BuildModel                `tfsdk:"builds"`
+}
+
+type parentModel struct {
+	BucketName         basetypes.StringValue `tfsdk:"bucket_name"`
+	ChannelName        basetypes.StringValue `tfsdk:"channel_name"`
+	VersionID          basetypes.StringValue `tfsdk:"version_id"`
+	VersionFingerprint basetypes.StringValue `tfsdk:"version_fingerprint"`
+	VersionName        basetypes.StringValue `tfsdk:"version_name"`
+	Status             basetypes.StringValue `tfsdk:"status"`
+}
+
+var _ location.BucketLocation = dataSourceModel{}
+
+func (m dataSourceModel) GetOrganizationID() string {
+	return m.OrganizationID.ValueString()
+}
+
+func (m dataSourceModel) GetProjectID() string {
+	return m.ProjectID.ValueString()
+}
+
+func (m dataSourceModel) GetBucketName() string {
+	return m.BucketName.ValueString()
+}
+
+func (m *dataSourceModel) populateFromLocationIfEmpty(location location.Location) {
+	if m.ProjectID.IsNull() || m.ProjectID.ValueString() == "" {
+		m.ProjectID = customtypes.NewUUIDValue(location.GetProjectID())
+	}
+	if m.OrganizationID.IsNull() || m.OrganizationID.ValueString() == "" {
+		m.OrganizationID = customtypes.NewUUIDValue(location.GetOrganizationID())
+	}
+}
+
+func newVersionModel(version *packerv2.Version, parents []*packerv2.BucketAncestry) versionModel {
+	if version == nil {
+		version = &packerv2.Version{}
+	}
+
+	m := versionModel{
+		ID:             customtypes.NewULIDValue(version.ID),
+		Name:           types.StringValue(version.Name),
+		Fingerprint:    customtypes.NewPackerFingerprintValue(version.Fingerprint),
+		AuthorID:       types.StringValue(version.AuthorID),
+		Status:         types.StringNull(),
+		TemplateType:   types.StringNull(),
+		HasDescendants: types.BoolValue(version.HasDescendants),
+		CreatedAt:      types.StringValue(version.CreatedAt.String()),
+		UpdatedAt:      types.StringValue(version.UpdatedAt.String()),
+		RevokeAt:       types.StringNull(),
+		ParentsStatus:  types.StringNull(),
+		Parents:        []parentModel{},
+		Builds:         []builds.BuildModel{},
+	}
+
+	if version.Status != nil {
+		m.Status = types.StringValue(string(*version.Status))
+	}
+	if version.TemplateType != nil {
+		m.TemplateType = types.StringValue(string(*version.TemplateType))
+	}
+	if !version.RevokeAt.IsZero() {
+		m.RevokeAt = types.StringValue(version.RevokeAt.String())
+	}
+	if version.Parents != nil && version.Parents.Status != nil {
+		m.ParentsStatus = types.StringValue(string(*version.Parents.Status))
+	}
+
+	for _, relation := range parents {
+		if relation == nil || relation.Parent == nil {
+			continue
+		}
+		parent := parentModel{
+			BucketName:         types.StringValue(relation.Parent.BucketName),
+			ChannelName:        types.StringValue(relation.Parent.ChannelName),
+			VersionID:          types.StringValue(relation.Parent.VersionID),
+			VersionFingerprint: types.StringValue(relation.Parent.VersionFingerprint),
+			VersionName:        types.StringValue(relation.Parent.VersionName),
+			Status:             types.StringNull(),
+		}
+		if relation.Status != nil {
+			parent.Status = types.StringValue(string(*relation.Status))
+		}
+		m.Parents = append(m.Parents, parent)
+	}
+
+	for _, build := range version.Builds {
+		if build == nil {
+			continue
+		}
+		m.Builds = append(m.Builds, builds.NewBuildModel(build))
+	}
+
+	return m
+}
+
+// filter selects HCP Packer Versions by status, creation time and the labels
+// of their Builds. Zero-valued fields do not filter.
+type filter struct {
+	status  string
+	created utils.TimeRange
+	labels  map[string]string
+}
+
+func (f filter) matches(version *packerv2.Version) bool {
+	if version == nil {
+		return false
+	}
+	if f.status != "" && (version.Status == nil || string(*version.Status) != f.status) {
+		return false
+	}
+	if !f.created.Contains(version.CreatedAt) {
+		return false
+	}
+	if len(f.labels) == 0 {
+		return true
+	}
+	for _, build := range version.Builds {
+		if build != nil && utils.LabelsMatch(build.Labels, f.labels) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *dataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get and validate config model from the request
+	var model dataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	// Get and validate client
+	client := d.Client()
+	resp.Diagnostics.Append(utils.CheckClient(client)...)
+
+	// Check for errors from previous steps
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.populateFromLocationIfEmpty(client)
+
+	created, diags := utils.NewTimeRange(path.Root("created_after"), model.CreatedAfter, path.Root("created_before"), model.CreatedBefore)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	f := filter{
+		status:  model.Status.ValueString(),
+		created: created,
+		labels:  model.Labels,
+	}
+
+	versions, listDiags := packerv2.ListVersionsDiags(client, model)
+	resp.Diagnostics.Append(listDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Versions = []versionModel{}
+	for _, version := range versions {
+		if !f.matches(version) {
+			continue
+		}
+
+		// Parents are only listed for Versions that survived filtering, since
+		// the registry requires one ancestry request per Version.
+		parents, err := packerv2.ListVersionParents(client, location.GenericVersionLocation{
+			BucketLocation:     model,
+			VersionFingerprint: version.Fingerprint,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"failed to list parent Versions, received an error from the HCP Packer API",
+				err.Error(),
+			)
+			return
+		}
+
+		model.Versions = append(model.Versions, newVersionModel(version, parents))
+	}
+
+	// Set the state from the data source model and append any errors to the response
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
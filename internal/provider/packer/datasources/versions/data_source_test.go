@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package versions_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/testutils"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/testutils/configbuilder"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/testutils/configbuilder/packerconfig"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/testutils/testcheck"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/testutils/testclient"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/utils/location"
+)
+
+func TestAcc_Packer_Data_Versions_Simple(t *testing.T) {
+	// This is also checked further inside resource.ParallelTest, but we need to
+	// check it here because the next like DefaultProjectLocation tries to create the provider
+	// client, which it doesn't work in all evirnoments.
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set",
+			resource.EnvTfAcc)
+		return
+	}
+
+	loc := acctest.DefaultProjectLocation(t)
+
+	bucketName := testutils.CreateTestSlug("VersionsSimple")
+	labeledFingerprint := acctest.RandString(32)
+	plainFingerprint := acctest.RandString(32)
+
+	bucketLoc := location.GenericBucketLocation{
+		Location:   loc,
+		BucketName: bucketName,
+	}
+
+	baseConfig := packerconfig.NewVersionsDataSourceBuilder("Simple")
+	baseConfig.SetBucketName(fmt.Sprintf("%q", bucketName))
+
+	labeledConfig := packerconfig.CloneVersionsDataSourceBuilder(baseConfig)
+	labeledConfig.SetLabels(`{ "os" = "ubuntu" }`)
+
+	activeConfig := packerconfig.CloneVersionsDataSourceBuilder(baseConfig)
+	activeConfig.SetStatus(`"VERSION_ACTIVE"`)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testclient.UpsertRegistry(t, loc, nil)
+			testclient.UpsertBucket(t, loc, bucketName)
+			testclient.UpsertCompleteVersion(t, bucketLoc, labeledFingerprint, &testclient.UpsertBuildOptions{
+				Labels:        map[string]string{"os": "ubuntu"},
+				Platform:      "aws",
+				ComponentType: "amazon-ebs.example",
+			})
+			testclient.UpsertCompleteVersion(t, bucketLoc, plainFingerprint, nil)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(state *terraform.State) error {
+			loc := acctest.DefaultProjectLocation(t)
+			if err := testclient.DeleteBucket(t, loc, bucketName); err != nil {
+				return err
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{ // Check that the data source lists every version of the bucket
+				Config: configbuilder.BuildersToString(baseConfig),
+				Check: resource.ComposeTestCheckFunc(
+					testcheck.Attribute(baseConfig, "organization_id", loc.GetOrganizationID()),
+					testcheck.Attribute(baseConfig, "project_id", loc.GetProjectID()),
+					testcheck.Attribute(baseConfig, "bucket_name", bucketName),
+					testcheck.Attribute(baseConfig, "versions.#", "2"),
+				),
+			},
+			{ // Check that the data source filters versions by build labels
+				Config: configbuilder.BuildersToString(labeledConfig),
+				Check: resource.ComposeTestCheckFunc(
+					testcheck.Attribute(labeledConfig, "versions.#", "1"),
+					testcheck.Attribute(labeledConfig, "versions.0.fingerprint", labeledFingerprint),
+					testcheck.Attribute(labeledConfig, "versions.0.builds.#", "1"),
+					testcheck.Attribute(labeledConfig, "versions.0.builds.0.labels.os", "ubuntu"),
+					testcheck.Attribute(labeledConfig, "versions.0.builds.0.component_type", "amazon-ebs.example"),
+					testcheck.AttributeSet(labeledConfig, "versions.0.builds.0.packer_run_uuid"),
+				),
+			},
+			{ // Check that the data source filters versions by status
+				Config: configbuilder.BuildersToString(activeConfig),
+				Check: resource.ComposeTestCheckFunc(
+					testcheck.Attribute(activeConfig, "versions.#", "2"),
+					testcheck.Attribute(activeConfig, "versions.0.status", "VERSION_ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Packer_Data_Versions_InvalidInputs(t *testing.T) {
+	bucketName := testutils.CreateTestSlug("InvalidInputs")
+
+	baseConfig := packerconfig.NewVersionsDataSourceBuilder("Invalid")
+	baseConfig.SetBucketName(fmt.Sprintf("%q", bucketName))
+
+	invalidStatus := packerconfig.CloneVersionsDataSourceBuilder(baseConfig)
+	invalidStatus.SetStatus(`"ACTIVE"`)
+
+	invalidTimestamp := packerconfig.CloneVersionsDataSourceBuilder(baseConfig)
+	invalidTimestamp.SetAttribute("created_after", `"yesterday"`)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{ // Check that the data source fails when the status is not a version status
+				Config:      configbuilder.BuildersToString(invalidStatus),
+				ExpectError: regexp.MustCompile(".*value must be one of.*"),
+			},
+			{ // Check that the data source fails when the time range is not RFC3339
+				Config:      configbuilder.BuildersToString(invalidTimestamp),
+				ExpectError: regexp.MustCompile(".*must be an RFC3339 timestamp.*"),
+			},
+		},
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package versions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	packermodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-packer-service/stable/2023-01-01/models"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients/packerv2"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/utils"
+)
+
+func TestFilterMatches(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	after := created.Add(-time.Hour)
+	before := created.Add(-time.Minute)
+
+	version := &packerv2.Version{
+		Status:    packermodels.HashicorpCloudPacker20230101VersionStatusVERSIONACTIVE.Pointer(),
+		CreatedAt: strfmt.DateTime(created),
+		Builds: []*packerv2.Build{
+			{Labels: map[string]string{"os": "debian"}},
+			{Labels: map[string]string{"os": "ubuntu", "arch": "arm64"}},
+		},
+	}
+
+	cases := map[string]struct {
+		filter filter
+		want   bool
+	}{
+		"empty filter": {
+			filter: filter{},
+			want:   true,
+		},
+		"matching status": {
+			filter: filter{status: "VERSION_ACTIVE"},
+			want:   true,
+		},
+		"other status": {
+			filter: filter{status: "VERSION_REVOKED"},
+			want:   false,
+		},
+		"inside time range": {
+			filter: filter{created: utils.TimeRange{After: &after}},
+			want:   true,
+		},
+		"outside time range": {
+			filter: filter{created: utils.TimeRange{After: &after, Before: &before}},
+			want:   false,
+		},
+		"labels of one build": {
+			filter: filter{labels: map[string]string{"os": "ubuntu", "arch": "arm64"}},
+			want:   true,
+		},
+		"labels spread across builds": {
+			filter: filter{labels: map[string]string{"os": "debian", "arch": "arm64"}},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.filter.matches(version); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/datasources/artifact"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/datasources/builds"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/datasources/version"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/datasources/versions"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/resources/bucket"
 )
 
@@ -23,5 +25,7 @@ var ResourceSchemaBuilders []func() resource.Resource = []func() resource.Resour
 // Framework provider. To add a new data source, add a new function to this list.
 var DataSourceSchemaBuilders []func() datasource.DataSource = []func() datasource.DataSource{
 	version.NewDataSource,
+	versions.NewDataSource,
 	artifact.NewDataSource,
+	builds.NewDataSource,
 }
@@ -23,12 +23,14 @@ import (
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients/packerv2"
 	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourcePackerBucket{}
 var _ resource.ResourceWithImportState = &resourcePackerBucket{}
+var _ resource.ResourceWithIdentity = &resourcePackerBucket{}
 var _ resource.ResourceWithConfigure = &resourcePackerBucket{}
 var _ resource.ResourceWithModifyPlan = &resourcePackerBucket{}
 
@@ -160,6 +162,7 @@ func (r *resourcePackerBucket) Create(ctx context.Context, req resource.CreateRe
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourcePackerBucket) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -167,6 +170,7 @@ func (r *resourcePackerBucket) Read(ctx context.Context, req resource.ReadReques
 
 	// Read Terraform prior state into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -221,10 +225,18 @@ func (r *resourcePackerBucket) Delete(ctx context.Context, req resource.DeleteRe
 	}
 }
 
+func (r *resourcePackerBucket) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(hcpvalidator.ImportIDPart{Name: "resource_name"})
+}
+
 func (r *resourcePackerBucket) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Save Resource Name to the State
-	resource.ImportStatePassthroughID(ctx, path.Root("resource_name"), req, resp)
-	resourceName := req.ID
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("resource_name"), path.Root("resource_name"), req, resp)
+	var resourceName string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("resource_name"), &resourceName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	resourceNameRegex := `packer\/project\/.*\/bucket\/.*`
 	// packer/project/{project_id}/bucket/{bucket_name}
 
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package packerconfig
+
+import "github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/testutils/configbuilder"
+
+type BuildsDataSourceBuilder interface {
+	configbuilder.DataSourceBuilder
+
+	SetBucketName(bucketName string)
+	GetBucketName() string
+	SetVersionFingerprint(fingerprint string)
+	GetVersionFingerprint() string
+	SetStatus(status string)
+	GetStatus() string
+	SetLabels(labels string)
+	GetLabels() string
+}
+
+func NewBuildsDataSourceBuilder(uniqueName string) BuildsDataSourceBuilder {
+	return &buildsDataSourceBuilder{
+		newPackerDataSourceBuilder("builds", uniqueName),
+	}
+}
+
+func CloneBuildsDataSourceBuilder(oldBuilder BuildsDataSourceBuilder) BuildsDataSourceBuilder {
+	return &buildsDataSourceBuilder{
+		configbuilder.CloneDataSourceBuilder(oldBuilder),
+	}
+}
+
+type buildsDataSourceBuilder struct {
+	configbuilder.DataSourceBuilder
+}
+
+var _ BuildsDataSourceBuilder = &buildsDataSourceBuilder{}
+
+func (b *buildsDataSourceBuilder) SetBucketName(bucketName string) {
+	b.SetAttribute("bucket_name", bucketName)
+}
+
+func (b *buildsDataSourceBuilder) GetBucketName() string {
+	return b.GetAttribute("bucket_name")
+}
+
+func (b *buildsDataSourceBuilder) SetVersionFingerprint(fingerprint string) {
+	b.SetAttribute("version_fingerprint", fingerprint)
+}
+
+func (b *buildsDataSourceBuilder) GetVersionFingerprint() string {
+	return b.GetAttribute("version_fingerprint")
+}
+
+func (b *buildsDataSourceBuilder) SetStatus(status string) {
+	b.SetAttribute("status", status)
+}
+
+func (b *buildsDataSourceBuilder) GetStatus() string {
+	return b.GetAttribute("status")
+}
+
+func (b *buildsDataSourceBuilder) SetLabels(labels string) {
+	b.SetAttribute("labels", labels)
+}
+
+func (b *buildsDataSourceBuilder) GetLabels() string {
+	return b.GetAttribute("labels")
+}
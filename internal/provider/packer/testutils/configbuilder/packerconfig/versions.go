@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package packerconfig
+
+import "github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/testutils/configbuilder"
+
+type VersionsDataSourceBuilder interface {
+	configbuilder.DataSourceBuilder
+
+	SetBucketName(bucketName string)
+	GetBucketName() string
+	SetStatus(status string)
+	GetStatus() string
+	SetLabels(labels string)
+	GetLabels() string
+}
+
+func NewVersionsDataSourceBuilder(uniqueName string) VersionsDataSourceBuilder {
+	return &versionsDataSourceBuilder{
+		newPackerDataSourceBuilder("versions", uniqueName),
+	}
+}
+
+func CloneVersionsDataSourceBuilder(oldBuilder VersionsDataSourceBuilder) VersionsDataSourceBuilder {
+	return &versionsDataSourceBuilder{
+		configbuilder.CloneDataSourceBuilder(oldBuilder),
+	}
+}
+
+type versionsDataSourceBuilder struct {
+	configbuilder.DataSourceBuilder
+}
+
+var _ VersionsDataSourceBuilder = &versionsDataSourceBuilder{}
+
+func (b *versionsDataSourceBuilder) SetBucketName(bucketName string) {
+	b.SetAttribute("bucket_name", bucketName)
+}
+
+func (b *versionsDataSourceBuilder) GetBucketName() string {
+	return b.GetAttribute("bucket_name")
+}
+
+func (b *versionsDataSourceBuilder) SetStatus(status string) {
+	b.SetAttribute("status", status)
+}
+
+func (b *versionsDataSourceBuilder) GetStatus() string {
+	return b.GetAttribute("status")
+}
+
+func (b *versionsDataSourceBuilder) SetLabels(labels string) {
+	b.SetAttribute("labels", labels)
+}
+
+func (b *versionsDataSourceBuilder) GetLabels() string {
+	return b.GetAttribute("labels")
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// TimeRange is an optional, inclusive window used to filter HCP Packer
+// registry objects by their creation time. A nil bound is unbounded.
+type TimeRange struct {
+	After  *time.Time
+	Before *time.Time
+}
+
+// NewTimeRange builds a TimeRange from a pair of optional RFC3339 strings.
+// Values are expected to have been checked with RFC3339Validator already.
+// Invalid values are reported at the path of the attribute they come from.
+func NewTimeRange(afterPath path.Path, after basetypes.StringValue, beforePath path.Path, before basetypes.StringValue) (TimeRange, diag.Diagnostics) {
+	var r TimeRange
+	var diags diag.Diagnostics
+
+	if !after.IsNull() && !after.IsUnknown() {
+		t, err := time.Parse(time.RFC3339, after.ValueString())
+		if err != nil {
+			diags.AddAttributeError(afterPath, "Invalid time range", fmt.Sprintf("invalid lower time bound: %s", err))
+		} else {
+			r.After = &t
+		}
+	}
+
+	if !before.IsNull() && !before.IsUnknown() {
+		t, err := time.Parse(time.RFC3339, before.ValueString())
+		if err != nil {
+			diags.AddAttributeError(beforePath, "Invalid time range", fmt.Sprintf("invalid upper time bound: %s", err))
+		} else {
+			r.Before = &t
+		}
+	}
+
+	return r, diags
+}
+
+// Contains reports whether t is within the range. Zero timestamps, which the
+// API returns for objects that have not recorded a time, never match a
+// bounded range.
+func (r TimeRange) Contains(t strfmt.DateTime) bool {
+	if r.After == nil && r.Before == nil {
+		return true
+	}
+	if t.IsZero() {
+		return false
+	}
+
+	ts := time.Time(t)
+	if r.After != nil && ts.Before(*r.After) {
+		return false
+	}
+	if r.Before != nil && ts.After(*r.Before) {
+		return false
+	}
+	return true
+}
+
+// LabelsMatch reports whether labels contains every key-value pair in want.
+func LabelsMatch(labels, want map[string]string) bool {
+	for k, v := range want {
+		if got, ok := labels[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+// RFC3339Validator validates that a string attribute is an RFC3339 timestamp.
+func RFC3339Validator() validator.String {
+	return rfc3339Validator{}
+}
+
+type rfc3339Validator struct{}
+
+var _ validator.String = rfc3339Validator{}
+
+func (rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC3339 timestamp, such as 2006-01-02T15:04:05Z"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("%s, got %q: %s", v.Description(ctx), req.ConfigValue.ValueString(), err),
+		)
+	}
+}
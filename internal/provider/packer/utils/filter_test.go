@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewTimeRange(t *testing.T) {
+	afterPath := path.Root("created_after")
+	beforePath := path.Root("created_before")
+
+	cases := map[string]struct {
+		after, before types.String
+		wantAfter     bool
+		wantBefore    bool
+		wantPaths     []path.Path
+	}{
+		"unbounded": {
+			after:  types.StringNull(),
+			before: types.StringUnknown(),
+		},
+		"bounded": {
+			after:      types.StringValue("2024-05-01T00:00:00Z"),
+			before:     types.StringValue("2024-06-01T00:00:00Z"),
+			wantAfter:  true,
+			wantBefore: true,
+		},
+		"invalid lower bound": {
+			after:      types.StringValue("yesterday"),
+			before:     types.StringValue("2024-06-01T00:00:00Z"),
+			wantBefore: true,
+			wantPaths:  []path.Path{afterPath},
+		},
+		"invalid upper bound": {
+			after:     types.StringValue("2024-05-01T00:00:00Z"),
+			before:    types.StringValue("tomorrow"),
+			wantAfter: true,
+			wantPaths: []path.Path{beforePath},
+		},
+		"invalid bounds": {
+			after:     types.StringValue("yesterday"),
+			before:    types.StringValue("tomorrow"),
+			wantPaths: []path.Path{afterPath, beforePath},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, diags := NewTimeRange(afterPath, tc.after, beforePath, tc.before)
+
+			if got := r.After != nil; got != tc.wantAfter {
+				t.Errorf("After set = %t; want %t", got, tc.wantAfter)
+			}
+			if got := r.Before != nil; got != tc.wantBefore {
+				t.Errorf("Before set = %t; want %t", got, tc.wantBefore)
+			}
+
+			errs := diags.Errors()
+			if len(errs) != len(tc.wantPaths) {
+				t.Fatalf("errors = %v; want %d", errs, len(tc.wantPaths))
+			}
+			for i, want := range tc.wantPaths {
+				withPath, ok := errs[i].(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(want) {
+					t.Errorf("error %d = %v; want it reported at %s", i, errs[i], want)
+				}
+			}
+		})
+	}
+}
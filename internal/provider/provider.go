@@ -23,10 +23,13 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/config/geography"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/boundary"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/consul"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/iam"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/logstreaming"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/resourcemanager"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/vault"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/vaultradar"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/vaultsecrets"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/waypoint"
@@ -161,9 +164,21 @@ func (p *ProviderFramework) Resources(ctx context.Context) []func() resource.Res
 		resourcemanager.NewProjectResource,
 		resourcemanager.NewProjectIAMPolicyResource,
 		resourcemanager.NewProjectIAMBindingResource,
+		// Boundary
+		boundary.NewClusterResource,
+		boundary.NewWorkerResource,
+		// Consul
+		consul.NewClusterResource,
+		// Vault
+		vault.NewClusterMetricsStreamingResource,
+		vault.NewClusterAuditLogStreamingResource,
+		vault.NewReplicationGroupResource,
+		vault.NewClusterSnapshotResource,
+		vault.NewClusterSnapshotRestoreResource,
 		// Vault Secrets
 		vaultsecrets.NewVaultSecretsAppResource,
 		vaultsecrets.NewVaultSecretsSecretResource,
+		vaultsecrets.NewVaultSecretsSecretsResource,
 		vaultsecrets.NewVaultSecretsAppIAMPolicyResource,
 		vaultsecrets.NewVaultSecretsAppIAMBindingResource,
 		vaultsecrets.NewVaultSecretsIntegrationResource,
@@ -191,6 +206,7 @@ func (p *ProviderFramework) Resources(ctx context.Context) []func() resource.Res
 		webhook.NewNotificationsWebhookResource,
 		// Waypoint
 		waypoint.NewActionResource,
+		waypoint.NewActionRunResource,
 		waypoint.NewAgentGroupResource,
 		waypoint.NewApplicationResource,
 		waypoint.NewTemplateResource,
@@ -216,9 +232,16 @@ func (p *ProviderFramework) DataSources(ctx context.Context) []func() datasource
 		resourcemanager.NewProjectDataSource,
 		resourcemanager.NewOrganizationDataSource,
 		resourcemanager.NewIAMPolicyDataSource,
+		// Consul
+		consul.NewSnapshotsDataSource,
+		// Vault
+		vault.NewClusterSnapshotsDataSource,
+		vault.NewClusterPendingUpgradesDataSource,
+		vault.NewClusterConnectionDataSource,
 		// Vault Secrets
 		vaultsecrets.NewVaultSecretsAppDataSource,
 		vaultsecrets.NewVaultSecretsSecretDataSource,
+		vaultsecrets.NewVaultSecretsSecretVersionsDataSource,
 		vaultsecrets.NewVaultSecretsRotatingSecretDataSource,
 		vaultsecrets.NewVaultSecretsDynamicSecretDataSource,
 		// IAM
@@ -228,6 +251,8 @@ func (p *ProviderFramework) DataSources(ctx context.Context) []func() datasource
 		// Waypoint
 		waypoint.NewActionDataSource,
 		waypoint.NewAgentGroupDataSource,
+		waypoint.NewAgentGroupActionsDataSource,
+		waypoint.NewAgentConfigDataSource,
 		waypoint.NewApplicationDataSource,
 		waypoint.NewTemplateDataSource,
 		waypoint.NewAddOnDataSource,
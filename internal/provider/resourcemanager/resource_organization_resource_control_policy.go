@@ -17,8 +17,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"google.golang.org/grpc/codes"
 )
 
@@ -42,6 +45,13 @@ type resourceOrganizationResourceControlPolicy struct {
 var _ resource.Resource = &resourceOrganizationResourceControlPolicy{}
 var _ resource.ResourceWithConfigure = &resourceOrganizationResourceControlPolicy{}
 var _ resource.ResourceWithImportState = &resourceOrganizationResourceControlPolicy{}
+var _ resource.ResourceWithIdentity = &resourceOrganizationResourceControlPolicy{}
+
+// resourceControlPolicyImportID is the import ID of the resource control
+// policy, which is identified by its organization.
+var resourceControlPolicyImportID = []hcpvalidator.ImportIDPart{
+	{Name: "organization_id", Validators: []validator.String{hcpvalidator.UUID()}},
+}
 
 func (r *resourceOrganizationResourceControlPolicy) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_resource_control_policy"
@@ -80,6 +90,10 @@ func (r *resourceOrganizationResourceControlPolicy) Schema(_ context.Context, _
 	}
 }
 
+func (r *resourceOrganizationResourceControlPolicy) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(resourceControlPolicyImportID...)
+}
+
 func (r *resourceOrganizationResourceControlPolicy) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -152,12 +166,14 @@ func (r *resourceOrganizationResourceControlPolicy) Create(ctx context.Context,
 	// Step 4: Map policy into state.
 	state := policyToModel(policy)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 // Read implements resource.Resource.
 func (r *resourceOrganizationResourceControlPolicy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state resourceControlPolicyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -257,14 +273,13 @@ func (r *resourceOrganizationResourceControlPolicy) Delete(ctx context.Context,
 // ImportState implements resource.ResourceWithImportState. Import ID is the
 // organization ID.
 func (r *resourceOrganizationResourceControlPolicy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	orgID := strings.TrimSpace(req.ID)
-	if orgID == "" {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			"Import ID must be the organization identifier.",
-		)
+	req.ID = strings.TrimSpace(req.ID)
+	parts, diags := identity.ImportID(ctx, req, resourceControlPolicyImportID...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	orgID := parts[0]
 
 	policy, diags := r.getPolicy(ctx, orgID)
 	resp.Diagnostics.Append(diags...)
@@ -14,10 +14,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients/iampolicy"
 	"github.com/hashicorp/terraform-provider-hcp/internal/customdiags"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
 )
 
 // projectIAMSchema is the schema for the project IAM resources
@@ -38,17 +40,27 @@ func projectIAMSchema(binding bool) schema.Schema {
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					hcpvalidator.UUID(),
+				},
 			},
 		},
 	}
 }
 
 func NewProjectIAMPolicyResource() resource.Resource {
-	return iampolicy.NewResourceIamPolicy("project", projectIAMSchema(false), "project_id", newProjectIAMPolicyUpdater)
+	return iampolicy.NewResourceIamPolicy("project", projectIAMSchema(false), "project_id", newProjectIAMPolicyUpdater,
+		iampolicy.WithImportAttrDefault(defaultProjectID))
 }
 
 func NewProjectIAMBindingResource() resource.Resource {
-	return iampolicy.NewResourceIamBinding("project", projectIAMSchema(true), "project_id", newProjectIAMPolicyUpdater)
+	return iampolicy.NewResourceIamBinding("project", projectIAMSchema(true), "project_id", newProjectIAMPolicyUpdater,
+		iampolicy.WithImportAttrDefault(defaultProjectID))
+}
+
+// defaultProjectID returns the project used when project_id is not set.
+func defaultProjectID(client *clients.Client) string {
+	return client.Config.ProjectID
 }
 
 type projectIAMPolicyUpdater struct {
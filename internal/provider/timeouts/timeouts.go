@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package timeouts provides the timeouts block of resources migrated from the
+// SDKv2 provider, so that configurations setting their timeouts keep working.
+//
+// As with the SDKv2 provider, the timeout of an operation is the duration set
+// for the operation, or the default duration when it is not set, or the
+// timeout of the resource when neither is set.
+package timeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	Create  = "create"
+	Read    = "read"
+	Update  = "update"
+	Delete  = "delete"
+	Default = "default"
+)
+
+// Block returns the timeouts block for the given operations, along with the
+// default duration which applies to the operations that are not set.
+func Block(operations ...string) schema.SingleNestedBlock {
+	attributes := make(map[string]schema.Attribute, len(operations)+1)
+	for _, operation := range operations {
+		attributes[operation] = durationAttribute(fmt.Sprintf("The timeout of the %s operation", operation))
+	}
+	attributes[Default] = durationAttribute("The timeout of the operations whose timeout is not set")
+
+	return schema.SingleNestedBlock{
+		Attributes: attributes,
+	}
+}
+
+func durationAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description + ", as a duration string such as `30s` or `2h45m`.",
+		Optional:    true,
+		Validators: []validator.String{
+			durationValidator{},
+		},
+	}
+}
+
+// Get returns the timeout of the given operation from the timeouts block, or
+// fallback when neither the operation nor the default duration is set.
+func Get(ctx context.Context, timeouts types.Object, operation string, fallback time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if timeouts.IsNull() || timeouts.IsUnknown() {
+		return fallback, diags
+	}
+
+	attributes := timeouts.Attributes()
+	for _, name := range []string{operation, Default} {
+		value, ok := attributes[name].(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		duration, err := time.ParseDuration(value.ValueString())
+		if err != nil {
+			diags.AddError("Invalid timeout", fmt.Sprintf("Unable to parse the %s timeout %q: %v", name, value.ValueString(), err))
+			return fallback, diags
+		}
+		return duration, diags
+	}
+
+	return fallback, diags
+}
+
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that a string attribute's value is a duration
+// string accepted by time.ParseDuration.
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "must be a duration string such as `30s` or `2h45m`"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration",
+			fmt.Sprintf("The value %q %s: %v", req.ConfigValue.ValueString(), v.Description(ctx), err))
+	}
+}
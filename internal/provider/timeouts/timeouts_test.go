@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/timeouts"
+)
+
+func TestGet(t *testing.T) {
+	attributeTypes := map[string]attr.Type{
+		timeouts.Create:  types.StringType,
+		timeouts.Delete:  types.StringType,
+		timeouts.Default: types.StringType,
+	}
+	block := func(create, def string) types.Object {
+		value := func(s string) attr.Value {
+			if s == "" {
+				return types.StringNull()
+			}
+			return types.StringValue(s)
+		}
+		return types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			timeouts.Create:  value(create),
+			timeouts.Delete:  types.StringNull(),
+			timeouts.Default: value(def),
+		})
+	}
+
+	cases := map[string]struct {
+		timeouts    types.Object
+		operation   string
+		expected    time.Duration
+		expectError bool
+	}{
+		"null block": {
+			timeouts:  types.ObjectNull(attributeTypes),
+			operation: timeouts.Create,
+			expected:  time.Minute,
+		},
+		"operation set": {
+			timeouts:  block("10m", "20m"),
+			operation: timeouts.Create,
+			expected:  10 * time.Minute,
+		},
+		"default set": {
+			timeouts:  block("10m", "20m"),
+			operation: timeouts.Delete,
+			expected:  20 * time.Minute,
+		},
+		"operation not in block": {
+			timeouts:  block("10m", "20m"),
+			operation: timeouts.Read,
+			expected:  20 * time.Minute,
+		},
+		"nothing set": {
+			timeouts:  block("", ""),
+			operation: timeouts.Delete,
+			expected:  time.Minute,
+		},
+		"invalid duration": {
+			timeouts:    block("ten minutes", ""),
+			operation:   timeouts.Create,
+			expected:    time.Minute,
+			expectError: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			timeout, diags := timeouts.Get(context.Background(), c.timeouts, c.operation, time.Minute)
+			assert.Equal(t, c.expectError, diags.HasError())
+			assert.Equal(t, c.expected, timeout)
+		})
+	}
+}
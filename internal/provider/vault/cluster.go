@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"fmt"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+)
+
+// clusterImportID is the import ID of the resources configuring a Vault
+// cluster: the ID of the cluster, optionally prefixed by the ID of its project.
+var clusterImportID = []hcpvalidator.ImportIDPart{
+	{Name: "project_id", Optional: true, Validators: []validator.String{hcpvalidator.UUID()}},
+	{Name: "cluster_id", Validators: []validator.String{hcpvalidator.Slug()}},
+}
+
+// clusterLocation returns the location of a Vault cluster, including its
+// region which is required to update the cluster.
+func clusterLocation(cluster *vaultmodels.HashicorpCloudVault20201125Cluster) *sharedmodels.HashicorpCloudLocationLocation {
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: cluster.Location.OrganizationID,
+		ProjectID:      cluster.Location.ProjectID,
+		Region:         &sharedmodels.HashicorpCloudLocationRegion{},
+	}
+	if cluster.Location.Region != nil {
+		loc.Region.Provider = cluster.Location.Region.Provider
+		loc.Region.Region = cluster.Location.Region.Region
+	}
+
+	return loc
+}
+
+const (
+	endpointTypePublic  = "PUBLIC"
+	endpointTypePrivate = "PRIVATE"
+	endpointTypeProxy   = "PROXY"
+)
+
+// clusterEndpoints returns the URLs of the endpoints of a Vault cluster, keyed
+// by endpoint type. Disabled endpoints are omitted.
+func clusterEndpoints(cluster *vaultmodels.HashicorpCloudVault20201125Cluster) map[string]string {
+	endpoints := map[string]string{}
+	if cluster.DNSNames == nil {
+		return endpoints
+	}
+
+	// Port 8200 required to communicate with HCP Vault via HTTPS
+	if cluster.DNSNames.Private != "" {
+		endpoints[endpointTypePrivate] = fmt.Sprintf("https://%s:8200", cluster.DNSNames.Private)
+	}
+	if cluster.Config != nil && cluster.Config.NetworkConfig != nil && cluster.Config.NetworkConfig.PublicIpsEnabled && cluster.DNSNames.Public != "" {
+		endpoints[endpointTypePublic] = fmt.Sprintf("https://%s:8200", cluster.DNSNames.Public)
+	}
+	if cluster.DNSNames.Proxy != "" {
+		endpoints[endpointTypeProxy] = fmt.Sprintf("https://%s", cluster.DNSNames.Proxy)
+	}
+
+	return endpoints
+}
+
+// recommendedEndpoint returns the type of the endpoint to connect to a Vault
+// cluster: the private endpoint from the HVN of the cluster or a network peered
+// with it, and otherwise the public endpoint, falling back to the proxy
+// endpoint. It returns an empty type if no endpoint is reachable.
+func recommendedEndpoint(endpoints map[string]string, fromHVN bool) string {
+	candidates := []string{endpointTypePublic, endpointTypeProxy}
+	if fromHVN {
+		candidates = []string{endpointTypePrivate}
+	}
+
+	for _, endpointType := range candidates {
+		if _, ok := endpoints[endpointType]; ok {
+			return endpointType
+		}
+	}
+
+	return ""
+}
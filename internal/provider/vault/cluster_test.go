@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"testing"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterEndpoints(t *testing.T) {
+	cluster := func(public bool, proxy string) *vaultmodels.HashicorpCloudVault20201125Cluster {
+		return &vaultmodels.HashicorpCloudVault20201125Cluster{
+			Config: &vaultmodels.HashicorpCloudVault20201125ClusterConfig{
+				NetworkConfig: &vaultmodels.HashicorpCloudVault20201125NetworkConfig{PublicIpsEnabled: public},
+			},
+			DNSNames: &vaultmodels.HashicorpCloudVault20201125ClusterDNSNames{
+				Private: "vault.private.hashicorp.cloud",
+				Public:  "vault.public.hashicorp.cloud",
+				Proxy:   proxy,
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		cluster           *vaultmodels.HashicorpCloudVault20201125Cluster
+		fromHVN           bool
+		expectedEndpoints map[string]string
+		expectedType      string
+	}{
+		"public": {
+			cluster: cluster(true, ""),
+			expectedEndpoints: map[string]string{
+				endpointTypePublic:  "https://vault.public.hashicorp.cloud:8200",
+				endpointTypePrivate: "https://vault.private.hashicorp.cloud:8200",
+			},
+			expectedType: endpointTypePublic,
+		},
+		"public from HVN": {
+			cluster: cluster(true, ""),
+			fromHVN: true,
+			expectedEndpoints: map[string]string{
+				endpointTypePublic:  "https://vault.public.hashicorp.cloud:8200",
+				endpointTypePrivate: "https://vault.private.hashicorp.cloud:8200",
+			},
+			expectedType: endpointTypePrivate,
+		},
+		"proxy": {
+			cluster: cluster(false, "vault.proxy.hashicorp.cloud"),
+			expectedEndpoints: map[string]string{
+				endpointTypePrivate: "https://vault.private.hashicorp.cloud:8200",
+				endpointTypeProxy:   "https://vault.proxy.hashicorp.cloud",
+			},
+			expectedType: endpointTypeProxy,
+		},
+		"private only": {
+			cluster: cluster(false, ""),
+			expectedEndpoints: map[string]string{
+				endpointTypePrivate: "https://vault.private.hashicorp.cloud:8200",
+			},
+			expectedType: "",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			endpoints := clusterEndpoints(c.cluster)
+			assert.Equal(t, c.expectedEndpoints, endpoints)
+			assert.Equal(t, c.expectedType, recommendedEndpoint(endpoints, c.fromHVN))
+		})
+	}
+}
+
+func TestClusterLocation(t *testing.T) {
+	cases := map[string]struct {
+		region   *vaultmodels.HashicorpCloudInternalLocationRegion
+		expected *sharedmodels.HashicorpCloudLocationRegion
+	}{
+		"with region": {
+			region:   &vaultmodels.HashicorpCloudInternalLocationRegion{Provider: "aws", Region: "us-west-2"},
+			expected: &sharedmodels.HashicorpCloudLocationRegion{Provider: "aws", Region: "us-west-2"},
+		},
+		"without region": {
+			expected: &sharedmodels.HashicorpCloudLocationRegion{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			loc := clusterLocation(&vaultmodels.HashicorpCloudVault20201125Cluster{
+				Location: &vaultmodels.HashicorpCloudInternalLocationLocation{
+					OrganizationID: "org",
+					ProjectID:      "proj",
+					Region:         tc.region,
+				},
+			})
+
+			assert.Equal(t, &sharedmodels.HashicorpCloudLocationLocation{
+				OrganizationID: "org",
+				ProjectID:      "proj",
+				Region:         tc.expected,
+			}, loc)
+		})
+	}
+}
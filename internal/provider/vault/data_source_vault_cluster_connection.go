@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+)
+
+type DataSourceClusterConnection struct {
+	client *clients.Client
+}
+
+type DataSourceClusterConnectionModel struct {
+	ProjectID      types.String `tfsdk:"project_id"`
+	ClusterID      types.String `tfsdk:"cluster_id"`
+	FromHVN        types.Bool   `tfsdk:"from_hvn"`
+	Address        types.String `tfsdk:"address"`
+	EndpointType   types.String `tfsdk:"endpoint_type"`
+	Namespace      types.String `tfsdk:"namespace"`
+	TLSServerName  types.String `tfsdk:"tls_server_name"`
+	PublicAddress  types.String `tfsdk:"public_address"`
+	PrivateAddress types.String `tfsdk:"private_address"`
+	ProxyAddress   types.String `tfsdk:"proxy_address"`
+}
+
+func NewClusterConnectionDataSource() datasource.DataSource {
+	return &DataSourceClusterConnection{}
+}
+
+func (d *DataSourceClusterConnection) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_cluster_connection"
+}
+
+func (d *DataSourceClusterConnection) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Vault cluster connection data source returns the settings to connect to an HCP Vault cluster, " +
+			"such as the settings of the `vault` provider, using the endpoint reachable from where Terraform runs.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the HCP Vault cluster is located. If not specified, the project configured in the HCP provider config block is used.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					hcpvalidator.UUID(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the HCP Vault cluster.",
+				Required:    true,
+				Validators: []validator.String{
+					hcpvalidator.Slug(),
+				},
+			},
+			"from_hvn": schema.BoolAttribute{
+				Description: "Whether the cluster is accessed from its HVN or from a network connected to it, in which case the private endpoint is recommended. " +
+					"Otherwise, the public endpoint is recommended, falling back to the proxy endpoint. Defaults to `false`.",
+				Optional: true,
+			},
+			"address": schema.StringAttribute{
+				Description: "The URL of the recommended endpoint of the cluster.",
+				Computed:    true,
+			},
+			"endpoint_type": schema.StringAttribute{
+				Description: "The type of the recommended endpoint, one of `PUBLIC`, `PRIVATE` or `PROXY`.",
+				Computed:    true,
+			},
+			"namespace": schema.StringAttribute{
+				Description: "The Vault namespace of the cluster, which all requests to HCP Vault must be made in or under.",
+				Computed:    true,
+			},
+			"tls_server_name": schema.StringAttribute{
+				Description: "The host name of the recommended endpoint, to verify its TLS certificate when connecting through a load balancer or a tunnel. " +
+					"The endpoints of HCP Vault serve certificates signed by a publicly trusted certificate authority, so no CA bundle is required.",
+				Computed: true,
+			},
+			"public_address": schema.StringAttribute{
+				Description: "The URL of the public endpoint of the cluster. Null if the public endpoint is disabled.",
+				Computed:    true,
+			},
+			"private_address": schema.StringAttribute{
+				Description: "The URL of the private endpoint of the cluster.",
+				Computed:    true,
+			},
+			"proxy_address": schema.StringAttribute{
+				Description: "The URL of the proxy endpoint of the cluster. Null if the proxy endpoint is disabled.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *DataSourceClusterConnection) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *DataSourceClusterConnection) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceClusterConnectionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ProjectID.IsNull() {
+		data.ProjectID = types.StringValue(d.client.Config.ProjectID)
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: d.client.Config.OrganizationID,
+		ProjectID:      data.ProjectID.ValueString(),
+	}
+
+	clusterID := data.ClusterID.ValueString()
+	cluster, err := clients.GetVaultClusterByID(ctx, d.client, loc, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Vault cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	endpoints := clusterEndpoints(cluster)
+	endpointType := recommendedEndpoint(endpoints, data.FromHVN.ValueBool())
+	if endpointType == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("from_hvn"), "Vault cluster not reachable",
+			fmt.Sprintf("The public and proxy endpoints of the Vault cluster (%s) are disabled, so it can only be reached from its HVN; "+
+				"enable one of them on the cluster, or set from_hvn to true if the cluster is accessed from its HVN.", clusterID))
+		return
+	}
+
+	address := endpoints[endpointType]
+	u, err := url.Parse(address)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to parse the address of Vault cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	data.Address = types.StringValue(address)
+	data.EndpointType = types.StringValue(endpointType)
+	data.TLSServerName = types.StringValue(u.Hostname())
+	data.PublicAddress = endpointValue(endpoints, endpointTypePublic)
+	data.PrivateAddress = endpointValue(endpoints, endpointTypePrivate)
+	data.ProxyAddress = endpointValue(endpoints, endpointTypeProxy)
+	data.Namespace = types.StringNull()
+	if cluster.Config != nil && cluster.Config.VaultConfig != nil {
+		data.Namespace = types.StringValue(cluster.Config.VaultConfig.Namespace)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func endpointValue(endpoints map[string]string, endpointType string) types.String {
+	if address, ok := endpoints[endpointType]; ok {
+		return types.StringValue(address)
+	}
+	return types.StringNull()
+}
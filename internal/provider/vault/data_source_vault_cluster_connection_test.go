@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+)
+
+func TestAccVaultClusterConnectionDataSource(t *testing.T) {
+	name := "vault-connection-" + acctest.RandString(8)
+	dataSourceName := "data.hcp_vault_cluster_connection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The dev cluster only has a private endpoint.
+				Config: testAccVaultClusterConfig(name) + `
+data "hcp_vault_cluster_connection" "test" {
+  cluster_id = hcp_vault_cluster.test.cluster_id
+}
+`,
+				ExpectError: regexp.MustCompile(`Vault cluster not reachable`),
+			},
+			{
+				Config: testAccVaultClusterConfig(name) + `
+data "hcp_vault_cluster_connection" "test" {
+  cluster_id = hcp_vault_cluster.test.cluster_id
+  from_hvn   = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "endpoint_type", "PRIVATE"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "address", "hcp_vault_cluster.test", "vault_private_endpoint_url"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "private_address", "hcp_vault_cluster.test", "vault_private_endpoint_url"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "namespace", "hcp_vault_cluster.test", "namespace"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "tls_server_name"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "public_address"),
+				),
+			},
+		},
+	})
+}
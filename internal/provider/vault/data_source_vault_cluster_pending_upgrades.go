@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+)
+
+type DataSourceClusterPendingUpgrades struct {
+	client *clients.Client
+}
+
+type DataSourceClusterPendingUpgradesModel struct {
+	ProjectID    types.String     `tfsdk:"project_id"`
+	ClusterID    types.String     `tfsdk:"cluster_id"`
+	VaultVersion types.String     `tfsdk:"vault_version"`
+	Upgrades     []pendingUpgrade `tfsdk:"upgrades"`
+}
+
+func NewClusterPendingUpgradesDataSource() datasource.DataSource {
+	return &DataSourceClusterPendingUpgrades{}
+}
+
+func (d *DataSourceClusterPendingUpgrades) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_cluster_pending_upgrades"
+}
+
+func (d *DataSourceClusterPendingUpgrades) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Vault cluster pending upgrades data source lists the major and minor version upgrades of an HCP Vault cluster " +
+			"that are available or have not completed yet, along with the upgrade configuration that applies to them.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the HCP Vault cluster is located. If not specified, the project configured in the HCP provider config block is used.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					hcpvalidator.UUID(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the HCP Vault cluster.",
+				Required:    true,
+				Validators: []validator.String{
+					hcpvalidator.Slug(),
+				},
+			},
+			"vault_version": schema.StringAttribute{
+				Description: "The current Vault version of the cluster.",
+				Computed:    true,
+			},
+			"upgrades": schema.ListNestedAttribute{
+				Description: "The pending upgrades of the cluster.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The type of the upgrade, either `MAJOR` or `MINOR`. Minor upgrades include patch upgrades.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the upgrade, one of `AVAILABLE`, `IN_PROGRESS`, `FAILED` or `ACTION_REQUIRED`. " +
+								"`ACTION_REQUIRED` means the upgrade must be applied manually before HCP applies it automatically.",
+							Computed: true,
+						},
+						"upgrade_type": schema.StringAttribute{
+							Description: "The upgrade type configured on the cluster for this type of upgrade, one of `AUTOMATIC`, `SCHEDULED` or `MANUAL`.",
+							Computed:    true,
+						},
+						"maintenance_window_day": schema.StringAttribute{
+							Description: "The maintenance day of the week the upgrade is applied on, for scheduled upgrades.",
+							Computed:    true,
+						},
+						"maintenance_window_time": schema.StringAttribute{
+							Description: "The maintenance time frame the upgrade is applied in, for scheduled upgrades.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataSourceClusterPendingUpgrades) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *DataSourceClusterPendingUpgrades) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceClusterPendingUpgradesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ProjectID.IsNull() {
+		data.ProjectID = types.StringValue(d.client.Config.ProjectID)
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: d.client.Config.OrganizationID,
+		ProjectID:      data.ProjectID.ValueString(),
+	}
+
+	clusterID := data.ClusterID.ValueString()
+	cluster, err := clients.GetVaultClusterByID(ctx, d.client, loc, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Vault cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	data.VaultVersion = types.StringValue(cluster.CurrentVersion)
+	data.Upgrades = flattenPendingUpgrades(cluster)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+)
+
+func TestAccVaultClusterPendingUpgradesDataSource(t *testing.T) {
+	name := "vault-upgrades-" + acctest.RandString(8)
+	dataSourceName := "data.hcp_vault_cluster_pending_upgrades.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultClusterConfig(name) + `
+data "hcp_vault_cluster_pending_upgrades" "test" {
+  cluster_id = hcp_vault_cluster.test.cluster_id
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "project_id", "hcp_vault_cluster.test", "project_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vault_version", "hcp_vault_cluster.test", "vault_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "upgrades.#"),
+				),
+			},
+		},
+	})
+}
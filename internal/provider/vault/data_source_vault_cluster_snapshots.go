@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+)
+
+type DataSourceClusterSnapshots struct {
+	client *clients.Client
+}
+
+type DataSourceClusterSnapshotsModel struct {
+	ProjectID types.String      `tfsdk:"project_id"`
+	ClusterID types.String      `tfsdk:"cluster_id"`
+	Snapshots []clusterSnapshot `tfsdk:"snapshots"`
+}
+
+type clusterSnapshot struct {
+	SnapshotID   types.String `tfsdk:"snapshot_id"`
+	SnapshotName types.String `tfsdk:"snapshot_name"`
+	Type         types.String `tfsdk:"type"`
+	State        types.String `tfsdk:"state"`
+	Size         types.Int64  `tfsdk:"size"`
+	VaultVersion types.String `tfsdk:"vault_version"`
+	Locked       types.Bool   `tfsdk:"locked"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	FinishedAt   types.String `tfsdk:"finished_at"`
+}
+
+func NewClusterSnapshotsDataSource() datasource.DataSource {
+	return &DataSourceClusterSnapshots{}
+}
+
+func (d *DataSourceClusterSnapshots) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_cluster_snapshots"
+}
+
+func (d *DataSourceClusterSnapshots) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Vault cluster snapshots data source lists the snapshots of an HCP Vault cluster, " +
+			"including the snapshots taken automatically by HCP.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the HCP Vault cluster is located. If not specified, the project configured in the HCP provider config block is used.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					hcpvalidator.UUID(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the HCP Vault cluster.",
+				Required:    true,
+				Validators: []validator.String{
+					hcpvalidator.Slug(),
+				},
+			},
+			"snapshots": schema.ListNestedAttribute{
+				Description: "The snapshots of the cluster, from oldest to latest.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"snapshot_id": schema.StringAttribute{
+							Description: "The ID of the snapshot.",
+							Computed:    true,
+						},
+						"snapshot_name": schema.StringAttribute{
+							Description: "The name of the snapshot.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the snapshot, such as `MANUAL` or `AUTOMATIC`.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The state of the snapshot.",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "The size of the snapshot in bytes.",
+							Computed:    true,
+						},
+						"vault_version": schema.StringAttribute{
+							Description: "The version of Vault at the time of the snapshot creation.",
+							Computed:    true,
+						},
+						"locked": schema.BoolAttribute{
+							Description: "Whether the snapshot is locked, which prevents it from being deleted.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The time the snapshot was requested, in RFC3339 format.",
+							Computed:    true,
+						},
+						"finished_at": schema.StringAttribute{
+							Description: "The time the snapshot was stored, in RFC3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataSourceClusterSnapshots) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *DataSourceClusterSnapshots) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceClusterSnapshotsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ProjectID.IsNull() {
+		data.ProjectID = types.StringValue(d.client.Config.ProjectID)
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: d.client.Config.OrganizationID,
+		ProjectID:      data.ProjectID.ValueString(),
+	}
+
+	clusterID := data.ClusterID.ValueString()
+	snapshots, err := clients.ListVaultSnapshots(ctx, d.client, loc, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to list snapshots of Vault cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return time.Time(snapshots[i].RequestedAt).Before(time.Time(snapshots[j].RequestedAt))
+	})
+
+	data.Snapshots = make([]clusterSnapshot, 0, len(snapshots))
+	for _, s := range snapshots {
+		snapshot := clusterSnapshot{
+			SnapshotID:   types.StringValue(s.SnapshotID),
+			SnapshotName: types.StringValue(s.Name),
+			Type:         types.StringNull(),
+			State:        types.StringNull(),
+			Size:         snapshotSize(s.Bytes),
+			VaultVersion: types.StringValue(s.VaultVersion),
+			Locked:       types.BoolValue(s.IsLocked),
+			CreatedAt:    snapshotTime(s.RequestedAt),
+			FinishedAt:   snapshotTime(s.FinishedAt),
+		}
+		if s.Type != nil {
+			snapshot.Type = types.StringValue(string(*s.Type))
+		}
+		if s.State != nil {
+			snapshot.State = types.StringValue(string(*s.State))
+		}
+		data.Snapshots = append(data.Snapshots, snapshot)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+)
+
+func TestAccVaultClusterSnapshotsDataSource(t *testing.T) {
+	name := "vault-snapshots-" + acctest.RandString(8)
+	dataSourceName := "data.hcp_vault_cluster_snapshots.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultClusterConfig(name) + `
+resource "hcp_vault_cluster_snapshot" "test" {
+  cluster_id    = hcp_vault_cluster.test.cluster_id
+  snapshot_name = "test-snapshot"
+}
+
+data "hcp_vault_cluster_snapshots" "test" {
+  cluster_id = hcp_vault_cluster.test.cluster_id
+
+  depends_on = [hcp_vault_cluster_snapshot.test]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "project_id", "hcp_vault_cluster.test", "project_id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "snapshots.*", map[string]string{
+						"snapshot_name": "test-snapshot",
+						"type":          "MANUAL",
+						"state":         "STORED",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,850 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
+)
+
+// redacted is the value returned by the Vault service in place of sensitive
+// values, such as passwords and tokens.
+const redacted = "redacted"
+
+// observabilityProviders are the attributes of the observability providers
+// that Vault clusters can stream their metrics and audit logs to.
+var observabilityProviders = []string{"grafana", "splunk", "datadog", "cloudwatch", "elasticsearch", "http", "newrelic"}
+
+// observabilityStreaming describes the kind of data streamed by an
+// observability streaming resource, either metrics or audit logs.
+type observabilityStreaming struct {
+	// typeName is the type name of the resource, without the provider prefix.
+	typeName string
+	// description is the description of the resource.
+	description string
+	// data is the name of the streamed data used in the attribute
+	// descriptions, such as "metrics".
+	data string
+	// cloudwatchAttributes are the attributes of the cloudwatch provider that
+	// are specific to the streamed data.
+	cloudwatchAttributes map[string]schema.Attribute
+	// newModel returns an empty model of the resource.
+	newModel func() observabilityStreamingModel
+	// config returns the observability configuration of the cluster for the
+	// streamed data.
+	config func(cluster *vaultmodels.HashicorpCloudVault20201125ClusterConfig) *vaultmodels.HashicorpCloudVault20201125ObservabilityConfig
+	// update updates the observability configuration of the cluster for the
+	// streamed data.
+	update func(ctx context.Context, client *clients.Client, loc *sharedmodels.HashicorpCloudLocationLocation, clusterID string,
+		config *vaultmodels.HashicorpCloudVault20201125ObservabilityConfig) (*vaultmodels.HashicorpCloudVault20201125UpdateResponse, error)
+}
+
+// observabilityStreamingModel is the model of an observability streaming
+// resource, whose cloudwatch provider depends on the streamed data.
+type observabilityStreamingModel interface {
+	streaming() *observabilityStreamingBase
+	expandCloudWatch() *vaultmodels.HashicorpCloudVault20201125CloudWatch
+	flattenCloudWatch(cloudwatch *vaultmodels.HashicorpCloudVault20201125CloudWatch)
+}
+
+// observabilityStreamingBase holds the attributes shared by the metrics and
+// audit log streaming resources.
+type observabilityStreamingBase struct {
+	ClusterID     types.String        `tfsdk:"cluster_id"`
+	ProjectID     types.String        `tfsdk:"project_id"`
+	Grafana       *grafanaModel       `tfsdk:"grafana"`
+	Splunk        *splunkModel        `tfsdk:"splunk"`
+	Datadog       *datadogModel       `tfsdk:"datadog"`
+	Elasticsearch *elasticsearchModel `tfsdk:"elasticsearch"`
+	HTTP          *httpModel          `tfsdk:"http"`
+	NewRelic      *newRelicModel      `tfsdk:"newrelic"`
+}
+
+func (m *observabilityStreamingBase) streaming() *observabilityStreamingBase {
+	return m
+}
+
+type grafanaModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	User     types.String `tfsdk:"user"`
+	Password types.String `tfsdk:"password"`
+}
+
+type splunkModel struct {
+	HecEndpoint types.String `tfsdk:"hec_endpoint"`
+	Token       types.String `tfsdk:"token"`
+}
+
+type datadogModel struct {
+	APIKey types.String `tfsdk:"api_key"`
+	Region types.String `tfsdk:"region"`
+}
+
+// cloudwatchModel holds the attributes of the cloudwatch provider shared by
+// metrics and audit logs.
+type cloudwatchModel struct {
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	Region          types.String `tfsdk:"region"`
+}
+
+// expand returns the cloudwatch provider, whose other fields are set by the
+// Vault service.
+func (m cloudwatchModel) expand() *vaultmodels.HashicorpCloudVault20201125CloudWatch {
+	return &vaultmodels.HashicorpCloudVault20201125CloudWatch{
+		AccessKeyID:     m.AccessKeyID.ValueString(),
+		SecretAccessKey: m.SecretAccessKey.ValueString(),
+		Region:          m.Region.ValueString(),
+	}
+}
+
+// flatten returns the shared attributes of the cloudwatch provider, keeping
+// the redacted secret access key of m.
+func (m cloudwatchModel) flatten(cloudwatch *vaultmodels.HashicorpCloudVault20201125CloudWatch) cloudwatchModel {
+	return cloudwatchModel{
+		AccessKeyID:     types.StringValue(cloudwatch.AccessKeyID),
+		SecretAccessKey: sensitiveValue(m.SecretAccessKey, cloudwatch.SecretAccessKey),
+		Region:          types.StringValue(cloudwatch.Region),
+	}
+}
+
+type elasticsearchModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	User     types.String `tfsdk:"user"`
+	Password types.String `tfsdk:"password"`
+	Dataset  types.String `tfsdk:"dataset"`
+}
+
+type httpModel struct {
+	URI           types.String `tfsdk:"uri"`
+	Method        types.String `tfsdk:"method"`
+	Codec         types.String `tfsdk:"codec"`
+	Compression   types.Bool   `tfsdk:"compression"`
+	Headers       types.Map    `tfsdk:"headers"`
+	PayloadPrefix types.String `tfsdk:"payload_prefix"`
+	PayloadSuffix types.String `tfsdk:"payload_suffix"`
+	BasicUser     types.String `tfsdk:"basic_user"`
+	BasicPassword types.String `tfsdk:"basic_password"`
+	BearerToken   types.String `tfsdk:"bearer_token"`
+}
+
+type newRelicModel struct {
+	AccountID  types.String `tfsdk:"account_id"`
+	LicenseKey types.String `tfsdk:"license_key"`
+	Region     types.String `tfsdk:"region"`
+}
+
+var _ resource.Resource = &resourceObservabilityStreaming{}
+var _ resource.ResourceWithConfigure = &resourceObservabilityStreaming{}
+var _ resource.ResourceWithConfigValidators = &resourceObservabilityStreaming{}
+var _ resource.ResourceWithImportState = &resourceObservabilityStreaming{}
+var _ resource.ResourceWithIdentity = &resourceObservabilityStreaming{}
+
+// resourceObservabilityStreaming implements the resources streaming the
+// metrics and the audit logs of a Vault cluster to observability providers.
+type resourceObservabilityStreaming struct {
+	client *clients.Client
+	kind   observabilityStreaming
+}
+
+func (r *resourceObservabilityStreaming) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.kind.typeName
+}
+
+func (r *resourceObservabilityStreaming) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	data := r.kind.data
+
+	cloudwatchAttributes := map[string]schema.Attribute{
+		"access_key_id": schema.StringAttribute{
+			Description: fmt.Sprintf("CloudWatch access key ID for streaming %s.", data),
+			Required:    true,
+		},
+		"secret_access_key": schema.StringAttribute{
+			Description: fmt.Sprintf("CloudWatch secret access key for streaming %s.", data),
+			Required:    true,
+			Sensitive:   true,
+		},
+		"region": schema.StringAttribute{
+			Description: fmt.Sprintf("CloudWatch region for streaming %s.", data),
+			Required:    true,
+		},
+	}
+	maps.Copy(cloudwatchAttributes, r.kind.cloudwatchAttributes)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: r.kind.description,
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the HCP Vault cluster.",
+				Required:    true,
+				Validators: []validator.String{
+					hcpvalidator.Slug(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the HCP Vault cluster is located. If not specified, the project configured in the HCP provider config block is used.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					hcpvalidator.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"grafana": schema.SingleNestedAttribute{
+				Description: fmt.Sprintf("Grafana configuration for streaming %s.", data),
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"endpoint": schema.StringAttribute{
+						Description: fmt.Sprintf("Grafana endpoint for streaming %s.", data),
+						Required:    true,
+					},
+					"user": schema.StringAttribute{
+						Description: fmt.Sprintf("Grafana user for streaming %s.", data),
+						Required:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: fmt.Sprintf("Grafana password for streaming %s.", data),
+						Required:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"splunk": schema.SingleNestedAttribute{
+				Description: fmt.Sprintf("Splunk configuration for streaming %s.", data),
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"hec_endpoint": schema.StringAttribute{
+						Description: fmt.Sprintf("Splunk HTTP Event Collector endpoint for streaming %s.", data),
+						Required:    true,
+					},
+					"token": schema.StringAttribute{
+						Description: fmt.Sprintf("Splunk token for streaming %s.", data),
+						Required:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"datadog": schema.SingleNestedAttribute{
+				Description: fmt.Sprintf("Datadog configuration for streaming %s.", data),
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"api_key": schema.StringAttribute{
+						Description: fmt.Sprintf("Datadog API key for streaming %s.", data),
+						Required:    true,
+						Sensitive:   true,
+					},
+					"region": schema.StringAttribute{
+						Description: fmt.Sprintf("Datadog region for streaming %s.", data),
+						Required:    true,
+					},
+				},
+			},
+			"cloudwatch": schema.SingleNestedAttribute{
+				Description: fmt.Sprintf("CloudWatch configuration for streaming %s.", data),
+				Optional:    true,
+				Attributes:  cloudwatchAttributes,
+			},
+			"elasticsearch": schema.SingleNestedAttribute{
+				Description: fmt.Sprintf("Elasticsearch configuration for streaming %s.", data),
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"endpoint": schema.StringAttribute{
+						Description: fmt.Sprintf("Elasticsearch endpoint for streaming %s.", data),
+						Required:    true,
+					},
+					"user": schema.StringAttribute{
+						Description: fmt.Sprintf("Elasticsearch user for streaming %s.", data),
+						Required:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: fmt.Sprintf("Elasticsearch password for streaming %s.", data),
+						Required:    true,
+						Sensitive:   true,
+					},
+					"dataset": schema.StringAttribute{
+						Description: fmt.Sprintf("Elasticsearch dataset the %s are streamed to.", data),
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"http": schema.SingleNestedAttribute{
+				Description: fmt.Sprintf("HTTP configuration for streaming %s to any HTTP endpoint.", data),
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"uri": schema.StringAttribute{
+						Description: fmt.Sprintf("HTTP URI for streaming %s.", data),
+						Required:    true,
+					},
+					"method": schema.StringAttribute{
+						Description: fmt.Sprintf("HTTP payload method for streaming %s, allowed values are `PATCH`, `POST` and `PUT`.", data),
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("PATCH", "POST", "PUT"),
+						},
+					},
+					"codec": schema.StringAttribute{
+						Description: fmt.Sprintf("HTTP codec for streaming %s, allowed values are `JSON` and `NDJSON`.", data),
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(vaultmodels.HashicorpCloudVault20201125HTTPEncodingCodecJSON),
+								string(vaultmodels.HashicorpCloudVault20201125HTTPEncodingCodecNDJSON),
+							),
+						},
+					},
+					"compression": schema.BoolAttribute{
+						Description: fmt.Sprintf("Whether to compress the HTTP payloads when streaming %s. Defaults to `false`.", data),
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"headers": schema.MapAttribute{
+						Description: fmt.Sprintf("HTTP headers for streaming %s.", data),
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"payload_prefix": schema.StringAttribute{
+						Description: fmt.Sprintf("HTTP payload prefix for streaming %s.", data),
+						Optional:    true,
+					},
+					"payload_suffix": schema.StringAttribute{
+						Description: fmt.Sprintf("HTTP payload suffix for streaming %s.", data),
+						Optional:    true,
+					},
+					"basic_user": schema.StringAttribute{
+						Description: fmt.Sprintf("HTTP basic authentication username for streaming %s. Requires `basic_password`, and conflicts with `bearer_token`.", data),
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("basic_password")),
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("bearer_token")),
+						},
+					},
+					"basic_password": schema.StringAttribute{
+						Description: fmt.Sprintf("HTTP basic authentication password for streaming %s. Requires `basic_user`.", data),
+						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("basic_user")),
+						},
+					},
+					"bearer_token": schema.StringAttribute{
+						Description: fmt.Sprintf("HTTP bearer authentication token for streaming %s. Conflicts with `basic_user` and `basic_password`.", data),
+						Optional:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"newrelic": schema.SingleNestedAttribute{
+				Description: fmt.Sprintf("New Relic configuration for streaming %s.", data),
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"account_id": schema.StringAttribute{
+						Description: fmt.Sprintf("New Relic account ID for streaming %s.", data),
+						Required:    true,
+					},
+					"license_key": schema.StringAttribute{
+						Description: fmt.Sprintf("New Relic license key for streaming %s.", data),
+						Required:    true,
+						Sensitive:   true,
+					},
+					"region": schema.StringAttribute{
+						Description: fmt.Sprintf("New Relic region for streaming %s, allowed values are `US` and `EU`.", data),
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(vaultmodels.HashicorpCloudVault20201125NewRelicRegionUS),
+								string(vaultmodels.HashicorpCloudVault20201125NewRelicRegionEU),
+							),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceObservabilityStreaming) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(clusterImportID...)
+}
+
+func (r *resourceObservabilityStreaming) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	expressions := make([]path.Expression, 0, len(observabilityProviders))
+	for _, provider := range observabilityProviders {
+		expressions = append(expressions, path.MatchRoot(provider))
+	}
+
+	// The Vault service streams to a single provider at a time.
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(expressions...),
+	}
+}
+
+func (r *resourceObservabilityStreaming) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *resourceObservabilityStreaming) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := r.kind.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	base := plan.streaming()
+	if base.ProjectID.IsUnknown() {
+		base.ProjectID = types.StringValue(r.client.Config.ProjectID)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Configuring %s streaming of Vault cluster", r.kind.data), map[string]any{"cluster_id": base.ClusterID.ValueString()})
+
+	cluster, err := r.getCluster(ctx, base)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Vault cluster (%s)", base.ClusterID.ValueString()), err.Error())
+		return
+	}
+
+	cluster, diags := r.updateConfig(ctx, cluster, expandObservabilityConfig(plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flattenObservabilityConfig(plan, r.kind.config(cluster.Config))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *resourceObservabilityStreaming) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
+	state := r.kind.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	base := state.streaming()
+	cluster, err := r.getCluster(ctx, base)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			tflog.Warn(ctx, "Vault cluster not found, removing from state", map[string]any{"cluster_id": base.ClusterID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Vault cluster (%s)", base.ClusterID.ValueString()), err.Error())
+		return
+	}
+
+	config := r.kind.config(cluster.Config)
+	if !observabilityConfigured(config) {
+		tflog.Warn(ctx, fmt.Sprintf("Vault cluster has no %s streaming configured, removing from state", r.kind.data), map[string]any{"cluster_id": base.ClusterID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	flattenObservabilityConfig(state, config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceObservabilityStreaming) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := r.kind.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	base := plan.streaming()
+	tflog.Info(ctx, fmt.Sprintf("Updating %s streaming of Vault cluster", r.kind.data), map[string]any{"cluster_id": base.ClusterID.ValueString()})
+
+	cluster, err := r.getCluster(ctx, base)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Vault cluster (%s)", base.ClusterID.ValueString()), err.Error())
+		return
+	}
+
+	cluster, diags := r.updateConfig(ctx, cluster, expandObservabilityConfig(plan))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flattenObservabilityConfig(plan, r.kind.config(cluster.Config))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceObservabilityStreaming) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := r.kind.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	base := state.streaming()
+	tflog.Info(ctx, fmt.Sprintf("Removing %s streaming of Vault cluster", r.kind.data), map[string]any{"cluster_id": base.ClusterID.ValueString()})
+
+	cluster, err := r.getCluster(ctx, base)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			tflog.Warn(ctx, "Vault cluster not found, so no action was taken", map[string]any{"cluster_id": base.ClusterID.ValueString()})
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Vault cluster (%s)", base.ClusterID.ValueString()), err.Error())
+		return
+	}
+
+	// The configuration of every provider is emptied to stop streaming.
+	_, diags := r.updateConfig(ctx, cluster, &vaultmodels.HashicorpCloudVault20201125ObservabilityConfig{
+		Grafana:       &vaultmodels.HashicorpCloudVault20201125Grafana{},
+		Splunk:        &vaultmodels.HashicorpCloudVault20201125Splunk{},
+		Datadog:       &vaultmodels.HashicorpCloudVault20201125Datadog{},
+		Cloudwatch:    &vaultmodels.HashicorpCloudVault20201125CloudWatch{},
+		Elasticsearch: &vaultmodels.HashicorpCloudVault20201125Elasticsearch{},
+		Newrelic:      &vaultmodels.HashicorpCloudVault20201125NewRelic{},
+		HTTP:          &vaultmodels.HashicorpCloudVault20201125HTTP{},
+	})
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState imports the streaming configuration of a Vault cluster from an
+// import ID in the format [{project_id}:]{cluster_id}, or from its identity.
+// Sensitive values are never returned by the Vault service, so they must be
+// set in the configuration after the import.
+func (r *resourceObservabilityStreaming) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, diags := identity.ImportID(ctx, req, clusterImportID...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, clusterID := parts[0], parts[1]
+	if projectID == "" {
+		projectID = r.client.Config.ProjectID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), clusterID)...)
+}
+
+func (r *resourceObservabilityStreaming) location(base *observabilityStreamingBase) *sharedmodels.HashicorpCloudLocationLocation {
+	return &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: r.client.Config.OrganizationID,
+		ProjectID:      base.ProjectID.ValueString(),
+	}
+}
+
+// getCluster returns the Vault cluster of the resource.
+func (r *resourceObservabilityStreaming) getCluster(ctx context.Context, base *observabilityStreamingBase) (*vaultmodels.HashicorpCloudVault20201125Cluster, error) {
+	return clients.GetVaultClusterByID(ctx, r.client, r.location(base), base.ClusterID.ValueString())
+}
+
+// updateConfig updates the observability configuration of the cluster, waits
+// for the update to complete and returns the updated cluster.
+func (r *resourceObservabilityStreaming) updateConfig(ctx context.Context, cluster *vaultmodels.HashicorpCloudVault20201125Cluster,
+	config *vaultmodels.HashicorpCloudVault20201125ObservabilityConfig) (*vaultmodels.HashicorpCloudVault20201125Cluster, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	loc := clusterLocation(cluster)
+
+	updateResp, err := r.kind.update(ctx, r.client, loc, cluster.ID, config)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error updating %s streaming of Vault cluster (%s)", r.kind.data, cluster.ID), err.Error())
+		return nil, diags
+	}
+
+	operation := fmt.Sprintf("update Vault cluster %s streaming", r.kind.data)
+	if err := clients.WaitForOperation(ctx, r.client, operation, loc, updateResp.Operation.ID); err != nil {
+		diags.AddError(fmt.Sprintf("Unable to update %s streaming of Vault cluster (%s)", r.kind.data, cluster.ID), err.Error())
+		return nil, diags
+	}
+
+	updated, err := clients.GetVaultClusterByID(ctx, r.client, loc, cluster.ID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to retrieve Vault cluster (%s)", cluster.ID), err.Error())
+		return nil, diags
+	}
+
+	return updated, diags
+}
+
+// observabilityConfigured reports whether at least one observability provider
+// is configured.
+func observabilityConfigured(config *vaultmodels.HashicorpCloudVault20201125ObservabilityConfig) bool {
+	if config == nil {
+		return false
+	}
+
+	return (config.Grafana != nil && config.Grafana.Endpoint != "") ||
+		(config.Splunk != nil && config.Splunk.HecEndpoint != "") ||
+		(config.Datadog != nil && config.Datadog.Region != "") ||
+		(config.Cloudwatch != nil && config.Cloudwatch.Region != "") ||
+		(config.Elasticsearch != nil && config.Elasticsearch.Endpoint != "") ||
+		(config.HTTP != nil && config.HTTP.URI != "") ||
+		(config.Newrelic != nil && config.Newrelic.AccountID != "")
+}
+
+// expandObservabilityConfig returns the observability configuration of the
+// model. Providers that aren't configured are left empty, which removes them
+// from the configuration of the cluster.
+func expandObservabilityConfig(model observabilityStreamingModel) *vaultmodels.HashicorpCloudVault20201125ObservabilityConfig {
+	base := model.streaming()
+	config := &vaultmodels.HashicorpCloudVault20201125ObservabilityConfig{
+		Cloudwatch: model.expandCloudWatch(),
+	}
+
+	if grafana := base.Grafana; grafana != nil {
+		config.Grafana = &vaultmodels.HashicorpCloudVault20201125Grafana{
+			Endpoint: grafana.Endpoint.ValueString(),
+			User:     grafana.User.ValueString(),
+			Password: grafana.Password.ValueString(),
+		}
+	}
+
+	if splunk := base.Splunk; splunk != nil {
+		config.Splunk = &vaultmodels.HashicorpCloudVault20201125Splunk{
+			HecEndpoint: splunk.HecEndpoint.ValueString(),
+			Token:       splunk.Token.ValueString(),
+		}
+	}
+
+	if datadog := base.Datadog; datadog != nil {
+		config.Datadog = &vaultmodels.HashicorpCloudVault20201125Datadog{
+			APIKey: datadog.APIKey.ValueString(),
+			Region: datadog.Region.ValueString(),
+		}
+	}
+
+	if elasticsearch := base.Elasticsearch; elasticsearch != nil {
+		config.Elasticsearch = &vaultmodels.HashicorpCloudVault20201125Elasticsearch{
+			Endpoint: elasticsearch.Endpoint.ValueString(),
+			User:     elasticsearch.User.ValueString(),
+			Password: elasticsearch.Password.ValueString(),
+		}
+	}
+
+	if http := base.HTTP; http != nil {
+		codec := vaultmodels.HashicorpCloudVault20201125HTTPEncodingCodec(http.Codec.ValueString())
+		config.HTTP = &vaultmodels.HashicorpCloudVault20201125HTTP{
+			URI:           http.URI.ValueString(),
+			Method:        http.Method.ValueString(),
+			Codec:         &codec,
+			Compression:   http.Compression.ValueBool(),
+			PayloadPrefix: http.PayloadPrefix.ValueString(),
+			PayloadSuffix: http.PayloadSuffix.ValueString(),
+		}
+
+		if !http.Headers.IsNull() {
+			headers := make(map[string]string, len(http.Headers.Elements()))
+			for name, value := range http.Headers.Elements() {
+				if value, ok := value.(types.String); ok {
+					headers[name] = value.ValueString()
+				}
+			}
+			config.HTTP.Headers = headers
+		}
+
+		if !http.BearerToken.IsNull() {
+			config.HTTP.Bearer = &vaultmodels.HashicorpCloudVault20201125HTTPBearerAuth{
+				Token: http.BearerToken.ValueString(),
+			}
+		}
+		if !http.BasicUser.IsNull() {
+			config.HTTP.Basic = &vaultmodels.HashicorpCloudVault20201125HTTPBasicAuth{
+				User:     http.BasicUser.ValueString(),
+				Password: http.BasicPassword.ValueString(),
+			}
+		}
+	}
+
+	if newRelic := base.NewRelic; newRelic != nil {
+		region := vaultmodels.HashicorpCloudVault20201125NewRelicRegion(newRelic.Region.ValueString())
+		config.Newrelic = &vaultmodels.HashicorpCloudVault20201125NewRelic{
+			AccountID:  newRelic.AccountID.ValueString(),
+			LicenseKey: newRelic.LicenseKey.ValueString(),
+			Region:     &region,
+		}
+	}
+
+	return config
+}
+
+// flattenObservabilityConfig sets the providers of the model from the
+// observability configuration of the cluster. Sensitive values are returned
+// redacted, so the values of the model are kept for them.
+func flattenObservabilityConfig(model observabilityStreamingModel, config *vaultmodels.HashicorpCloudVault20201125ObservabilityConfig) {
+	if config == nil {
+		config = &vaultmodels.HashicorpCloudVault20201125ObservabilityConfig{}
+	}
+	base := model.streaming()
+	previous := *base
+	model.flattenCloudWatch(config.Cloudwatch)
+
+	base.Grafana = nil
+	if grafana := config.Grafana; grafana != nil && grafana.Endpoint != "" {
+		prior := valueOrZero(previous.Grafana)
+		base.Grafana = &grafanaModel{
+			Endpoint: types.StringValue(grafana.Endpoint),
+			User:     types.StringValue(grafana.User),
+			Password: sensitiveValue(prior.Password, grafana.Password),
+		}
+	}
+
+	base.Splunk = nil
+	if splunk := config.Splunk; splunk != nil && splunk.HecEndpoint != "" {
+		prior := valueOrZero(previous.Splunk)
+		base.Splunk = &splunkModel{
+			HecEndpoint: types.StringValue(splunk.HecEndpoint),
+			Token:       sensitiveValue(prior.Token, splunk.Token),
+		}
+	}
+
+	base.Datadog = nil
+	if datadog := config.Datadog; datadog != nil && datadog.Region != "" {
+		prior := valueOrZero(previous.Datadog)
+		base.Datadog = &datadogModel{
+			APIKey: sensitiveValue(prior.APIKey, datadog.APIKey),
+			Region: types.StringValue(datadog.Region),
+		}
+	}
+
+	base.Elasticsearch = nil
+	if elasticsearch := config.Elasticsearch; elasticsearch != nil && elasticsearch.Endpoint != "" {
+		prior := valueOrZero(previous.Elasticsearch)
+		base.Elasticsearch = &elasticsearchModel{
+			Endpoint: types.StringValue(elasticsearch.Endpoint),
+			User:     types.StringValue(elasticsearch.User),
+			Password: sensitiveValue(prior.Password, elasticsearch.Password),
+			Dataset:  types.StringValue(elasticsearch.Dataset),
+		}
+	}
+
+	base.HTTP = nil
+	if http := config.HTTP; http != nil && http.URI != "" {
+		prior := valueOrZero(previous.HTTP)
+		model := &httpModel{
+			URI:           types.StringValue(http.URI),
+			Method:        types.StringValue(http.Method),
+			Compression:   types.BoolValue(http.Compression),
+			Headers:       flattenHeaders(prior.Headers, http.Headers),
+			PayloadPrefix: optionalValue(prior.PayloadPrefix, http.PayloadPrefix),
+			PayloadSuffix: optionalValue(prior.PayloadSuffix, http.PayloadSuffix),
+			BasicUser:     types.StringNull(),
+			BasicPassword: types.StringNull(),
+			BearerToken:   types.StringNull(),
+		}
+		if http.Codec != nil {
+			model.Codec = types.StringValue(string(*http.Codec))
+		}
+		if http.Basic != nil {
+			model.BasicUser = types.StringValue(http.Basic.User)
+			model.BasicPassword = sensitiveValue(prior.BasicPassword, http.Basic.Password)
+		}
+		if http.Bearer != nil {
+			model.BearerToken = sensitiveValue(prior.BearerToken, http.Bearer.Token)
+		}
+		base.HTTP = model
+	}
+
+	base.NewRelic = nil
+	if newRelic := config.Newrelic; newRelic != nil && newRelic.AccountID != "" {
+		prior := valueOrZero(previous.NewRelic)
+		base.NewRelic = &newRelicModel{
+			AccountID:  types.StringValue(newRelic.AccountID),
+			LicenseKey: sensitiveValue(prior.LicenseKey, newRelic.LicenseKey),
+		}
+		if newRelic.Region != nil {
+			base.NewRelic.Region = types.StringValue(string(*newRelic.Region))
+		}
+	}
+}
+
+// valueOrZero returns the value pointed to by v, or the zero value of its type
+// if v is nil. The zero value of a model has null attributes.
+func valueOrZero[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
+
+// sensitiveValue returns the value of a sensitive attribute returned by the
+// Vault service, which returns redacted values instead of the actual ones.
+// The prior value of the attribute is kept in that case.
+func sensitiveValue(prior types.String, value string) types.String {
+	if value == redacted {
+		return prior
+	}
+	return types.StringValue(value)
+}
+
+// optionalValue returns the value of an optional attribute returned by the
+// Vault service, keeping the attribute null when the value is empty.
+func optionalValue(prior types.String, value string) types.String {
+	if value == "" && prior.IsNull() {
+		return prior
+	}
+	return types.StringValue(value)
+}
+
+// flattenHeaders returns the HTTP headers returned by the Vault service,
+// keeping the attribute null when there are no headers.
+func flattenHeaders(prior types.Map, headers interface{}) types.Map {
+	values := map[string]attr.Value{}
+	if headers, ok := headers.(map[string]interface{}); ok {
+		for name, value := range headers {
+			values[name] = types.StringValue(fmt.Sprint(value))
+		}
+	}
+	if headers, ok := headers.(map[string]string); ok {
+		for name, value := range headers {
+			values[name] = types.StringValue(value)
+		}
+	}
+
+	if len(values) == 0 && prior.IsNull() {
+		return prior
+	}
+	return types.MapValueMust(types.StringType, values)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"testing"
+
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObservabilityStreaming_ModelMatchesSchema(t *testing.T) {
+	for _, kind := range []observabilityStreaming{metricsStreaming, auditLogStreaming} {
+		t.Run(kind.typeName, func(t *testing.T) {
+			ctx := context.Background()
+			r := &resourceObservabilityStreaming{kind: kind}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+			require.False(t, schemaResp.Schema.ValidateImplementation(ctx).HasError())
+
+			model := kind.newModel()
+			base := model.streaming()
+			base.ClusterID = types.StringValue("vault-cluster")
+			base.ProjectID = types.StringValue("b4ac9d8a-7f2e-4f5b-9c0d-2d3e4f5a6b7c")
+			flattenObservabilityConfig(model, &vaultmodels.HashicorpCloudVault20201125ObservabilityConfig{
+				Cloudwatch: &vaultmodels.HashicorpCloudVault20201125CloudWatch{
+					AccessKeyID:     "access-key-id",
+					SecretAccessKey: "secret-access-key",
+					Region:          "us-east-1",
+				},
+			})
+
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			require.False(t, state.Set(ctx, model).HasError())
+
+			got := kind.newModel()
+			require.False(t, state.Get(ctx, got).HasError())
+			assert.Equal(t, model, got)
+		})
+	}
+}
+
+func TestObservabilityStreaming_ExpandFlatten(t *testing.T) {
+	codec := vaultmodels.HashicorpCloudVault20201125HTTPEncodingCodecJSON
+	region := vaultmodels.HashicorpCloudVault20201125NewRelicRegionEU
+
+	model := &metricsStreamingModel{
+		observabilityStreamingBase: observabilityStreamingBase{
+			Datadog: &datadogModel{
+				APIKey: types.StringValue("datadog-api-key"),
+				Region: types.StringValue("us1"),
+			},
+			HTTP: &httpModel{
+				URI:           types.StringValue("https://example.com/metrics"),
+				Method:        types.StringValue("POST"),
+				Codec:         types.StringValue("JSON"),
+				Compression:   types.BoolValue(true),
+				Headers:       types.MapValueMust(types.StringType, nil),
+				BearerToken:   types.StringValue("bearer-token"),
+				PayloadPrefix: types.StringNull(),
+			},
+			NewRelic: &newRelicModel{
+				AccountID:  types.StringValue("account-id"),
+				LicenseKey: types.StringValue("license-key"),
+				Region:     types.StringValue("EU"),
+			},
+		},
+	}
+
+	config := expandObservabilityConfig(model)
+	assert.Equal(t, &vaultmodels.HashicorpCloudVault20201125Datadog{APIKey: "datadog-api-key", Region: "us1"}, config.Datadog)
+	assert.Equal(t, &vaultmodels.HashicorpCloudVault20201125HTTPBearerAuth{Token: "bearer-token"}, config.HTTP.Bearer)
+	assert.Nil(t, config.HTTP.Basic)
+	assert.Equal(t, &codec, config.HTTP.Codec)
+	assert.Equal(t, &region, config.Newrelic.Region)
+	assert.Nil(t, config.Grafana)
+	assert.Nil(t, config.Cloudwatch)
+	assert.True(t, observabilityConfigured(config))
+
+	// The Vault service returns sensitive values redacted, and providers that
+	// were removed as empty configurations.
+	flattenObservabilityConfig(model, &vaultmodels.HashicorpCloudVault20201125ObservabilityConfig{
+		Grafana: &vaultmodels.HashicorpCloudVault20201125Grafana{},
+		Datadog: &vaultmodels.HashicorpCloudVault20201125Datadog{APIKey: redacted, Region: "us1"},
+		HTTP: &vaultmodels.HashicorpCloudVault20201125HTTP{
+			URI:         "https://example.com/metrics",
+			Method:      "POST",
+			Codec:       &codec,
+			Compression: true,
+			Bearer:      &vaultmodels.HashicorpCloudVault20201125HTTPBearerAuth{Token: redacted},
+		},
+		Newrelic: &vaultmodels.HashicorpCloudVault20201125NewRelic{AccountID: "account-id", LicenseKey: redacted, Region: &region},
+	})
+
+	assert.Nil(t, model.Grafana)
+	assert.Nil(t, model.CloudWatch)
+	assert.Equal(t, "datadog-api-key", model.Datadog.APIKey.ValueString())
+	assert.Equal(t, "bearer-token", model.HTTP.BearerToken.ValueString())
+	assert.True(t, model.HTTP.BasicUser.IsNull())
+	assert.True(t, model.HTTP.PayloadPrefix.IsNull())
+	assert.Empty(t, model.HTTP.Headers.Elements())
+	assert.False(t, model.HTTP.Headers.IsNull())
+	assert.Equal(t, "license-key", model.NewRelic.LicenseKey.ValueString())
+	assert.Equal(t, "EU", model.NewRelic.Region.ValueString())
+}
+
+func TestObservabilityConfigured(t *testing.T) {
+	assert.False(t, observabilityConfigured(nil))
+	assert.False(t, observabilityConfigured(&vaultmodels.HashicorpCloudVault20201125ObservabilityConfig{
+		Grafana: &vaultmodels.HashicorpCloudVault20201125Grafana{},
+		HTTP:    &vaultmodels.HashicorpCloudVault20201125HTTP{},
+	}))
+	assert.True(t, observabilityConfigured(&vaultmodels.HashicorpCloudVault20201125ObservabilityConfig{
+		Splunk: &vaultmodels.HashicorpCloudVault20201125Splunk{HecEndpoint: "https://splunk.example.com"},
+	}))
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+// auditLogStreaming describes the hcp_vault_cluster_audit_log_streaming
+// resource.
+var auditLogStreaming = observabilityStreaming{
+	typeName: "vault_cluster_audit_log_streaming",
+	description: "The Vault cluster audit log streaming resource streams the audit logs of an HCP Vault cluster to an " +
+		"observability provider. It must not be used with the `audit_log_config` block of the `hcp_vault_cluster` resource. " +
+		"(https://developer.hashicorp.com/vault/tutorials/cloud-monitoring/vault-audit-log-streaming)",
+	data: "audit logs",
+	cloudwatchAttributes: map[string]schema.Attribute{
+		"group_name": schema.StringAttribute{
+			Description: "CloudWatch group name of the log stream the audit logs are streamed to.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"stream_name": schema.StringAttribute{
+			Description: "CloudWatch name of the log stream the audit logs are streamed to.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+	newModel: func() observabilityStreamingModel {
+		return &auditLogStreamingModel{}
+	},
+	config: func(config *vaultmodels.HashicorpCloudVault20201125ClusterConfig) *vaultmodels.HashicorpCloudVault20201125ObservabilityConfig {
+		if config == nil {
+			return nil
+		}
+		return config.AuditLogExportConfig
+	},
+	update: func(ctx context.Context, client *clients.Client, loc *sharedmodels.HashicorpCloudLocationLocation, clusterID string,
+		config *vaultmodels.HashicorpCloudVault20201125ObservabilityConfig) (*vaultmodels.HashicorpCloudVault20201125UpdateResponse, error) {
+		return clients.UpdateVaultClusterConfig(ctx, client, loc, clusterID, nil, nil, nil, nil, config, nil)
+	},
+}
+
+func NewClusterAuditLogStreamingResource() resource.Resource {
+	return &resourceObservabilityStreaming{kind: auditLogStreaming}
+}
+
+type auditLogStreamingModel struct {
+	observabilityStreamingBase
+	CloudWatch *auditLogCloudWatchModel `tfsdk:"cloudwatch"`
+}
+
+type auditLogCloudWatchModel struct {
+	cloudwatchModel
+	GroupName  types.String `tfsdk:"group_name"`
+	StreamName types.String `tfsdk:"stream_name"`
+}
+
+func (m *auditLogStreamingModel) expandCloudWatch() *vaultmodels.HashicorpCloudVault20201125CloudWatch {
+	if m.CloudWatch == nil {
+		return nil
+	}
+	return m.CloudWatch.expand()
+}
+
+func (m *auditLogStreamingModel) flattenCloudWatch(cloudwatch *vaultmodels.HashicorpCloudVault20201125CloudWatch) {
+	if cloudwatch == nil || cloudwatch.Region == "" {
+		m.CloudWatch = nil
+		return
+	}
+
+	m.CloudWatch = &auditLogCloudWatchModel{
+		cloudwatchModel: valueOrZero(m.CloudWatch).flatten(cloudwatch),
+		GroupName:       types.StringValue(cloudwatch.GroupName),
+		StreamName:      types.StringValue(cloudwatch.StreamName),
+	}
+}
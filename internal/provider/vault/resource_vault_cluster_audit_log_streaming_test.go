@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+)
+
+func TestAccVaultClusterAuditLogStreaming(t *testing.T) {
+	name := "vault-audit-" + acctest.RandString(8)
+	resourceName := "hcp_vault_cluster_audit_log_streaming.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckVaultClusterObservabilityRemoved(t, "hcp_vault_cluster.test", false),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultClusterConfig(name) + `
+resource "hcp_vault_cluster_audit_log_streaming" "test" {
+  cluster_id = hcp_vault_cluster.test.cluster_id
+
+  http = {
+    uri    = "https://example.com/audit"
+    method = "POST"
+    codec  = "JSON"
+
+    bearer_token = "test"
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", "hcp_vault_cluster.test", "cluster_id"),
+					resource.TestCheckResourceAttr(resourceName, "http.uri", "https://example.com/audit"),
+					resource.TestCheckResourceAttr(resourceName, "http.method", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "http.codec", "JSON"),
+					resource.TestCheckResourceAttr(resourceName, "http.compression", "false"),
+					resource.TestCheckNoResourceAttr("hcp_vault_cluster.test", "audit_log_config.0"),
+				),
+			},
+			{
+				Config: testAccVaultClusterConfig(name) + `
+resource "hcp_vault_cluster_audit_log_streaming" "test" {
+  cluster_id = hcp_vault_cluster.test.cluster_id
+
+  datadog = {
+    api_key = "test_datadog"
+    region  = "us1"
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "datadog.region", "us1"),
+					resource.TestCheckNoResourceAttr(resourceName, "http"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccVaultClusterImportID(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "cluster_id",
+				ImportStateVerifyIgnore:              []string{"datadog.api_key"},
+			},
+		},
+	})
+}
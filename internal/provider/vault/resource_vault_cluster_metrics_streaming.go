@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+// metricsStreaming describes the hcp_vault_cluster_metrics_streaming resource.
+var metricsStreaming = observabilityStreaming{
+	typeName: "vault_cluster_metrics_streaming",
+	description: "The Vault cluster metrics streaming resource streams the metrics of an HCP Vault cluster to an " +
+		"observability provider. It must not be used with the `metrics_config` block of the `hcp_vault_cluster` resource. " +
+		"(https://developer.hashicorp.com/vault/tutorials/cloud-monitoring/vault-metrics-guide#metrics-streaming-configuration)",
+	data: "metrics",
+	cloudwatchAttributes: map[string]schema.Attribute{
+		"namespace": schema.StringAttribute{
+			Description: "CloudWatch namespace the metrics are streamed to.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+	newModel: func() observabilityStreamingModel {
+		return &metricsStreamingModel{}
+	},
+	config: func(config *vaultmodels.HashicorpCloudVault20201125ClusterConfig) *vaultmodels.HashicorpCloudVault20201125ObservabilityConfig {
+		if config == nil {
+			return nil
+		}
+		return config.MetricsConfig
+	},
+	update: func(ctx context.Context, client *clients.Client, loc *sharedmodels.HashicorpCloudLocationLocation, clusterID string,
+		config *vaultmodels.HashicorpCloudVault20201125ObservabilityConfig) (*vaultmodels.HashicorpCloudVault20201125UpdateResponse, error) {
+		return clients.UpdateVaultClusterConfig(ctx, client, loc, clusterID, nil, nil, nil, config, nil, nil)
+	},
+}
+
+func NewClusterMetricsStreamingResource() resource.Resource {
+	return &resourceObservabilityStreaming{kind: metricsStreaming}
+}
+
+type metricsStreamingModel struct {
+	observabilityStreamingBase
+	CloudWatch *metricsCloudWatchModel `tfsdk:"cloudwatch"`
+}
+
+type metricsCloudWatchModel struct {
+	cloudwatchModel
+	Namespace types.String `tfsdk:"namespace"`
+}
+
+func (m *metricsStreamingModel) expandCloudWatch() *vaultmodels.HashicorpCloudVault20201125CloudWatch {
+	if m.CloudWatch == nil {
+		return nil
+	}
+	return m.CloudWatch.expand()
+}
+
+func (m *metricsStreamingModel) flattenCloudWatch(cloudwatch *vaultmodels.HashicorpCloudVault20201125CloudWatch) {
+	if cloudwatch == nil || cloudwatch.Region == "" {
+		m.CloudWatch = nil
+		return
+	}
+
+	m.CloudWatch = &metricsCloudWatchModel{
+		cloudwatchModel: valueOrZero(m.CloudWatch).flatten(cloudwatch),
+		Namespace:       types.StringValue(cloudwatch.Namespace),
+	}
+}
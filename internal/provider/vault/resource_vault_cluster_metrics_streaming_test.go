@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+)
+
+// testAccVaultClusterConfig returns the configuration of a development Vault
+// cluster and its HVN.
+func testAccVaultClusterConfig(name string) string {
+	return fmt.Sprintf(`
+resource "hcp_hvn" "test" {
+  hvn_id         = "%[1]s"
+  cloud_provider = "aws"
+  region         = "us-west-2"
+}
+
+resource "hcp_vault_cluster" "test" {
+  cluster_id = "%[1]s"
+  hvn_id     = hcp_hvn.test.hvn_id
+  tier       = "dev"
+}
+`, name)
+}
+
+func TestAccVaultClusterMetricsStreaming(t *testing.T) {
+	name := "vault-metrics-" + acctest.RandString(8)
+	resourceName := "hcp_vault_cluster_metrics_streaming.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckVaultClusterObservabilityRemoved(t, "hcp_vault_cluster.test", true),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultClusterConfig(name) + `
+resource "hcp_vault_cluster_metrics_streaming" "test" {
+  cluster_id = hcp_vault_cluster.test.cluster_id
+
+  datadog = {
+    api_key = "test_datadog"
+    region  = "us1"
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", "hcp_vault_cluster.test", "cluster_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "hcp_vault_cluster.test", "project_id"),
+					resource.TestCheckResourceAttr(resourceName, "datadog.region", "us1"),
+					resource.TestCheckResourceAttr(resourceName, "datadog.api_key", "test_datadog"),
+					resource.TestCheckNoResourceAttr(resourceName, "splunk"),
+					resource.TestCheckNoResourceAttr("hcp_vault_cluster.test", "metrics_config.0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccVaultClusterImportID(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "cluster_id",
+				// Sensitive values are redacted by the Vault service.
+				ImportStateVerifyIgnore: []string{"datadog.api_key"},
+			},
+		},
+	})
+}
+
+// testAccVaultClusterImportID returns the import ID of a resource configuring
+// a Vault cluster, in the format {project_id}:{cluster_id}.
+func testAccVaultClusterImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["cluster_id"]), nil
+	}
+}
+
+// testAccCheckVaultClusterObservabilityRemoved checks that the metrics or the
+// audit log streaming of the Vault cluster was removed, if the cluster still
+// exists.
+func testAccCheckVaultClusterObservabilityRemoved(t *testing.T, clusterResourceName string, metrics bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[clusterResourceName]
+		if !ok {
+			return nil
+		}
+
+		client := acctest.HCPClients(t)
+		loc := &sharedmodels.HashicorpCloudLocationLocation{
+			OrganizationID: client.Config.OrganizationID,
+			ProjectID:      rs.Primary.Attributes["project_id"],
+		}
+
+		cluster, err := clients.GetVaultClusterByID(context.Background(), client, loc, rs.Primary.Attributes["cluster_id"])
+		if err != nil {
+			if clients.IsResponseCodeNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		config := cluster.Config.AuditLogExportConfig
+		if metrics {
+			config = cluster.Config.MetricsConfig
+		}
+		if config != nil && config.Datadog != nil && config.Datadog.Region != "" {
+			return fmt.Errorf("Vault cluster %q still streams to Datadog", cluster.ID)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
+)
+
+// snapshotImportID is the import ID of a snapshot: the ID of the snapshot,
+// optionally prefixed by the ID of its project.
+var snapshotImportID = []hcpvalidator.ImportIDPart{
+	{Name: "project_id", Optional: true, Validators: []validator.String{hcpvalidator.UUID()}},
+	{Name: "snapshot_id"},
+}
+
+type clusterSnapshotModel struct {
+	ProjectID    types.String `tfsdk:"project_id"`
+	ClusterID    types.String `tfsdk:"cluster_id"`
+	SnapshotName types.String `tfsdk:"snapshot_name"`
+	SnapshotID   types.String `tfsdk:"snapshot_id"`
+	Size         types.Int64  `tfsdk:"size"`
+	State        types.String `tfsdk:"state"`
+	VaultVersion types.String `tfsdk:"vault_version"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+var _ resource.Resource = &resourceClusterSnapshot{}
+var _ resource.ResourceWithConfigure = &resourceClusterSnapshot{}
+var _ resource.ResourceWithImportState = &resourceClusterSnapshot{}
+var _ resource.ResourceWithIdentity = &resourceClusterSnapshot{}
+
+func NewClusterSnapshotResource() resource.Resource {
+	return &resourceClusterSnapshot{}
+}
+
+type resourceClusterSnapshot struct {
+	client *clients.Client
+}
+
+func (r *resourceClusterSnapshot) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_cluster_snapshot"
+}
+
+func (r *resourceClusterSnapshot) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Vault cluster snapshot resource manages manual snapshots of an HCP Vault cluster. " +
+			"Snapshots can be restored with the `hcp_vault_cluster_snapshot_restore` resource.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the HCP Vault cluster is located. If not specified, the project configured in the HCP provider config block is used.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					hcpvalidator.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the HCP Vault cluster.",
+				Required:    true,
+				Validators: []validator.String{
+					hcpvalidator.Slug(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_name": schema.StringAttribute{
+				Description: "The name of the snapshot.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				Description: "The ID of the snapshot.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Description: "The size of the snapshot in bytes.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "The state of the snapshot.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vault_version": schema.StringAttribute{
+				Description: "The version of Vault at the time of the snapshot creation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The time the snapshot was requested, in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceClusterSnapshot) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(snapshotImportID...)
+}
+
+func (r *resourceClusterSnapshot) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *resourceClusterSnapshot) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan clusterSnapshotModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectID.IsUnknown() {
+		plan.ProjectID = types.StringValue(r.client.Config.ProjectID)
+	}
+
+	clusterID := plan.ClusterID.ValueString()
+	cluster, err := clients.GetVaultClusterByID(ctx, r.client, r.location(plan.ProjectID), clusterID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			resp.Diagnostics.AddAttributeError(path.Root("cluster_id"), "Vault cluster not found",
+				fmt.Sprintf("Unable to create snapshot; no HCP Vault cluster found with ID %q.", clusterID))
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Vault cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Creating Vault cluster snapshot", map[string]any{"cluster_id": clusterID, "snapshot_name": plan.SnapshotName.ValueString()})
+
+	createResp, err := clients.CreateVaultSnapshot(ctx, r.client, cluster, plan.SnapshotName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to create snapshot of Vault cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	// The snapshot is kept in the state if waiting for it fails, so that it is
+	// not left unmanaged.
+	plan.SnapshotID = types.StringValue(createResp.SnapshotID)
+	if err := clients.WaitForOperation(ctx, r.client, "create Vault snapshot", clusterLocation(cluster), createResp.Operation.ID); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to create snapshot of Vault cluster (%s)", clusterID), err.Error())
+		flattenClusterSnapshot(&plan, &vaultmodels.HashicorpCloudVault20201125Snapshot{ClusterID: clusterID})
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	snapshot, err := clients.GetVaultSnapshotByID(ctx, r.client, r.location(plan.ProjectID), createResp.SnapshotID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to retrieve Vault snapshot (%s)", createResp.SnapshotID), err.Error())
+		return
+	}
+
+	flattenClusterSnapshot(&plan, snapshot)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *resourceClusterSnapshot) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
+	var state clusterSnapshotModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshotID := state.SnapshotID.ValueString()
+	snapshot, err := clients.GetVaultSnapshotByID(ctx, r.client, r.location(state.ProjectID), snapshotID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			tflog.Warn(ctx, "Vault snapshot not found, removing from state", map[string]any{"snapshot_id": snapshotID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Vault snapshot (%s)", snapshotID), err.Error())
+		return
+	}
+
+	flattenClusterSnapshot(&state, snapshot)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceClusterSnapshot) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan clusterSnapshotModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshotID := plan.SnapshotID.ValueString()
+	snapshot, err := clients.RenameVaultSnapshot(ctx, r.client, r.location(plan.ProjectID), snapshotID, plan.SnapshotName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to rename Vault snapshot (%s)", snapshotID), err.Error())
+		return
+	}
+
+	flattenClusterSnapshot(&plan, snapshot)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceClusterSnapshot) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state clusterSnapshotModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loc := r.location(state.ProjectID)
+	snapshotID := state.SnapshotID.ValueString()
+
+	tflog.Info(ctx, "Deleting Vault snapshot", map[string]any{"snapshot_id": snapshotID})
+
+	deleteResp, err := clients.DeleteVaultSnapshot(ctx, r.client, loc, snapshotID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			tflog.Warn(ctx, "Vault snapshot not found, so no action was taken", map[string]any{"snapshot_id": snapshotID})
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to delete Vault snapshot (%s)", snapshotID), err.Error())
+		return
+	}
+
+	if err := clients.WaitForOperation(ctx, r.client, "delete Vault snapshot", loc, deleteResp.Operation.ID); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to delete Vault snapshot (%s)", snapshotID), err.Error())
+	}
+}
+
+// ImportState imports a snapshot from an import ID in the format
+// [{project_id}:]{snapshot_id}, or from its identity.
+func (r *resourceClusterSnapshot) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, diags := identity.ImportID(ctx, req, snapshotImportID...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, snapshotID := parts[0], parts[1]
+	if projectID == "" {
+		projectID = r.client.Config.ProjectID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("snapshot_id"), snapshotID)...)
+}
+
+func (r *resourceClusterSnapshot) location(projectID types.String) *sharedmodels.HashicorpCloudLocationLocation {
+	return &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: r.client.Config.OrganizationID,
+		ProjectID:      projectID.ValueString(),
+	}
+}
+
+// flattenClusterSnapshot sets the attributes of the model from the snapshot.
+// The cluster of a snapshot cannot change, so the configured cluster ID is kept
+// and only set from the snapshot after an import.
+func flattenClusterSnapshot(model *clusterSnapshotModel, snapshot *vaultmodels.HashicorpCloudVault20201125Snapshot) {
+	if model.ClusterID.IsNull() || model.ClusterID.IsUnknown() {
+		model.ClusterID = types.StringValue(snapshot.ClusterID)
+	}
+	if snapshot.Name != "" {
+		model.SnapshotName = types.StringValue(snapshot.Name)
+	}
+	if snapshot.SnapshotID != "" {
+		model.SnapshotID = types.StringValue(snapshot.SnapshotID)
+	}
+	model.Size = snapshotSize(snapshot.Bytes)
+	model.State = types.StringNull()
+	if snapshot.State != nil {
+		model.State = types.StringValue(string(*snapshot.State))
+	}
+	model.VaultVersion = types.StringNull()
+	if snapshot.VaultVersion != "" {
+		model.VaultVersion = types.StringValue(snapshot.VaultVersion)
+	}
+	model.CreatedAt = snapshotTime(snapshot.RequestedAt)
+}
+
+// snapshotSize returns the size of a snapshot, which the Vault service returns
+// as a string and omits until the snapshot is stored.
+func snapshotSize(bytes string) types.Int64 {
+	size, err := strconv.ParseInt(bytes, 10, 64)
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(size)
+}
+
+// snapshotTime formats a snapshot timestamp, omitted by the API when unset.
+func snapshotTime(t strfmt.DateTime) types.String {
+	if time.Time(t).IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Time(t).Format(time.RFC3339))
+}
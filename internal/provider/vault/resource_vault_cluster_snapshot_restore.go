@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+)
+
+type clusterSnapshotRestoreModel struct {
+	ProjectID  types.String `tfsdk:"project_id"`
+	ClusterID  types.String `tfsdk:"cluster_id"`
+	SnapshotID types.String `tfsdk:"snapshot_id"`
+	Triggers   types.Map    `tfsdk:"triggers"`
+	RestoredAt types.String `tfsdk:"restored_at"`
+}
+
+var _ resource.Resource = &resourceClusterSnapshotRestore{}
+var _ resource.ResourceWithConfigure = &resourceClusterSnapshotRestore{}
+
+func NewClusterSnapshotRestoreResource() resource.Resource {
+	return &resourceClusterSnapshotRestore{}
+}
+
+type resourceClusterSnapshotRestore struct {
+	client *clients.Client
+}
+
+func (r *resourceClusterSnapshotRestore) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_cluster_snapshot_restore"
+}
+
+func (r *resourceClusterSnapshotRestore) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Vault cluster snapshot restore resource restores a snapshot to an HCP Vault cluster, replacing the data of the cluster with the data of the snapshot. " +
+			"The snapshot is restored when the resource is created, and again whenever the cluster, the snapshot or `triggers` change. " +
+			"Destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the HCP Vault cluster is located. If not specified, the project configured in the HCP provider config block is used.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					hcpvalidator.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the HCP Vault cluster the snapshot is restored to.",
+				Required:    true,
+				Validators: []validator.String{
+					hcpvalidator.Slug(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				Description: "The ID of the snapshot to restore.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that, when changed, restore the snapshot again. " +
+					"Use this to restore a snapshot on demand, for example as part of a disaster recovery runbook.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"restored_at": schema.StringAttribute{
+				Description: "The time the snapshot was restored, in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceClusterSnapshotRestore) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *resourceClusterSnapshotRestore) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan clusterSnapshotRestoreModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectID.IsUnknown() {
+		plan.ProjectID = types.StringValue(r.client.Config.ProjectID)
+	}
+
+	loc := r.location(plan.ProjectID)
+	clusterID := plan.ClusterID.ValueString()
+	snapshotID := plan.SnapshotID.ValueString()
+
+	cluster, err := clients.GetVaultClusterByID(ctx, r.client, loc, clusterID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			resp.Diagnostics.AddAttributeError(path.Root("cluster_id"), "Vault cluster not found",
+				fmt.Sprintf("Unable to restore snapshot; no HCP Vault cluster found with ID %q.", clusterID))
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Vault cluster (%s)", clusterID), err.Error())
+		return
+	}
+
+	snapshot, err := clients.GetVaultSnapshotByID(ctx, r.client, loc, snapshotID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			resp.Diagnostics.AddAttributeError(path.Root("snapshot_id"), "Vault snapshot not found",
+				fmt.Sprintf("Unable to restore snapshot; no HCP Vault snapshot found with ID %q.", snapshotID))
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Vault snapshot (%s)", snapshotID), err.Error())
+		return
+	}
+
+	// Only stored snapshots can be restored.
+	if state := valueOrZero(snapshot.State); state != vaultmodels.HashicorpCloudVault20201125SnapshotStateSTORED {
+		resp.Diagnostics.AddAttributeError(path.Root("snapshot_id"), "Vault snapshot not stored",
+			fmt.Sprintf("Unable to restore snapshot (%s) in state %q; only stored snapshots can be restored.", snapshotID, state))
+		return
+	}
+
+	tflog.Info(ctx, "Restoring Vault snapshot", map[string]any{"cluster_id": clusterID, "snapshot_id": snapshotID})
+
+	restoreResp, err := clients.RestoreVaultSnapshot(ctx, r.client, cluster, snapshotID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to restore snapshot (%s) to Vault cluster (%s)", snapshotID, clusterID), err.Error())
+		return
+	}
+
+	if err := clients.WaitForOperation(ctx, r.client, "restore Vault snapshot", clusterLocation(cluster), restoreResp.Operation.ID); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to restore snapshot (%s) to Vault cluster (%s)", snapshotID, clusterID), err.Error())
+		return
+	}
+
+	plan.RestoredAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceClusterSnapshotRestore) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state clusterSnapshotRestoreModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The restore is kept in the state as long as its cluster exists, even if
+	// the snapshot has since been deleted.
+	clusterID := state.ClusterID.ValueString()
+	if _, err := clients.GetVaultClusterByID(ctx, r.client, r.location(state.ProjectID), clusterID); err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			tflog.Warn(ctx, "Vault cluster not found, removing snapshot restore from state", map[string]any{"cluster_id": clusterID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch Vault cluster (%s)", clusterID), err.Error())
+	}
+}
+
+// Update is never called, since every argument of the resource requires it to
+// be replaced.
+func (r *resourceClusterSnapshotRestore) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan clusterSnapshotRestoreModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceClusterSnapshotRestore) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Info(ctx, "Vault snapshot restores cannot be undone, removing from state only.")
+}
+
+func (r *resourceClusterSnapshotRestore) location(projectID types.String) *sharedmodels.HashicorpCloudLocationLocation {
+	return &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: r.client.Config.OrganizationID,
+		ProjectID:      projectID.ValueString(),
+	}
+}
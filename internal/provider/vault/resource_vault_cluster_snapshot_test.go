@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+)
+
+func TestAccVaultClusterSnapshot(t *testing.T) {
+	name := "vault-snapshot-" + acctest.RandString(8)
+	resourceName := "hcp_vault_cluster_snapshot.test"
+	restoreResourceName := "hcp_vault_cluster_snapshot_restore.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultClusterConfig(name) + `
+resource "hcp_vault_cluster_snapshot" "test" {
+  cluster_id    = hcp_vault_cluster.test.cluster_id
+  snapshot_name = "test-snapshot"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", "hcp_vault_cluster.test", "cluster_id"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_name", "test-snapshot"),
+					resource.TestCheckResourceAttr(resourceName, "state", "STORED"),
+					resource.TestCheckResourceAttrSet(resourceName, "snapshot_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "size"),
+					resource.TestCheckResourceAttrSet(resourceName, "vault_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				// Rename the snapshot and restore it.
+				Config: testAccVaultClusterConfig(name) + `
+resource "hcp_vault_cluster_snapshot" "test" {
+  cluster_id    = hcp_vault_cluster.test.cluster_id
+  snapshot_name = "test-snapshot-renamed"
+}
+
+resource "hcp_vault_cluster_snapshot_restore" "test" {
+  cluster_id  = hcp_vault_cluster.test.cluster_id
+  snapshot_id = hcp_vault_cluster_snapshot.test.snapshot_id
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "snapshot_name", "test-snapshot-renamed"),
+					resource.TestCheckResourceAttrPair(restoreResourceName, "snapshot_id", resourceName, "snapshot_id"),
+					resource.TestCheckResourceAttrSet(restoreResourceName, "restored_at"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccVaultClusterSnapshotImportID(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "snapshot_id",
+			},
+		},
+	})
+}
+
+func testAccVaultClusterSnapshotImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["snapshot_id"]), nil
+	}
+}
@@ -0,0 +1,892 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
+)
+
+// vaultClusterResourceType is the resource type of a Vault cluster, used to
+// link secondaries to their primary cluster.
+const vaultClusterResourceType = "hashicorp.vault.cluster"
+
+// plusTiers are the tiers of the Vault clusters supporting performance
+// replication.
+var plusTiers = []string{
+	string(vaultmodels.HashicorpCloudVault20201125TierPLUSSMALL),
+	string(vaultmodels.HashicorpCloudVault20201125TierPLUSMEDIUM),
+	string(vaultmodels.HashicorpCloudVault20201125TierPLUSLARGE),
+}
+
+// replicationGroupImportID is the import ID of a replication group: the ID of
+// its primary cluster, optionally prefixed by the ID of its project.
+var replicationGroupImportID = []hcpvalidator.ImportIDPart{
+	{Name: "project_id", Optional: true, Validators: []validator.String{hcpvalidator.UUID()}},
+	{Name: "primary_cluster_id", Validators: []validator.String{hcpvalidator.Slug()}},
+}
+
+type replicationGroupModel struct {
+	ProjectID        types.String                `tfsdk:"project_id"`
+	PrimaryClusterID types.String                `tfsdk:"primary_cluster_id"`
+	Tier             types.String                `tfsdk:"tier"`
+	Secondaries      []replicationSecondaryModel `tfsdk:"secondaries"`
+}
+
+type replicationSecondaryModel struct {
+	ClusterID               types.String `tfsdk:"cluster_id"`
+	HvnID                   types.String `tfsdk:"hvn_id"`
+	PathsFilter             types.List   `tfsdk:"paths_filter"`
+	PublicEndpoint          types.Bool   `tfsdk:"public_endpoint"`
+	VaultVersion            types.String `tfsdk:"vault_version"`
+	VaultPublicEndpointURL  types.String `tfsdk:"vault_public_endpoint_url"`
+	VaultPrivateEndpointURL types.String `tfsdk:"vault_private_endpoint_url"`
+	State                   types.String `tfsdk:"state"`
+}
+
+var _ resource.Resource = &resourceReplicationGroup{}
+var _ resource.ResourceWithConfigure = &resourceReplicationGroup{}
+var _ resource.ResourceWithValidateConfig = &resourceReplicationGroup{}
+var _ resource.ResourceWithModifyPlan = &resourceReplicationGroup{}
+var _ resource.ResourceWithImportState = &resourceReplicationGroup{}
+var _ resource.ResourceWithIdentity = &resourceReplicationGroup{}
+
+func NewReplicationGroupResource() resource.Resource {
+	return &resourceReplicationGroup{}
+}
+
+type resourceReplicationGroup struct {
+	client *clients.Client
+}
+
+func (r *resourceReplicationGroup) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_replication_group"
+}
+
+func (r *resourceReplicationGroup) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Vault replication group resource manages the performance replication secondaries of a Plus tier HCP Vault cluster. " +
+			"Secondaries are created by the group and share the tier of its primary cluster. " +
+			"(https://developer.hashicorp.com/vault/tutorials/cloud-ops/vault-replication-terraform)",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the HCP Vault clusters of the replication group are located. If not specified, the project configured in the HCP provider config block is used.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					hcpvalidator.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"primary_cluster_id": schema.StringAttribute{
+				Description: "The ID of the Plus tier HCP Vault cluster which is the primary of the replication group.",
+				Required:    true,
+				Validators: []validator.String{
+					hcpvalidator.Slug(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tier": schema.StringAttribute{
+				Description: "Tier of every HCP Vault cluster of the replication group, which must match the tier of the primary cluster. " +
+					"Valid options are `plus_small`, `plus_medium` and `plus_large`. Changing it scales all the clusters of the group. " +
+					"See [Scale a cluster](https://registry.terraform.io/providers/hashicorp/hcp/latest/docs/guides/vault-scaling).",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(plusTiers...),
+				},
+			},
+			"secondaries": schema.ListNestedAttribute{
+				Description: "The performance replication secondaries of the primary cluster. Secondaries are created in order and deleted in reverse order.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cluster_id": schema.StringAttribute{
+							Description: "The ID of the HCP Vault cluster of the secondary.",
+							Required:    true,
+							Validators: []validator.String{
+								hcpvalidator.Slug(),
+							},
+						},
+						"hvn_id": schema.StringAttribute{
+							Description: "The ID of the HVN the secondary is associated to. Changing it recreates the secondary.",
+							Required:    true,
+							Validators: []validator.String{
+								hcpvalidator.Slug(),
+							},
+						},
+						"paths_filter": schema.ListAttribute{
+							Description: "The [paths filter](https://developer.hashicorp.com/vault/tutorials/cloud-ops/vault-replication-terraform) of the secondary. It operates in \"deny\" mode only.",
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.UniqueValues(),
+								listvalidator.ValueStringsAre(
+									stringvalidator.RegexMatches(regexp.MustCompile(`\A[\w-]+(/[\w-]+)*\z`), "must be a valid path"),
+								),
+							},
+						},
+						"public_endpoint": schema.BoolAttribute{
+							Description: "Denotes that the secondary has a public endpoint. Defaults to false.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"vault_version": schema.StringAttribute{
+							Description: "The Vault version of the secondary.",
+							Computed:    true,
+						},
+						"vault_public_endpoint_url": schema.StringAttribute{
+							Description: "The public URL of the secondary. This will be empty if `public_endpoint` is `false`.",
+							Computed:    true,
+						},
+						"vault_private_endpoint_url": schema.StringAttribute{
+							Description: "The private URL of the secondary.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The state of the secondary.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceReplicationGroup) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(replicationGroupImportID...)
+}
+
+func (r *resourceReplicationGroup) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *resourceReplicationGroup) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var primaryClusterID types.String
+	var secondaries types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("primary_cluster_id"), &primaryClusterID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secondaries"), &secondaries)...)
+	if resp.Diagnostics.HasError() || secondaries.IsNull() || secondaries.IsUnknown() {
+		return
+	}
+
+	seen := make(map[string]bool, len(secondaries.Elements()))
+	for i, element := range secondaries.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+
+		var secondary replicationSecondaryModel
+		resp.Diagnostics.Append(object.As(ctx, &secondary, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if secondary.ClusterID.IsNull() || secondary.ClusterID.IsUnknown() {
+			continue
+		}
+
+		clusterID := secondary.ClusterID.ValueString()
+		clusterIDPath := path.Root("secondaries").AtListIndex(i).AtName("cluster_id")
+		if clusterID == primaryClusterID.ValueString() {
+			resp.Diagnostics.AddAttributeError(clusterIDPath, "Invalid secondary",
+				fmt.Sprintf("The primary cluster (%s) cannot be a secondary of its own replication group.", clusterID))
+		}
+		if seen[clusterID] {
+			resp.Diagnostics.AddAttributeError(clusterIDPath, "Duplicate secondary",
+				fmt.Sprintf("The cluster %q is declared more than once in the secondaries of the replication group.", clusterID))
+		}
+		seen[clusterID] = true
+	}
+}
+
+// ModifyPlan validates the primary cluster of the replication group, when it
+// already exists, so that tier mismatches are reported at plan time rather
+// than part way through an apply.
+func (r *resourceReplicationGroup) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	modifiers.ModifyPlanForDefaultProjectChange(ctx, r.client.Config.ProjectID, req.State, req.Config, req.Plan, resp)
+
+	var projectID, primaryClusterID, tier, priorTier types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("primary_cluster_id"), &primaryClusterID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tier"), &tier)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tier"), &priorTier)...)
+	}
+	if resp.Diagnostics.HasError() || primaryClusterID.IsUnknown() || tier.IsUnknown() {
+		return
+	}
+	if projectID.IsUnknown() {
+		projectID = types.StringValue(r.client.Config.ProjectID)
+	}
+
+	primary, err := clients.GetVaultClusterByID(ctx, r.client, r.location(projectID), primaryClusterID.ValueString())
+	if err != nil {
+		// The primary cluster may be created by the same apply, in which case
+		// it is validated when the replication group is created.
+		if clients.IsResponseCodeNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to fetch primary Vault cluster (%s)", primaryClusterID.ValueString()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(validatePrimary(primary)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing the tier of an existing group scales all its clusters,
+	// otherwise the tier must match the tier of the primary cluster.
+	if (priorTier.IsNull() || strings.EqualFold(priorTier.ValueString(), tier.ValueString())) &&
+		!strings.EqualFold(string(*primary.Config.Tier), tier.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("tier"), "Vault replication group tier mismatch",
+			fmt.Sprintf("The tier of the replication group (%s) must match the tier of its primary cluster %q (%s). "+
+				"Reference the tier of the primary cluster to keep them in sync.",
+				tier.ValueString(), primary.ID, strings.ToLower(string(*primary.Config.Tier))))
+	}
+}
+
+func (r *resourceReplicationGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan replicationGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectID.IsUnknown() {
+		plan.ProjectID = types.StringValue(r.client.Config.ProjectID)
+	}
+
+	primary, diags := r.getPrimary(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !strings.EqualFold(string(*primary.Config.Tier), plan.Tier.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("tier"), "Vault replication group tier mismatch",
+			fmt.Sprintf("The tier of the replication group (%s) must match the tier of its primary cluster %q (%s).",
+				plan.Tier.ValueString(), primary.ID, strings.ToLower(string(*primary.Config.Tier))))
+		return
+	}
+
+	// The secondaries are created one at a time. If one of them fails, those
+	// that were already created are kept in the state so that they are not
+	// left unmanaged.
+	current := plan
+	current.Secondaries = make([]replicationSecondaryModel, 0, len(plan.Secondaries))
+	for _, secondary := range plan.Secondaries {
+		resp.Diagnostics.Append(r.createSecondary(ctx, &plan, primary, secondary)...)
+		if resp.Diagnostics.HasError() {
+			if len(current.Secondaries) > 0 {
+				resp.Diagnostics.Append(r.setPartialState(ctx, &current, &resp.State)...)
+			}
+			return
+		}
+		current.Secondaries = append(current.Secondaries, secondary)
+	}
+
+	if _, diags := r.read(ctx, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *resourceReplicationGroup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
+	var state replicationGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, "Primary Vault cluster not found, removing replication group from state", map[string]any{"primary_cluster_id": state.PrimaryClusterID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceReplicationGroup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state replicationGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := make(map[string]replicationSecondaryModel, len(plan.Secondaries))
+	for _, secondary := range plan.Secondaries {
+		planned[secondary.ClusterID.ValueString()] = secondary
+	}
+
+	// current tracks the secondaries that exist while the group is updated,
+	// so that the state reflects them if one of the updates fails.
+	current := state
+	current.Secondaries = slices.Clone(state.Secondaries)
+	fail := func() {
+		resp.Diagnostics.Append(r.setPartialState(ctx, &current, &resp.State)...)
+	}
+
+	// Secondaries that were removed from the group, or moved to another HVN,
+	// are deleted first so that fewer clusters are scaled.
+	for i := len(state.Secondaries) - 1; i >= 0; i-- {
+		secondary := state.Secondaries[i]
+		if next, ok := planned[secondary.ClusterID.ValueString()]; ok && next.HvnID.Equal(secondary.HvnID) {
+			continue
+		}
+
+		resp.Diagnostics.Append(r.deleteSecondary(ctx, &state, secondary)...)
+		if resp.Diagnostics.HasError() {
+			fail()
+			return
+		}
+		current.Secondaries = slices.Delete(current.Secondaries, i, i+1)
+	}
+
+	primary, diags := r.getPrimary(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		fail()
+		return
+	}
+
+	if !strings.EqualFold(plan.Tier.ValueString(), state.Tier.ValueString()) {
+		primary, diags = r.scale(ctx, &current, primary, plan.Tier.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			fail()
+			return
+		}
+		current.Tier = plan.Tier
+	}
+
+	for _, secondary := range plan.Secondaries {
+		i := slices.IndexFunc(current.Secondaries, func(s replicationSecondaryModel) bool {
+			return s.ClusterID.Equal(secondary.ClusterID)
+		})
+		if i < 0 {
+			resp.Diagnostics.Append(r.createSecondary(ctx, &plan, primary, secondary)...)
+			if resp.Diagnostics.HasError() {
+				fail()
+				return
+			}
+			current.Secondaries = append(current.Secondaries, secondary)
+			continue
+		}
+
+		resp.Diagnostics.Append(r.updateSecondary(ctx, &plan, current.Secondaries[i], secondary)...)
+		if resp.Diagnostics.HasError() {
+			fail()
+			return
+		}
+		current.Secondaries[i] = secondary
+	}
+
+	if _, diags := r.read(ctx, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceReplicationGroup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state replicationGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Secondaries are deleted in the reverse order of their creation, before
+	// the primary cluster which the replication group depends on.
+	for i := len(state.Secondaries) - 1; i >= 0; i-- {
+		resp.Diagnostics.Append(r.deleteSecondary(ctx, &state, state.Secondaries[i])...)
+		if resp.Diagnostics.HasError() {
+			state.Secondaries = state.Secondaries[:i+1]
+			resp.Diagnostics.Append(r.setPartialState(ctx, &state, &resp.State)...)
+			return
+		}
+	}
+}
+
+// ImportState imports a replication group from an import ID in the format
+// [{project_id}:]{primary_cluster_id}, or from its identity. Every secondary of
+// the primary cluster is imported into the group.
+func (r *resourceReplicationGroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, diags := identity.ImportID(ctx, req, replicationGroupImportID...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, primaryClusterID := parts[0], parts[1]
+	if projectID == "" {
+		projectID = r.client.Config.ProjectID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("primary_cluster_id"), primaryClusterID)...)
+}
+
+func (r *resourceReplicationGroup) location(projectID types.String) *sharedmodels.HashicorpCloudLocationLocation {
+	return &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: r.client.Config.OrganizationID,
+		ProjectID:      projectID.ValueString(),
+	}
+}
+
+// getPrimary returns the primary cluster of the replication group, after
+// checking that it can be the primary of a replication group.
+func (r *resourceReplicationGroup) getPrimary(ctx context.Context, model *replicationGroupModel) (*vaultmodels.HashicorpCloudVault20201125Cluster, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	primaryClusterID := model.PrimaryClusterID.ValueString()
+	primary, err := clients.GetVaultClusterByID(ctx, r.client, r.location(model.ProjectID), primaryClusterID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			diags.AddAttributeError(path.Root("primary_cluster_id"), "Primary Vault cluster not found",
+				fmt.Sprintf("The primary cluster (%s) does not exist.", primaryClusterID))
+			return nil, diags
+		}
+		diags.AddError(fmt.Sprintf("Unable to fetch primary Vault cluster (%s)", primaryClusterID), err.Error())
+		return nil, diags
+	}
+
+	diags.Append(validatePrimary(primary)...)
+	return primary, diags
+}
+
+// read refreshes the model from the primary cluster and its secondaries. It
+// reports whether the primary cluster was found. Secondaries that no longer
+// exist are removed from the model, and every secondary of the primary is
+// added to it when it has none, as it is the case after an import.
+func (r *resourceReplicationGroup) read(ctx context.Context, model *replicationGroupModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	loc := r.location(model.ProjectID)
+	primaryClusterID := model.PrimaryClusterID.ValueString()
+	primary, err := clients.GetVaultClusterByID(ctx, r.client, loc, primaryClusterID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			return false, diags
+		}
+		diags.AddError(fmt.Sprintf("Unable to fetch primary Vault cluster (%s)", primaryClusterID), err.Error())
+		return false, diags
+	}
+
+	secondaries, err := clients.ListVaultPerformanceReplicationSecondaries(ctx, r.client, loc, primaryClusterID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to list secondaries of Vault cluster (%s)", primaryClusterID), err.Error())
+		return false, diags
+	}
+	slices.SortFunc(secondaries, func(a, b *vaultmodels.HashicorpCloudVault20201125Cluster) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	if model.Secondaries == nil {
+		for _, secondary := range secondaries {
+			model.Secondaries = append(model.Secondaries, replicationSecondaryModel{
+				ClusterID: types.StringValue(secondary.ID),
+			})
+		}
+	}
+
+	managed := make([]*vaultmodels.HashicorpCloudVault20201125Cluster, 0, len(model.Secondaries))
+	refreshed := make([]replicationSecondaryModel, 0, len(model.Secondaries))
+	for _, secondary := range model.Secondaries {
+		i := slices.IndexFunc(secondaries, func(c *vaultmodels.HashicorpCloudVault20201125Cluster) bool {
+			return c.ID == secondary.ClusterID.ValueString()
+		})
+		if i < 0 {
+			tflog.Warn(ctx, "Vault secondary cluster not found, removing it from the replication group", map[string]any{"cluster_id": secondary.ClusterID.ValueString()})
+			continue
+		}
+
+		managed = append(managed, secondaries[i])
+		refreshed = append(refreshed, flattenReplicationSecondary(ctx, secondaries[i]))
+	}
+	model.Secondaries = refreshed
+
+	tier := groupTier(primary, managed)
+	if !strings.EqualFold(model.Tier.ValueString(), tier) {
+		model.Tier = types.StringValue(strings.ToLower(tier))
+	}
+
+	return true, diags
+}
+
+// createSecondary creates a secondary of the primary cluster and waits for it
+// to be created.
+func (r *resourceReplicationGroup) createSecondary(ctx context.Context, model *replicationGroupModel,
+	primary *vaultmodels.HashicorpCloudVault20201125Cluster, secondary replicationSecondaryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	loc := r.location(model.ProjectID)
+	clusterID := secondary.ClusterID.ValueString()
+	hvnID := secondary.HvnID.ValueString()
+
+	// The region of the secondary is the region of its HVN.
+	hvn, err := clients.GetHvnByID(ctx, r.client, loc, hvnID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to find existing HVN (%s)", hvnID), err.Error())
+		return diags
+	}
+	loc.Region = &sharedmodels.HashicorpCloudLocationRegion{
+		Provider: hvn.Location.Region.Provider,
+		Region:   hvn.Location.Region.Region,
+	}
+
+	_, err = clients.GetVaultClusterByID(ctx, r.client, loc, clusterID)
+	if err == nil {
+		diags.AddError(fmt.Sprintf("Vault cluster (%s) already exists", clusterID),
+			fmt.Sprintf("A Vault cluster with cluster_id=%q in project_id=%q already exists. Secondaries are created by the replication group, "+
+				"so existing clusters cannot be added to it.", clusterID, loc.ProjectID))
+		return diags
+	}
+	if !clients.IsResponseCodeNotFound(err) {
+		diags.AddError(fmt.Sprintf("Unable to check for presence of an existing Vault cluster (%s)", clusterID), err.Error())
+		return diags
+	}
+
+	pathsFilter, d := expandPathsFilter(ctx, secondary.PathsFilter)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Info(ctx, "Creating Vault secondary cluster", map[string]any{"cluster_id": clusterID, "primary_cluster_id": primary.ID})
+
+	createResp, err := clients.CreateVaultCluster(ctx, r.client, loc, &vaultmodels.HashicorpCloudVault20201125InputCluster{
+		ID: clusterID,
+		Location: &vaultmodels.HashicorpCloudInternalLocationLocation{
+			OrganizationID: loc.OrganizationID,
+			ProjectID:      loc.ProjectID,
+			Region: &vaultmodels.HashicorpCloudInternalLocationRegion{
+				Provider: loc.Region.Provider,
+				Region:   loc.Region.Region,
+			},
+		},
+		Config: &vaultmodels.HashicorpCloudVault20201125InputClusterConfig{
+			VaultConfig: &vaultmodels.HashicorpCloudVault20201125VaultConfig{
+				// Secondary clusters inherit their initial version from the
+				// current version of their primary.
+				InitialVersion: primary.CurrentVersion,
+			},
+			Tier: primary.Config.Tier,
+			NetworkConfig: &vaultmodels.HashicorpCloudVault20201125InputNetworkConfig{
+				NetworkID:        hvn.ID,
+				PublicIpsEnabled: secondary.PublicEndpoint.ValueBool(),
+			},
+		},
+		PerformanceReplicationPrimaryCluster: &vaultmodels.HashicorpCloudInternalLocationLink{
+			Type:     vaultClusterResourceType,
+			ID:       primary.ID,
+			Location: primary.Location,
+		},
+		PerformanceReplicationPathsFilter: pathsFilter,
+	})
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to create Vault secondary cluster (%s)", clusterID), err.Error())
+		return diags
+	}
+
+	if err := clients.WaitForOperation(ctx, r.client, "create Vault cluster", loc, createResp.Operation.ID); err != nil {
+		diags.AddError(fmt.Sprintf("Unable to create Vault secondary cluster (%s)", clusterID), err.Error())
+	}
+
+	return diags
+}
+
+// updateSecondary updates the paths filter and the public endpoint of a
+// secondary.
+func (r *resourceReplicationGroup) updateSecondary(ctx context.Context, model *replicationGroupModel, prior, secondary replicationSecondaryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if prior.PathsFilter.Equal(secondary.PathsFilter) && prior.PublicEndpoint.Equal(secondary.PublicEndpoint) {
+		return diags
+	}
+
+	clusterID := secondary.ClusterID.ValueString()
+	cluster, err := clients.GetVaultClusterByID(ctx, r.client, r.location(model.ProjectID), clusterID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to fetch Vault secondary cluster (%s)", clusterID), err.Error())
+		return diags
+	}
+	loc := clusterLocation(cluster)
+
+	tflog.Info(ctx, "Updating Vault secondary cluster", map[string]any{"cluster_id": clusterID})
+
+	if !prior.PathsFilter.Equal(secondary.PathsFilter) {
+		var operationID string
+		if secondary.PathsFilter.IsNull() {
+			deleteResp, err := clients.DeleteVaultPathsFilter(ctx, r.client, loc, clusterID)
+			if err != nil {
+				diags.AddError(fmt.Sprintf("Error deleting paths filter of Vault secondary cluster (%s)", clusterID), err.Error())
+				return diags
+			}
+			operationID = deleteResp.Operation.ID
+		} else {
+			pathsFilter, d := expandPathsFilter(ctx, secondary.PathsFilter)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			updateResp, err := clients.UpdateVaultPathsFilter(ctx, r.client, loc, clusterID, *pathsFilter)
+			if err != nil {
+				diags.AddError(fmt.Sprintf("Error updating paths filter of Vault secondary cluster (%s)", clusterID), err.Error())
+				return diags
+			}
+			operationID = updateResp.Operation.ID
+		}
+
+		if err := clients.WaitForOperation(ctx, r.client, "update Vault paths filter", loc, operationID); err != nil {
+			diags.AddError(fmt.Sprintf("Unable to update paths filter of Vault secondary cluster (%s)", clusterID), err.Error())
+			return diags
+		}
+	}
+
+	if !prior.PublicEndpoint.Equal(secondary.PublicEndpoint) {
+		updateResp, err := clients.UpdateVaultClusterPublicIps(ctx, r.client, loc, clusterID, secondary.PublicEndpoint.ValueBool())
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error updating public endpoint of Vault secondary cluster (%s)", clusterID), err.Error())
+			return diags
+		}
+
+		if err := clients.WaitForOperation(ctx, r.client, "update Vault cluster public IPs", loc, updateResp.Operation.ID); err != nil {
+			diags.AddError(fmt.Sprintf("Unable to update public endpoint of Vault secondary cluster (%s)", clusterID), err.Error())
+		}
+	}
+
+	return diags
+}
+
+// deleteSecondary deletes a secondary and waits for it to be deleted.
+func (r *resourceReplicationGroup) deleteSecondary(ctx context.Context, model *replicationGroupModel, secondary replicationSecondaryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	loc := r.location(model.ProjectID)
+	clusterID := secondary.ClusterID.ValueString()
+
+	tflog.Info(ctx, "Deleting Vault secondary cluster", map[string]any{"cluster_id": clusterID})
+
+	deleteResp, err := clients.DeleteVaultCluster(ctx, r.client, loc, clusterID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			tflog.Warn(ctx, "Vault secondary cluster not found, so no action was taken", map[string]any{"cluster_id": clusterID})
+			return diags
+		}
+		diags.AddError(fmt.Sprintf("Unable to delete Vault secondary cluster (%s)", clusterID), err.Error())
+		return diags
+	}
+
+	if err := clients.WaitForOperation(ctx, r.client, "delete Vault cluster", loc, deleteResp.Operation.ID); err != nil {
+		diags.AddError(fmt.Sprintf("Unable to delete Vault secondary cluster (%s)", clusterID), err.Error())
+	}
+
+	return diags
+}
+
+// scale scales the clusters of the replication group to the tier. Clusters of
+// a replication group scale together through their primary, so the request
+// is only issued if one of them does not have the tier yet. The refreshed
+// primary cluster is returned.
+func (r *resourceReplicationGroup) scale(ctx context.Context, model *replicationGroupModel,
+	primary *vaultmodels.HashicorpCloudVault20201125Cluster, tier string) (*vaultmodels.HashicorpCloudVault20201125Cluster, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	secondaries, err := clients.ListVaultPerformanceReplicationSecondaries(ctx, r.client, r.location(model.ProjectID), primary.ID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to list secondaries of Vault cluster (%s)", primary.ID), err.Error())
+		return nil, diags
+	}
+	if strings.EqualFold(groupTier(primary, secondaries), tier) {
+		return primary, diags
+	}
+
+	tflog.Info(ctx, "Scaling Vault replication group", map[string]any{"primary_cluster_id": primary.ID, "tier": tier})
+
+	loc := clusterLocation(primary)
+	tier = strings.ToUpper(tier)
+	updateResp, err := clients.UpdateVaultClusterConfig(ctx, r.client, loc, primary.ID, &tier, nil, nil, nil, nil, nil)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error scaling Vault replication group of cluster (%s)", primary.ID), err.Error())
+		return nil, diags
+	}
+
+	if err := clients.WaitForOperation(ctx, r.client, "update Vault cluster", loc, updateResp.Operation.ID); err != nil {
+		diags.AddError(fmt.Sprintf("Unable to scale Vault replication group of cluster (%s)", primary.ID), err.Error())
+		return nil, diags
+	}
+
+	updated, err := clients.GetVaultClusterByID(ctx, r.client, loc, primary.ID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to retrieve Vault cluster (%s)", primary.ID), err.Error())
+		return nil, diags
+	}
+
+	return updated, diags
+}
+
+// setPartialState sets the state of a replication group that was partially
+// created, updated or deleted.
+func (r *resourceReplicationGroup) setPartialState(ctx context.Context, model *replicationGroupModel, state *tfsdk.State) diag.Diagnostics {
+	for i := range model.Secondaries {
+		secondary := &model.Secondaries[i]
+		for _, value := range []*types.String{&secondary.VaultVersion, &secondary.VaultPublicEndpointURL, &secondary.VaultPrivateEndpointURL, &secondary.State} {
+			if value.IsUnknown() {
+				*value = types.StringNull()
+			}
+		}
+	}
+
+	return state.Set(ctx, model)
+}
+
+// validatePrimary checks that a cluster can be the primary of a replication
+// group.
+func validatePrimary(primary *vaultmodels.HashicorpCloudVault20201125Cluster) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if primary.Config == nil || primary.Config.Tier == nil || !slices.Contains(plusTiers, string(*primary.Config.Tier)) {
+		diags.AddAttributeError(path.Root("primary_cluster_id"), "Invalid primary Vault cluster",
+			fmt.Sprintf("The primary cluster (%s) must be a Plus tier cluster.", primary.ID))
+		return diags
+	}
+
+	if info := primary.PerformanceReplicationInfo; info != nil && info.Mode != nil &&
+		*info.Mode == vaultmodels.HashicorpCloudVault20201125ClusterPerformanceReplicationInfoModeSECONDARY {
+		diags.AddAttributeError(path.Root("primary_cluster_id"), "Invalid primary Vault cluster",
+			fmt.Sprintf("The primary cluster (%s) is already a secondary.", primary.ID))
+	}
+
+	return diags
+}
+
+// groupTier returns the tier of a replication group: the tier of its primary,
+// unless a secondary has a different tier because scaling the group failed
+// part way through, so that the next apply scales the group again.
+func groupTier(primary *vaultmodels.HashicorpCloudVault20201125Cluster, secondaries []*vaultmodels.HashicorpCloudVault20201125Cluster) string {
+	tier := string(valueOrZero(primary.Config.Tier))
+	for _, secondary := range secondaries {
+		if secondary.Config != nil && secondary.Config.Tier != nil && string(*secondary.Config.Tier) != tier {
+			return string(*secondary.Config.Tier)
+		}
+	}
+
+	return tier
+}
+
+// expandPathsFilter returns the paths filter of a secondary, or nil if it has
+// none.
+func expandPathsFilter(ctx context.Context, paths types.List) (*vaultmodels.HashicorpCloudVault20201125ClusterPerformanceReplicationPathsFilter, diag.Diagnostics) {
+	if paths.IsNull() || paths.IsUnknown() {
+		return nil, nil
+	}
+
+	pathsFilter := &vaultmodels.HashicorpCloudVault20201125ClusterPerformanceReplicationPathsFilter{
+		Mode: vaultmodels.HashicorpCloudVault20201125ClusterPerformanceReplicationPathsFilterModeDENY.Pointer(),
+	}
+	diags := paths.ElementsAs(ctx, &pathsFilter.Paths, false)
+	return pathsFilter, diags
+}
+
+// flattenReplicationSecondary returns the model of a secondary cluster.
+func flattenReplicationSecondary(ctx context.Context, cluster *vaultmodels.HashicorpCloudVault20201125Cluster) replicationSecondaryModel {
+	secondary := replicationSecondaryModel{
+		ClusterID:               types.StringValue(cluster.ID),
+		HvnID:                   types.StringNull(),
+		PathsFilter:             types.ListNull(types.StringType),
+		PublicEndpoint:          types.BoolValue(false),
+		VaultVersion:            types.StringValue(cluster.CurrentVersion),
+		VaultPublicEndpointURL:  types.StringNull(),
+		VaultPrivateEndpointURL: types.StringNull(),
+		State:                   types.StringNull(),
+	}
+
+	if cluster.Config != nil && cluster.Config.NetworkConfig != nil {
+		secondary.HvnID = types.StringValue(cluster.Config.NetworkConfig.NetworkID)
+		secondary.PublicEndpoint = types.BoolValue(cluster.Config.NetworkConfig.PublicIpsEnabled)
+	}
+
+	if info := cluster.PerformanceReplicationInfo; info != nil && info.PathsFilter != nil && len(info.PathsFilter.Paths) > 0 {
+		secondary.PathsFilter, _ = types.ListValueFrom(ctx, types.StringType, info.PathsFilter.Paths)
+	}
+
+	// Port 8200 is required to communicate with HCP Vault via HTTPS.
+	if cluster.DNSNames != nil {
+		if secondary.PublicEndpoint.ValueBool() {
+			secondary.VaultPublicEndpointURL = types.StringValue(fmt.Sprintf("https://%s:8200", cluster.DNSNames.Public))
+		}
+		secondary.VaultPrivateEndpointURL = types.StringValue(fmt.Sprintf("https://%s:8200", cluster.DNSNames.Private))
+	}
+
+	if cluster.State != nil {
+		secondary.State = types.StringValue(string(*cluster.State))
+	}
+
+	return secondary
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+)
+
+func TestAccVaultReplicationGroup(t *testing.T) {
+	name := "vault-repl-" + acctest.RandString(8)
+	resourceName := "hcp_vault_replication_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The tier of the group must match the tier of its primary.
+				Config: testAccVaultReplicationGroupConfig(name, "plus_small", `
+resource "hcp_vault_replication_group" "test" {
+  primary_cluster_id = hcp_vault_cluster.primary.cluster_id
+  tier               = "plus_medium"
+
+  secondaries = [{
+    cluster_id = "%[1]s-2"
+    hvn_id     = hcp_hvn.secondary.hvn_id
+  }]
+}
+`),
+				ExpectError: regexp.MustCompile(`Vault replication group tier mismatch`),
+			},
+			{
+				Config: testAccVaultReplicationGroupConfig(name, "plus_small", `
+resource "hcp_vault_replication_group" "test" {
+  primary_cluster_id = hcp_vault_cluster.primary.cluster_id
+  tier               = hcp_vault_cluster.primary.tier
+
+  secondaries = [{
+    cluster_id   = "%[1]s-2"
+    hvn_id       = hcp_hvn.secondary.hvn_id
+    paths_filter = ["path/a", "path/b"]
+  }]
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "primary_cluster_id", "hcp_vault_cluster.primary", "cluster_id"),
+					resource.TestCheckResourceAttr(resourceName, "tier", "plus_small"),
+					resource.TestCheckResourceAttr(resourceName, "secondaries.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secondaries.0.cluster_id", name+"-2"),
+					resource.TestCheckResourceAttr(resourceName, "secondaries.0.paths_filter.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "secondaries.0.public_endpoint", "false"),
+					resource.TestCheckResourceAttr(resourceName, "secondaries.0.state", "RUNNING"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondaries.0.vault_private_endpoint_url"),
+				),
+			},
+			{
+				// Update the paths filter of the secondary and scale the group.
+				Config: testAccVaultReplicationGroupConfig(name, "plus_medium", `
+resource "hcp_vault_replication_group" "test" {
+  primary_cluster_id = hcp_vault_cluster.primary.cluster_id
+  tier               = hcp_vault_cluster.primary.tier
+
+  secondaries = [{
+    cluster_id   = "%[1]s-2"
+    hvn_id       = hcp_hvn.secondary.hvn_id
+    paths_filter = ["path/c"]
+  }]
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tier", "plus_medium"),
+					resource.TestCheckResourceAttr(resourceName, "secondaries.0.paths_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secondaries.0.paths_filter.0", "path/c"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccVaultReplicationGroupImportID(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "primary_cluster_id",
+			},
+		},
+	})
+}
+
+// testAccVaultReplicationGroupConfig returns the configuration of a primary
+// Vault cluster of the tier, the HVN of its secondaries and the replication
+// group, formatted with the name.
+func testAccVaultReplicationGroupConfig(name, tier, group string) string {
+	return fmt.Sprintf(`
+resource "hcp_hvn" "primary" {
+  hvn_id         = "%[1]s-1"
+  cidr_block     = "172.25.16.0/20"
+  cloud_provider = "aws"
+  region         = "us-west-2"
+}
+
+resource "hcp_hvn" "secondary" {
+  hvn_id         = "%[1]s-2"
+  cidr_block     = "172.24.16.0/20"
+  cloud_provider = "aws"
+  region         = "us-east-1"
+}
+
+resource "hcp_vault_cluster" "primary" {
+  cluster_id = "%[1]s-1"
+  hvn_id     = hcp_hvn.primary.hvn_id
+  tier       = "%[2]s"
+}
+`+group, name, tier)
+}
+
+func testAccVaultReplicationGroupImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["primary_cluster_id"]), nil
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"strings"
+
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	upgradeKindMajor = "MAJOR"
+	upgradeKindMinor = "MINOR"
+
+	upgradeStatusAvailable      = "AVAILABLE"
+	upgradeStatusInProgress     = "IN_PROGRESS"
+	upgradeStatusFailed         = "FAILED"
+	upgradeStatusActionRequired = "ACTION_REQUIRED"
+)
+
+// pendingUpgradeTopics maps the cluster notification topics describing an
+// upgrade that has not completed yet to the kind and status of the upgrade.
+var pendingUpgradeTopics = map[vaultmodels.HashicorpCloudVault20201125ClusterNotificationTopic][2]string{
+	vaultmodels.HashicorpCloudVault20201125ClusterNotificationTopicNEWMAJORVERSIONAVAILABLE:          {upgradeKindMajor, upgradeStatusAvailable},
+	vaultmodels.HashicorpCloudVault20201125ClusterNotificationTopicMAJORVERSIONUPGRADEINPROGRESS:     {upgradeKindMajor, upgradeStatusInProgress},
+	vaultmodels.HashicorpCloudVault20201125ClusterNotificationTopicMAJORVERSIONUPGRADEFAILED:         {upgradeKindMajor, upgradeStatusFailed},
+	vaultmodels.HashicorpCloudVault20201125ClusterNotificationTopicMAJORVERSIONUPGRADEMANUALREMINDER: {upgradeKindMajor, upgradeStatusActionRequired},
+	vaultmodels.HashicorpCloudVault20201125ClusterNotificationTopicNEWMINORVERSIONAVAILABLE:          {upgradeKindMinor, upgradeStatusAvailable},
+	vaultmodels.HashicorpCloudVault20201125ClusterNotificationTopicMINORVERSIONUPGRADEINPROGRESS:     {upgradeKindMinor, upgradeStatusInProgress},
+	vaultmodels.HashicorpCloudVault20201125ClusterNotificationTopicMINORVERSIONUPGRADEFAILED:         {upgradeKindMinor, upgradeStatusFailed},
+	vaultmodels.HashicorpCloudVault20201125ClusterNotificationTopicMINORVERSIONUPGRADEMANUALREMINDER: {upgradeKindMinor, upgradeStatusActionRequired},
+}
+
+type pendingUpgrade struct {
+	Type                  types.String `tfsdk:"type"`
+	Status                types.String `tfsdk:"status"`
+	UpgradeType           types.String `tfsdk:"upgrade_type"`
+	MaintenanceWindowDay  types.String `tfsdk:"maintenance_window_day"`
+	MaintenanceWindowTime types.String `tfsdk:"maintenance_window_time"`
+}
+
+// flattenPendingUpgrades returns the pending upgrades of a cluster from its
+// notifications, in the order the Vault service returns them.
+func flattenPendingUpgrades(cluster *vaultmodels.HashicorpCloudVault20201125Cluster) []pendingUpgrade {
+	upgrades := []pendingUpgrade{}
+	for _, notification := range cluster.Notifications {
+		if notification == nil || notification.Topic == nil {
+			continue
+		}
+		kind, ok := pendingUpgradeTopics[*notification.Topic]
+		if !ok {
+			continue
+		}
+
+		upgrade := pendingUpgrade{
+			Type:                  types.StringValue(kind[0]),
+			Status:                types.StringValue(kind[1]),
+			UpgradeType:           types.StringNull(),
+			MaintenanceWindowDay:  types.StringNull(),
+			MaintenanceWindowTime: types.StringNull(),
+		}
+		setUpgradeConfig(&upgrade, cluster.Config)
+		upgrades = append(upgrades, upgrade)
+	}
+
+	return upgrades
+}
+
+// setUpgradeConfig sets the upgrade configuration of the cluster that applies
+// to the kind of the upgrade.
+func setUpgradeConfig(upgrade *pendingUpgrade, config *vaultmodels.HashicorpCloudVault20201125ClusterConfig) {
+	if config == nil {
+		return
+	}
+
+	var upgradeType, day, window string
+	switch upgrade.Type.ValueString() {
+	case upgradeKindMajor:
+		c := config.MajorVersionUpgradeConfig
+		if c == nil || c.UpgradeType == nil {
+			return
+		}
+		upgradeType = string(*c.UpgradeType)
+		if c.MaintenanceWindow != nil {
+			day = string(valueOrZero(c.MaintenanceWindow.DayOfWeek))
+			window = string(valueOrZero(c.MaintenanceWindow.TimeWindowUtc))
+		}
+	case upgradeKindMinor:
+		c := config.MinorVersionUpgradeConfig
+		if c == nil || c.UpgradeType == nil {
+			return
+		}
+		upgradeType = string(*c.UpgradeType)
+		if c.MaintenanceWindow != nil {
+			day = string(valueOrZero(c.MaintenanceWindow.DayOfWeek))
+			window = string(valueOrZero(c.MaintenanceWindow.TimeWindowUtc))
+		}
+	}
+
+	upgrade.UpgradeType = types.StringValue(upgradeType)
+	if strings.EqualFold(upgradeType, "SCHEDULED") {
+		upgrade.MaintenanceWindowDay = types.StringValue(day)
+		upgrade.MaintenanceWindowTime = types.StringValue(window)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"testing"
+
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenPendingUpgrades(t *testing.T) {
+	topic := func(t vaultmodels.HashicorpCloudVault20201125ClusterNotificationTopic) *vaultmodels.HashicorpCloudVault20201125ClusterNotificationTopic {
+		return &t
+	}
+	majorType := vaultmodels.HashicorpCloudVault20201125MajorVersionUpgradeConfigUpgradeTypeMANUAL
+	minorType := vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfigUpgradeTypeSCHEDULED
+	day := vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfigMaintenanceWindowDayOfWeekSATURDAY
+	window := vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfigMaintenanceWindowTimeWindowUTCWINDOW6PM10PM
+
+	cluster := &vaultmodels.HashicorpCloudVault20201125Cluster{
+		Config: &vaultmodels.HashicorpCloudVault20201125ClusterConfig{
+			MajorVersionUpgradeConfig: &vaultmodels.HashicorpCloudVault20201125MajorVersionUpgradeConfig{
+				UpgradeType: &majorType,
+			},
+			MinorVersionUpgradeConfig: &vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfig{
+				UpgradeType: &minorType,
+				MaintenanceWindow: &vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfigMaintenanceWindow{
+					DayOfWeek:     &day,
+					TimeWindowUtc: &window,
+				},
+			},
+		},
+		Notifications: []*vaultmodels.HashicorpCloudVault20201125ClusterNotification{
+			{
+				Topic: topic(vaultmodels.HashicorpCloudVault20201125ClusterNotificationTopicMAJORVERSIONUPGRADEMANUALREMINDER),
+			},
+			{
+				Topic: topic(vaultmodels.HashicorpCloudVault20201125ClusterNotificationTopicMINORVERSIONUPGRADECOMPLETED),
+			},
+			{
+				Topic: topic(vaultmodels.HashicorpCloudVault20201125ClusterNotificationTopicNEWMINORVERSIONAVAILABLE),
+			},
+			nil,
+		},
+	}
+
+	assert.Equal(t, []pendingUpgrade{
+		{
+			Type:                  types.StringValue("MAJOR"),
+			Status:                types.StringValue("ACTION_REQUIRED"),
+			UpgradeType:           types.StringValue("MANUAL"),
+			MaintenanceWindowDay:  types.StringNull(),
+			MaintenanceWindowTime: types.StringNull(),
+		},
+		{
+			Type:                  types.StringValue("MINOR"),
+			Status:                types.StringValue("AVAILABLE"),
+			UpgradeType:           types.StringValue("SCHEDULED"),
+			MaintenanceWindowDay:  types.StringValue("SATURDAY"),
+			MaintenanceWindowTime: types.StringValue("WINDOW_6PM_10PM"),
+		},
+	}, flattenPendingUpgrades(cluster))
+}
@@ -14,14 +14,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 
 	service "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-radar/preview/2023-05-01/client/integration_connection_service"
 )
 
 var (
-	_ resource.Resource              = &integrationConnectionResource{}
-	_ resource.ResourceWithConfigure = &integrationConnectionResource{}
+	_ resource.Resource                = &integrationConnectionResource{}
+	_ resource.ResourceWithConfigure   = &integrationConnectionResource{}
+	_ resource.ResourceWithImportState = &integrationConnectionResource{}
+	_ resource.ResourceWithIdentity    = &integrationConnectionResource{}
 )
 
 // integrationConnectionResource is an implementation for configuring specific types of integration connections.
@@ -73,6 +76,14 @@ func (r *integrationConnectionResource) Configure(_ context.Context, req resourc
 	r.client = client
 }
 
+func (r *integrationConnectionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(radarImportID...)
+}
+
+func (r *integrationConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRadarResource(ctx, r.client, req, resp)
+}
+
 func (r *integrationConnectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	modifiers.ModifyPlanForDefaultProjectChange(ctx, r.client.Config.ProjectID, req.State, req.Config, req.Plan, resp)
 }
@@ -131,11 +142,13 @@ func (r *integrationConnectionResource) Create(ctx context.Context, req resource
 	conn.SetID(types.StringValue(res.GetPayload().ID))
 	conn.SetProjectID(types.StringValue(projectID))
 	resp.Diagnostics.Append(resp.State.Set(ctx, conn)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *integrationConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	conn, diags := r.GetConnectionFromState(ctx, req.State)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -14,14 +14,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 
 	service "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-radar/preview/2023-05-01/client/integration_subscription_service"
 )
 
 var (
-	_ resource.Resource              = &integrationSubscriptionResource{}
-	_ resource.ResourceWithConfigure = &integrationSubscriptionResource{}
+	_ resource.Resource                = &integrationSubscriptionResource{}
+	_ resource.ResourceWithConfigure   = &integrationSubscriptionResource{}
+	_ resource.ResourceWithImportState = &integrationSubscriptionResource{}
+	_ resource.ResourceWithIdentity    = &integrationSubscriptionResource{}
 )
 
 // integrationSubscriptionResource is an implementation for configuring specific types of integration subscriptions.
@@ -73,6 +76,14 @@ func (r *integrationSubscriptionResource) Configure(_ context.Context, req resou
 	r.client = client
 }
 
+func (r *integrationSubscriptionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(radarImportID...)
+}
+
+func (r *integrationSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRadarResource(ctx, r.client, req, resp)
+}
+
 func (r *integrationSubscriptionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	modifiers.ModifyPlanForDefaultProjectChange(ctx, r.client.Config.ProjectID, req.State, req.Config, req.Plan, resp)
 }
@@ -120,11 +131,13 @@ func (r *integrationSubscriptionResource) Create(ctx context.Context, req resour
 	subscription.SetID(types.StringValue(res.GetPayload().ID))
 	subscription.SetProjectID(types.StringValue(projectID))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &subscription)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *integrationSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	subscription, diags := r.GetSubscriptionFromState(ctx, req.State)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -18,14 +18,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 
 	service "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-radar/preview/2023-05-01/client/data_source_registration_service"
 )
 
 var (
-	_ resource.Resource              = &radarSourceResource{}
-	_ resource.ResourceWithConfigure = &radarSourceResource{}
+	_ resource.Resource                = &radarSourceResource{}
+	_ resource.ResourceWithConfigure   = &radarSourceResource{}
+	_ resource.ResourceWithImportState = &radarSourceResource{}
+	_ resource.ResourceWithIdentity    = &radarSourceResource{}
 )
 
 var (
@@ -97,7 +100,9 @@ type radarSource interface {
 	GetID() types.String
 	SetID(types.String)
 	GetName() types.String
+	SetName(types.String)
 	GetConnectionURL() types.String
+	SetConnectionURL(types.String)
 	GetToken() types.String
 	GetDetectorType() types.String
 	GetTokenEnvVar() types.String
@@ -135,6 +140,14 @@ func (r *radarSourceResource) Configure(_ context.Context, req resource.Configur
 	r.client = client
 }
 
+func (r *radarSourceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(radarImportID...)
+}
+
+func (r *radarSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRadarResource(ctx, r.client, req, resp)
+}
+
 func (r *radarSourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	modifiers.ModifyPlanForDefaultProjectChange(ctx, r.client.Config.ProjectID, req.State, req.Config, req.Plan, resp)
 }
@@ -181,11 +194,13 @@ func (r *radarSourceResource) Create(ctx context.Context, req resource.CreateReq
 	src.SetID(types.StringValue(res.GetPayload().ID))
 	src.SetProjectID(types.StringValue(projectID))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &src)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *radarSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	src, diags := r.GetSourceFromState(ctx, req.State)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -214,8 +229,17 @@ func (r *radarSourceResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	// The only other state that could change related to this resource is the token, and for obvious reasons we don't
-	// return that in the read response. So we don't need to update the state here.
+	// The name and connection URL can't change without replacing the source, they are only missing from the state
+	// after an import. The only other state that could change related to this resource is the token, and for obvious
+	// reasons we don't return that in the read response.
+	if src.GetName().IsNull() {
+		src.SetName(types.StringValue(res.GetPayload().Name))
+	}
+	if src.GetConnectionURL().IsNull() && res.GetPayload().ConnectionURL != "" {
+		src.SetConnectionURL(types.StringValue(res.GetPayload().ConnectionURL))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &src)...)
 }
 
 func (r *radarSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
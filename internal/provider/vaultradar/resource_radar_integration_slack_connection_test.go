@@ -90,6 +90,13 @@ func TestRadarIntegrationSlackConnection(t *testing.T) {
 					}),
 				),
 			},
+			// IMPORT, the token is not returned by the API.
+			{
+				ResourceName:            "hcp_vault_radar_integration_slack_connection.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
 			// DELETE happens automatically.
 		},
 	})
@@ -121,6 +121,12 @@ func TestRadarIntegrationSlackSubscription(t *testing.T) {
 					resource.TestCheckResourceAttrSet("hcp_vault_radar_integration_slack_subscription.slack_subscription", "connection_id"),
 				),
 			},
+			// IMPORT
+			{
+				ResourceName:      "hcp_vault_radar_integration_slack_subscription.slack_subscription",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// DELETE happens automatically.
 		},
 	})
@@ -61,11 +61,11 @@ func radarResourceIAMSchema(binding bool) schema.Schema {
 }
 
 func NewRadarResourceIAMPolicyResource() resource.Resource {
-	return iampolicy.NewResourceIamPolicy("vault_radar_resource", radarResourceIAMSchema(false), "", newRadarResourceIAMPolicyUpdater)
+	return iampolicy.NewResourceIamPolicy("vault_radar_resource", radarResourceIAMSchema(false), "resource_name", newRadarResourceIAMPolicyUpdater)
 }
 
 func NewRadarResourceIAMBindingResource() resource.Resource {
-	return iampolicy.NewResourceIamBinding("vault_radar_resource", radarResourceIAMSchema(true), "", newRadarResourceIAMPolicyUpdater)
+	return iampolicy.NewResourceIamBinding("vault_radar_resource", radarResourceIAMSchema(true), "resource_name", newRadarResourceIAMPolicyUpdater)
 }
 
 type radarResourceIAMPolicyUpdater struct {
@@ -176,6 +176,8 @@ type appRolePushConfig struct {
 
 func (m *vaultDedicatedModel) GetConnectionURL() types.String { return m.VaultURL }
 
+func (m *vaultDedicatedModel) SetConnectionURL(url types.String) { m.VaultURL = url }
+
 func (m *vaultDedicatedModel) SetFeatures(feature map[string]interface{}) {
 	if val, ok := feature["copy_secrets"]; ok && val != nil {
 		m.AccessReadWrite = types.BoolValue(true)
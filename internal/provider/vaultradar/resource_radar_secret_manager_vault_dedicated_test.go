@@ -79,6 +79,13 @@ func TestRadarSecretManagerVaultDedicatedResource(t *testing.T) {
 					resource.TestCheckResourceAttr("hcp_vault_radar_secret_manager_vault_dedicated.example", "kubernetes.role_name", roleName),
 				),
 			},
+			// IMPORT, the auth details are not returned by the API.
+			{
+				ResourceName:            "hcp_vault_radar_secret_manager_vault_dedicated.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"kubernetes"},
+			},
 			// DELETE happens automatically.
 		},
 	})
@@ -83,8 +83,13 @@ type githubCloudSourceModel struct {
 
 func (d *githubCloudSourceModel) GetName() types.String { return d.GitHubOrganization }
 
+func (d *githubCloudSourceModel) SetName(name types.String) { d.GitHubOrganization = name }
+
 func (d *githubCloudSourceModel) GetConnectionURL() types.String { return basetypes.NewStringNull() }
 
+// SetConnectionURL is a no-op, GitHub Cloud sources don't have a connection URL.
+func (d *githubCloudSourceModel) SetConnectionURL(types.String) {}
+
 func (d *githubCloudSourceModel) GetToken() types.String { return d.Token }
 
 func (d *githubCloudSourceModel) GetTokenEnvVar() types.String { return d.TokenEnvVar }
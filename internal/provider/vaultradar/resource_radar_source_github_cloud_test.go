@@ -71,6 +71,13 @@ func TestRadarSourceGitHubCloud(t *testing.T) {
 					}),
 				),
 			},
+			// IMPORT, the token is not returned by the API.
+			{
+				ResourceName:            "hcp_vault_radar_source_github_cloud.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
 			// DELETE happens automatically.
 		},
 	})
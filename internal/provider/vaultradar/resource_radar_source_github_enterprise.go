@@ -97,8 +97,12 @@ type githubEnterpriseSourceModel struct {
 
 func (d *githubEnterpriseSourceModel) GetName() types.String { return d.GitHubOrganization }
 
+func (d *githubEnterpriseSourceModel) SetName(name types.String) { d.GitHubOrganization = name }
+
 func (d *githubEnterpriseSourceModel) GetConnectionURL() types.String { return d.DomainName }
 
+func (d *githubEnterpriseSourceModel) SetConnectionURL(url types.String) { d.DomainName = url }
+
 func (d *githubEnterpriseSourceModel) GetToken() types.String { return d.Token }
 
 func (d *githubEnterpriseSourceModel) GetTokenEnvVar() types.String { return d.TokenEnvVar }
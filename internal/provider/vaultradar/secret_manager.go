@@ -18,14 +18,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 
 	service "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-radar/preview/2023-05-01/client/secret_manager_service"
 )
 
 var (
-	_ resource.Resource              = &secretManagerResource{}
-	_ resource.ResourceWithConfigure = &secretManagerResource{}
+	_ resource.Resource                = &secretManagerResource{}
+	_ resource.ResourceWithConfigure   = &secretManagerResource{}
+	_ resource.ResourceWithImportState = &secretManagerResource{}
+	_ resource.ResourceWithIdentity    = &secretManagerResource{}
 )
 
 var (
@@ -87,6 +90,7 @@ type secretManager interface {
 	GetID() types.String
 	SetID(types.String)
 	GetConnectionURL() types.String
+	SetConnectionURL(types.String)
 	GetTokenLocation() (types.String, error)
 	GetAuthMethod() types.String
 	SetFeatures(map[string]interface{})
@@ -122,6 +126,14 @@ func (r *secretManagerResource) Configure(_ context.Context, req resource.Config
 	r.client = client
 }
 
+func (r *secretManagerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(radarImportID...)
+}
+
+func (r *secretManagerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRadarResource(ctx, r.client, req, resp)
+}
+
 func (r *secretManagerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	modifiers.ModifyPlanForDefaultProjectChange(ctx, r.client.Config.ProjectID, req.State, req.Config, req.Plan, resp)
 }
@@ -190,11 +202,13 @@ func (r *secretManagerResource) Create(ctx context.Context, req resource.CreateR
 	sm.SetID(types.StringValue(res.GetPayload().ID))
 	sm.SetProjectID(types.StringValue(projectID))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &sm)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *secretManagerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	sm, diags := r.GetSecretManagerFromState(ctx, req.State)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -222,6 +236,12 @@ func (r *secretManagerResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	// The connection URL can't change without replacing the secret manager, it is only missing from the state after an
+	// import. The auth details are not returned by the API.
+	if sm.GetConnectionURL().IsNull() {
+		sm.SetConnectionURL(types.StringValue(res.GetPayload().ConnectionURL))
+	}
+
 	// Read the details for the secret manager features, incase it changed outside of Terraform.
 	features := res.GetPayload().Features
 	tflog.Debug(ctx, fmt.Sprintf("Read of radar secret manager features: %+v type:%T ", features, features))
@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -26,6 +27,7 @@ type DataSourceVaultSecretsSecretModel struct {
 	OrgID       types.String `tfsdk:"organization_id"`
 	SecretName  types.String `tfsdk:"secret_name"`
 	SecretValue types.String `tfsdk:"secret_value"`
+	Version     types.Int64  `tfsdk:"version"`
 }
 
 func NewVaultSecretsSecretDataSource() datasource.DataSource {
@@ -38,7 +40,8 @@ func (d *DataSourceVaultSecretsSecret) Metadata(_ context.Context, req datasourc
 
 func (d *DataSourceVaultSecretsSecret) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "The Vault Secrets secret data source retrieves a singular secret and its latest version.",
+		MarkdownDescription: "The Vault Secrets secret data source retrieves a singular secret and its latest version, " +
+			"or the version given by `version`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -52,6 +55,12 @@ func (d *DataSourceVaultSecretsSecret) Schema(_ context.Context, _ datasource.Sc
 				Description: "The name of the Vault Secrets secret.",
 				Required:    true,
 			},
+			"version": schema.Int64Attribute{
+				Description: "The version of the secret to read. Defaults to the latest version. Pinning a previous " +
+					"version allows rolling consumers back to it.",
+				Optional: true,
+				Computed: true,
+			},
 			"secret_value": schema.StringAttribute{
 				Description: "The secret value corresponding to the secret name input.",
 				Computed:    true,
@@ -103,7 +112,13 @@ func (d *DataSourceVaultSecretsSecret) Read(ctx context.Context, req datasource.
 		ProjectID:      client.Config.ProjectID,
 	}
 
-	openSecret, err := clients.OpenVaultSecretsAppSecret(ctx, client, loc, data.AppName.ValueString(), data.SecretName.ValueString())
+	var openSecret *secretmodels.Secrets20231128OpenSecret
+	var err error
+	if data.Version.IsNull() {
+		openSecret, err = clients.OpenVaultSecretsAppSecret(ctx, client, loc, data.AppName.ValueString(), data.SecretName.ValueString())
+	} else {
+		openSecret, err = clients.OpenVaultSecretsAppSecretVersion(ctx, client, loc, data.AppName.ValueString(), data.SecretName.ValueString(), data.Version.ValueInt64())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(err.Error(), "Unable to open secret")
 		return
@@ -112,10 +127,13 @@ func (d *DataSourceVaultSecretsSecret) Read(ctx context.Context, req datasource.
 	// NOTE: for backwards compatibility purposes, if the secret is not a static secret (a string)
 	// encode the complex secret as a JSON string
 	var secretValue string
+	version := types.Int64Null()
 	switch {
 	case openSecret.StaticVersion != nil:
 		secretValue = openSecret.StaticVersion.Value
+		version = types.Int64Value(openSecret.StaticVersion.Version)
 	case openSecret.RotatingVersion != nil:
+		version = types.Int64Value(openSecret.RotatingVersion.Version)
 		secretData, err := json.Marshal(openSecret.RotatingVersion.Values)
 		if err != nil {
 			resp.Diagnostics.AddError(err.Error(), "could not encode rotating secret as json")
@@ -147,6 +165,7 @@ func (d *DataSourceVaultSecretsSecret) Read(ctx context.Context, req datasource.
 
 	data.ID = data.AppName
 	data.SecretValue = types.StringValue(secretValue)
+	data.Version = version
 	data.OrgID = types.StringValue(client.Config.OrganizationID)
 	data.ProjectID = types.StringValue(client.Config.ProjectID)
 
@@ -38,6 +38,20 @@ func TestAcc_dataSourceVaultSecretsSecret(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceAddress, "organization_id"),
 					resource.TestCheckResourceAttrSet(dataSourceAddress, "project_id"),
 					resource.TestCheckResourceAttr(dataSourceAddress, "secret_value", testSecretValue),
+					resource.TestCheckResourceAttr(dataSourceAddress, "version", "2"),
+				),
+			},
+			// Pinning the version reads a previous value
+			{
+				Config: fmt.Sprintf(`
+					data "hcp_vault_secrets_secret" "foo" {
+						app_name    = %q
+						secret_name = %q
+						version     = 1
+					}`, testAppName, testSecretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceAddress, "secret_value", "this shouldn't show up!"),
+					resource.TestCheckResourceAttr(dataSourceAddress, "version", "1"),
 				),
 			},
 		},
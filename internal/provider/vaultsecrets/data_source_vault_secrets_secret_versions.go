@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+type DataSourceVaultSecretsSecretVersions struct {
+	client *clients.Client
+}
+
+type DataSourceVaultSecretsSecretVersionsModel struct {
+	ID            types.String    `tfsdk:"id"`
+	AppName       types.String    `tfsdk:"app_name"`
+	ProjectID     types.String    `tfsdk:"project_id"`
+	OrgID         types.String    `tfsdk:"organization_id"`
+	SecretName    types.String    `tfsdk:"secret_name"`
+	SecretType    types.String    `tfsdk:"secret_type"`
+	LatestVersion types.Int64     `tfsdk:"latest_version"`
+	Versions      []secretVersion `tfsdk:"versions"`
+}
+
+type secretVersion struct {
+	Version   types.Int64  `tfsdk:"version"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	CreatedBy types.String `tfsdk:"created_by"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	RevokedAt types.String `tfsdk:"revoked_at"`
+}
+
+func NewVaultSecretsSecretVersionsDataSource() datasource.DataSource {
+	return &DataSourceVaultSecretsSecretVersions{}
+}
+
+func (d *DataSourceVaultSecretsSecretVersions) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_secrets_secret_versions"
+}
+
+func (d *DataSourceVaultSecretsSecretVersions) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Vault Secrets secret versions data source lists the versions of a secret, without their values. " +
+			"A version can then be read with the `version` argument of the `hcp_vault_secrets_secret` data source.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this resource.",
+			},
+			"app_name": schema.StringAttribute{
+				Description: "The name of the Vault Secrets application.",
+				Required:    true,
+			},
+			"secret_name": schema.StringAttribute{
+				Description: "The name of the Vault Secrets secret.",
+				Required:    true,
+			},
+			"secret_type": schema.StringAttribute{
+				Description: "The type of the secret.",
+				Computed:    true,
+			},
+			"latest_version": schema.Int64Attribute{
+				Description: "The latest version of the secret.",
+				Computed:    true,
+			},
+			"versions": schema.ListNestedAttribute{
+				Description: "The versions of the secret, from oldest to latest.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.Int64Attribute{
+							Description: "The version number.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The time the version was created, in RFC3339 format.",
+							Computed:    true,
+						},
+						"created_by": schema.StringAttribute{
+							Description: "The email, or name, of the principal that created the version.",
+							Computed:    true,
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "The time the version of a rotating secret expires, in RFC3339 format.",
+							Computed:    true,
+						},
+						"revoked_at": schema.StringAttribute{
+							Description: "The time the version of a rotating secret was revoked, in RFC3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the HCP organization where the Vault Secrets app is located.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the Vault Secrets app is located.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *DataSourceVaultSecretsSecretVersions) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *DataSourceVaultSecretsSecretVersions) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceVaultSecretsSecretVersionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.client
+	if client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HCP Client",
+			"Expected configured HCP client. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: client.Config.OrganizationID,
+		ProjectID:      client.Config.ProjectID,
+	}
+
+	res, err := clients.ListVaultSecretsAppSecretVersions(ctx, client, loc, data.AppName.ValueString(), data.SecretName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list secret versions", err.Error())
+		return
+	}
+
+	versions := []secretVersion{}
+	if res.StaticVersions != nil {
+		for _, v := range res.StaticVersions.Versions {
+			versions = append(versions, secretVersion{
+				Version:   types.Int64Value(v.Version),
+				CreatedAt: versionTime(v.CreatedAt),
+				CreatedBy: principalName(v.CreatedBy),
+				ExpiresAt: types.StringNull(),
+				RevokedAt: types.StringNull(),
+			})
+		}
+	}
+	if res.RotatingVersions != nil {
+		for _, v := range res.RotatingVersions.Versions {
+			versions = append(versions, secretVersion{
+				Version:   types.Int64Value(v.Version),
+				CreatedAt: versionTime(v.CreatedAt),
+				CreatedBy: principalName(v.CreatedBy),
+				ExpiresAt: versionTime(v.ExpiresAt),
+				RevokedAt: versionTime(v.RevokedAt),
+			})
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version.ValueInt64() < versions[j].Version.ValueInt64()
+	})
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.AppName.ValueString(), data.SecretName.ValueString()))
+	data.SecretType = types.StringValue(res.Type)
+	data.LatestVersion = types.Int64Null()
+	if len(versions) > 0 {
+		data.LatestVersion = versions[len(versions)-1].Version
+	}
+	data.Versions = versions
+	data.OrgID = types.StringValue(client.Config.OrganizationID)
+	data.ProjectID = types.StringValue(client.Config.ProjectID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// versionTime formats a version timestamp, omitted by the API when unset.
+func versionTime(t strfmt.DateTime) types.String {
+	if time.Time(t).IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Time(t).Format(time.RFC3339))
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+)
+
+func TestAcc_dataSourceVaultSecretsSecretVersions(t *testing.T) {
+	testAppName := generateRandomSlug()
+	dataSourceAddress := "data.hcp_vault_secrets_secret_versions.foo"
+	testSecretName := "secret_versions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					createTestApp(t, testAppName)
+
+					createTestAppSecret(t, testAppName, testSecretName, "first")
+					createTestAppSecret(t, testAppName, testSecretName, "second")
+				},
+				Config: fmt.Sprintf(`
+					data "hcp_vault_secrets_secret_versions" "foo" {
+						app_name    = %q
+						secret_name = %q
+					}`, testAppName, testSecretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceAddress, "secret_type", "kv"),
+					resource.TestCheckResourceAttr(dataSourceAddress, "latest_version", "2"),
+					resource.TestCheckResourceAttr(dataSourceAddress, "versions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceAddress, "versions.0.version", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceAddress, "versions.0.created_at"),
+					resource.TestCheckResourceAttrSet(dataSourceAddress, "versions.0.created_by"),
+					resource.TestCheckResourceAttr(dataSourceAddress, "versions.1.version", "2"),
+				),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			deleteTestAppSecret(t, testAppName, testSecretName)
+			deleteTestApp(t, testAppName)
+			return nil
+		},
+	})
+}
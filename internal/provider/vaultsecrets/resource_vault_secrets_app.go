@@ -14,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
@@ -33,6 +33,7 @@ type App struct {
 	OrganizationID types.String `tfsdk:"organization_id"`
 	ResourceName   types.String `tfsdk:"resource_name"`
 	SyncNames      types.Set    `tfsdk:"sync_names"`
+	SecretCount    types.Int64  `tfsdk:"secret_count"`
 
 	syncNames []string `tfsdk:"-"`
 }
@@ -41,6 +42,7 @@ var _ resource.Resource = &resourceVaultSecretsApp{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsApp{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsApp{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsApp{}
+var _ resource.ResourceWithIdentity = &resourceVaultSecretsApp{}
 
 func NewVaultSecretsAppResource() resource.Resource {
 	return &resourceVaultSecretsApp{}
@@ -104,7 +106,12 @@ func (r *resourceVaultSecretsApp) Schema(_ context.Context, _ resource.SchemaReq
 						slugValidator,
 					),
 				},
-			}},
+			},
+			"secret_count": schema.Int64Attribute{
+				Description: "The number of secrets in the app.",
+				Computed:    true,
+			},
+		},
 	}
 }
 
@@ -151,9 +158,12 @@ func (r *resourceVaultSecretsApp) Create(ctx context.Context, req resource.Creat
 		}
 		return response.Payload.App, nil
 	})...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourceVaultSecretsApp) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
 	resp.Diagnostics.Append(decorateOperation[*App](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		app, ok := i.(*App)
 		if !ok {
@@ -220,10 +230,12 @@ func (r *resourceVaultSecretsApp) Delete(ctx context.Context, req resource.Delet
 	})...)
 }
 
+func (r *resourceVaultSecretsApp) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(vaultSecretsIdentity("app_name")...)
+}
+
 func (r *resourceVaultSecretsApp) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), r.client.Config.OrganizationID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), r.client.Config.ProjectID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_name"), req.ID)...)
+	importVaultSecretsState(ctx, r.client, req, resp, "app_name")
 }
 
 var _ hvsResource = &App{}
@@ -254,6 +266,7 @@ func (a *App) fromModel(_ context.Context, orgID, projID string, model any) diag
 	a.ProjectID = types.StringValue(projID)
 	a.ID = types.StringValue(appModel.ResourceID)
 	a.ResourceName = types.StringValue(appModel.ResourceName)
+	a.SecretCount = types.Int64Value(int64(appModel.SecretCount))
 
 	var syncs []attr.Value
 	for _, c := range appModel.SyncNames {
@@ -5,7 +5,9 @@ package vaultsecrets
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/resource_service"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
@@ -45,11 +47,39 @@ func vaultSecretsAppIAMSchema(binding bool) schema.Schema {
 }
 
 func NewVaultSecretsAppIAMPolicyResource() resource.Resource {
-	return iampolicy.NewResourceIamPolicy("vault_secrets_app", vaultSecretsAppIAMSchema(false), "resource_name", newVaultSecretsAppResourceIAMPolicyUpdater)
+	return iampolicy.NewResourceIamPolicy("vault_secrets_app", vaultSecretsAppIAMSchema(false), "resource_name", newVaultSecretsAppResourceIAMPolicyUpdater,
+		iampolicy.WithImportIDFunc(vaultSecretsAppResourceName))
 }
 
 func NewVaultSecretsAppIAMBindingResource() resource.Resource {
-	return iampolicy.NewResourceIamBinding("vault_secrets_app", vaultSecretsAppIAMSchema(true), "resource_name", newVaultSecretsAppResourceIAMPolicyUpdater)
+	return iampolicy.NewResourceIamBinding("vault_secrets_app", vaultSecretsAppIAMSchema(true), "resource_name", newVaultSecretsAppResourceIAMPolicyUpdater,
+		iampolicy.WithImportIDFunc(vaultSecretsAppResourceName))
+}
+
+// vaultSecretsAppResourceName converts an import ID in the format
+// [project_id/]app_name into the app's resource name. Resource names are
+// accepted as is.
+func vaultSecretsAppResourceName(_ context.Context, id string, client *clients.Client) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if strings.HasPrefix(id, "secrets/") {
+		return id, diags
+	}
+
+	projectID := client.Config.ProjectID
+	parts := strings.Split(id, "/")
+	if len(parts) == 2 {
+		projectID = parts[0]
+		parts = parts[1:]
+	}
+	if len(parts) != 1 || projectID == "" || parts[0] == "" {
+		diags.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the format app_name, project_id/app_name or secrets/project/<project ID>/app/<app Name>, got: %q", id),
+		)
+		return "", diags
+	}
+
+	return fmt.Sprintf("secrets/project/%s/app/%s", projectID, parts[0]), diags
 }
 
 type vaultSecretsAppResourceIAMPolicyUpdater struct {
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
 )
 
@@ -40,6 +41,13 @@ func TestAccVaultSecretsAppIamPolicyResource(t *testing.T) {
 					resource.TestCheckResourceAttrPair("hcp_vault_secrets_app_iam_policy.example", "policy_data", "data.hcp_iam_policy.example", "policy_data"),
 				),
 			},
+			{
+				ResourceName:                         "hcp_vault_secrets_app_iam_policy.example",
+				ImportState:                          true,
+				ImportStateId:                        appName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "resource_name",
+			},
 		},
 	})
 }
@@ -62,6 +70,19 @@ func TestAccVaultSecretsAppIamBindingResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("hcp_vault_secrets_app_iam_binding.example", "role"),
 				),
 			},
+			{
+				ResourceName: "hcp_vault_secrets_app_iam_binding.example",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["hcp_vault_secrets_app_iam_binding.example"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state")
+					}
+					return fmt.Sprintf("%s/%s:%s:%s", projectID, appName, roleName, rs.Primary.Attributes["principal_id"]), nil
+				},
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "resource_name",
+			},
 		},
 	})
 }
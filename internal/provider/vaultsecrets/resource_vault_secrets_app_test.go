@@ -167,6 +167,7 @@ func appCheckFunc(appName, description string, syncNames []string) []resource.Te
 		resource.TestCheckResourceAttrSet("hcp_vault_secrets_app.acc_test_app", "organization_id"),
 		resource.TestCheckResourceAttrSet("hcp_vault_secrets_app.acc_test_app", "id"),
 		resource.TestCheckResourceAttrSet("hcp_vault_secrets_app.acc_test_app", "resource_name"),
+		resource.TestCheckResourceAttr("hcp_vault_secrets_app.acc_test_app", "secret_count", "0"),
 		resource.TestCheckResourceAttr("hcp_vault_secrets_app.acc_test_app", "project_id", os.Getenv("HCP_PROJECT_ID")),
 		resource.TestCheckResourceAttr("hcp_vault_secrets_app.acc_test_app", "app_name", appName),
 		resource.TestCheckResourceAttr("hcp_vault_secrets_app.acc_test_app", "description", description)}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
+	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 	"golang.org/x/exp/maps"
 )
@@ -75,6 +77,8 @@ type gcpImpersonateServiceAccount struct {
 var _ resource.Resource = &resourceVaultSecretsDynamicSecret{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsDynamicSecret{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsDynamicSecret{}
+var _ resource.ResourceWithImportState = &resourceVaultSecretsDynamicSecret{}
+var _ resource.ResourceWithIdentity = &resourceVaultSecretsDynamicSecret{}
 
 func NewVaultSecretsDynamicSecretResource() resource.Resource {
 	return &resourceVaultSecretsDynamicSecret{}
@@ -151,12 +155,24 @@ func (r *resourceVaultSecretsDynamicSecret) ModifyPlan(ctx context.Context, req
 }
 
 func (r *resourceVaultSecretsDynamicSecret) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
 	resp.Diagnostics.Append(decorateOperation[*DynamicSecret](ctx, r.client, &resp.State, req.State.Get, "reading", func(s hvsResource) (any, error) {
 		secret, ok := s.(*DynamicSecret)
 		if !ok {
 			return nil, fmt.Errorf(invalidSecretTypeErrorFmt, s)
 		}
 
+		// The provider is only unknown right after an import, the configuration
+		// of the secret is read through the generic operation first.
+		if secret.SecretProvider.IsNull() {
+			model, err := getAppDynamicSecret(ctx, r.client.VaultSecrets, secret)
+			if err != nil || model == nil {
+				return nil, err
+			}
+			secret.fromImportedModel(model)
+		}
+
 		dynamicSecretImpl, ok := dynamicSecretsImpl[Provider(secret.SecretProvider.ValueString())]
 		if !ok {
 			return nil, fmt.Errorf(unsupportedProviderErrorFmt, maps.Keys(dynamicSecretsImpl), secret.SecretProvider.ValueString())
@@ -165,6 +181,14 @@ func (r *resourceVaultSecretsDynamicSecret) Read(ctx context.Context, req resour
 	})...)
 }
 
+func (r *resourceVaultSecretsDynamicSecret) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(vaultSecretsIdentity("app_name", "name")...)
+}
+
+func (r *resourceVaultSecretsDynamicSecret) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importVaultSecretsState(ctx, r.client, req, resp, "app_name", "name")
+}
+
 func (r *resourceVaultSecretsDynamicSecret) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	resp.Diagnostics.Append(decorateOperation[*DynamicSecret](ctx, r.client, &resp.State, req.Plan.Get, "creating", func(s hvsResource) (any, error) {
 		secret, ok := s.(*DynamicSecret)
@@ -178,6 +202,7 @@ func (r *resourceVaultSecretsDynamicSecret) Create(ctx context.Context, req reso
 		}
 		return dynamicSecretImpl.create(ctx, r.client.VaultSecrets, secret)
 	})...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourceVaultSecretsDynamicSecret) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -237,3 +262,46 @@ func (s *DynamicSecret) fromModel(_ context.Context, orgID, projID string, _ any
 
 	return diags
 }
+
+// fromImportedModel sets the configuration of an imported secret, which is
+// otherwise only known from the Terraform configuration.
+func (s *DynamicSecret) fromImportedModel(model *secretmodels.Secrets20231128DynamicSecret) {
+	s.SecretProvider = types.StringValue(model.Provider)
+	s.IntegrationName = types.StringValue(model.IntegrationName)
+	if model.DefaultTTL != "" {
+		s.DefaultTTL = types.StringValue(model.DefaultTTL)
+	}
+
+	switch {
+	case model.AwsAssumeRoleDetails != nil:
+		s.AWSAssumeRole = &awsAssumeRole{
+			IAMRoleARN: types.StringValue(model.AwsAssumeRoleDetails.RoleArn),
+		}
+	case model.GcpServiceAccountImpersonationDetails != nil:
+		s.GCPImpersonateServiceAccount = &gcpImpersonateServiceAccount{
+			ServiceAccountEmail: types.StringValue(model.GcpServiceAccountImpersonationDetails.ServiceAccountEmail),
+		}
+	}
+}
+
+// getAppDynamicSecret reads any type of dynamic secret. It returns nil if the
+// secret does not exist.
+func getAppDynamicSecret(ctx context.Context, client secret_service.ClientService, secret *DynamicSecret) (*secretmodels.Secrets20231128DynamicSecret, error) {
+	response, err := client.GetAppDynamicSecret(
+		secret_service.NewGetAppDynamicSecretParamsWithContext(ctx).
+			WithOrganizationID(secret.OrganizationID.ValueString()).
+			WithProjectID(secret.ProjectID.ValueString()).
+			WithAppName(secret.AppName.ValueString()).
+			WithName(secret.Name.ValueString()),
+		nil)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if response == nil || response.Payload == nil {
+		return nil, nil
+	}
+	return response.Payload.Secret, nil
+}
@@ -59,6 +59,14 @@ func testAccVaultSecretsResourceDynamicSecretAWS(t *testing.T) {
 					awsCheckFunc(appName, secretName2, integrationName, ttl2, roleARN)...,
 				),
 			},
+			// Importing the secret reads its whole configuration
+			{
+				ResourceName:                         "hcp_vault_secrets_dynamic_secret.acc_test_aws",
+				ImportState:                          true,
+				ImportStateId:                        fmt.Sprintf("%s/%s", appName, secretName2),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
 			// Deleting the secret out of band causes a recreation
 			{
 				PreConfig: func() {
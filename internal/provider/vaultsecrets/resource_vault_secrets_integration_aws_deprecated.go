@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 	"golang.org/x/exp/maps"
 )
@@ -57,6 +58,7 @@ var _ resource.Resource = &resourceVaultSecretsIntegrationAWS{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsIntegrationAWS{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsIntegrationAWS{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsIntegrationAWS{}
+var _ resource.ResourceWithIdentity = &resourceVaultSecretsIntegrationAWS{}
 
 func NewVaultSecretsIntegrationAWSResource() resource.Resource {
 	return &resourceVaultSecretsIntegrationAWS{}
@@ -118,6 +120,7 @@ func (r *resourceVaultSecretsIntegrationAWS) Schema(_ context.Context, _ resourc
 
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets AWS integration resource manages an AWS integration.",
+		DeprecationMessage:  deprecatedIntegrationAWS.deprecationMessage(),
 		Attributes:          attributes,
 	}
 }
@@ -142,6 +145,8 @@ func (r *resourceVaultSecretsIntegrationAWS) ModifyPlan(ctx context.Context, req
 }
 
 func (r *resourceVaultSecretsIntegrationAWS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationAWS](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationAWS)
 		if !ok {
@@ -188,6 +193,7 @@ func (r *resourceVaultSecretsIntegrationAWS) Create(ctx context.Context, req res
 		}
 		return response.Payload.Integration, nil
 	})...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourceVaultSecretsIntegrationAWS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -236,12 +242,14 @@ func (r *resourceVaultSecretsIntegrationAWS) Delete(ctx context.Context, req res
 	})...)
 }
 
+func (r *resourceVaultSecretsIntegrationAWS) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(vaultSecretsIdentity("name")...)
+}
+
 func (r *resourceVaultSecretsIntegrationAWS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// The Vault Secrets API does not return sensitive values like the secret access key, so they will be initialized to an empty value
 	// It means the first plan/apply after a successful import will always show a diff for the secret access key
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), r.client.Config.OrganizationID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), r.client.Config.ProjectID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	importVaultSecretsState(ctx, r.client, req, resp, "name")
 }
 
 var _ hvsResource = &IntegrationAWS{}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 	"golang.org/x/exp/maps"
 )
@@ -59,6 +60,7 @@ var _ resource.Resource = &resourceVaultSecretsIntegrationAzure{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsIntegrationAzure{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsIntegrationAzure{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsIntegrationAzure{}
+var _ resource.ResourceWithIdentity = &resourceVaultSecretsIntegrationAzure{}
 
 func NewVaultSecretsIntegrationAzureResource() resource.Resource {
 	return &resourceVaultSecretsIntegrationAzure{}
@@ -127,6 +129,7 @@ func (r *resourceVaultSecretsIntegrationAzure) Schema(_ context.Context, _ resou
 
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets Azure integration resource manages an Azure integration.",
+		DeprecationMessage:  deprecatedIntegrationAzure.deprecationMessage(),
 		Attributes:          attributes,
 	}
 }
@@ -151,6 +154,8 @@ func (r *resourceVaultSecretsIntegrationAzure) ModifyPlan(ctx context.Context, r
 }
 
 func (r *resourceVaultSecretsIntegrationAzure) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationAzure](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationAzure)
 		if !ok {
@@ -197,6 +202,7 @@ func (r *resourceVaultSecretsIntegrationAzure) Create(ctx context.Context, req r
 		}
 		return response.Payload.Integration, nil
 	})...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourceVaultSecretsIntegrationAzure) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -245,12 +251,14 @@ func (r *resourceVaultSecretsIntegrationAzure) Delete(ctx context.Context, req r
 	})...)
 }
 
+func (r *resourceVaultSecretsIntegrationAzure) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(vaultSecretsIdentity("name")...)
+}
+
 func (r *resourceVaultSecretsIntegrationAzure) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// The Vault Secrets API does not return sensitive values like the client secret, so they will be initialized to an empty value
 	// It means the first plan/apply after a successful import will always show a diff for the client secret.
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), r.client.Config.OrganizationID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), r.client.Config.ProjectID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	importVaultSecretsState(ctx, r.client, req, resp, "name")
 }
 
 var _ hvsResource = &IntegrationAzure{}
@@ -11,7 +11,6 @@ import (
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,6 +18,7 @@ import (
 	"golang.org/x/exp/maps"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
@@ -49,6 +49,7 @@ var _ resource.Resource = &resourceVaultSecretsIntegrationConfluent{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsIntegrationConfluent{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsIntegrationConfluent{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsIntegrationConfluent{}
+var _ resource.ResourceWithIdentity = &resourceVaultSecretsIntegrationConfluent{}
 
 func NewVaultSecretsIntegrationsConfluentResource() resource.Resource {
 	return &resourceVaultSecretsIntegrationConfluent{}
@@ -86,6 +87,7 @@ func (r *resourceVaultSecretsIntegrationConfluent) Schema(_ context.Context, _ r
 
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets Confluent integration resource manages an Confluent integration.",
+		DeprecationMessage:  deprecatedIntegrationConfluent.deprecationMessage(),
 		Attributes:          attributes,
 	}
 }
@@ -110,6 +112,8 @@ func (r *resourceVaultSecretsIntegrationConfluent) ModifyPlan(ctx context.Contex
 }
 
 func (r *resourceVaultSecretsIntegrationConfluent) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationConfluent](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationConfluent)
 		if !ok {
@@ -155,6 +159,7 @@ func (r *resourceVaultSecretsIntegrationConfluent) Create(ctx context.Context, r
 		}
 		return response.Payload.Integration, nil
 	})...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourceVaultSecretsIntegrationConfluent) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -202,12 +207,14 @@ func (r *resourceVaultSecretsIntegrationConfluent) Delete(ctx context.Context, r
 	})...)
 }
 
+func (r *resourceVaultSecretsIntegrationConfluent) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(vaultSecretsIdentity("name")...)
+}
+
 func (r *resourceVaultSecretsIntegrationConfluent) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// The Vault Secrets API does not return sensitive values like the secret access key, so they will be initialized to an empty value
 	// It means the first plan/apply after a successful import will always show a diff for the secret access key
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), r.client.Config.OrganizationID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), r.client.Config.ProjectID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	importVaultSecretsState(ctx, r.client, req, resp, "name")
 }
 
 var _ hvsResource = &IntegrationConfluent{}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 	"golang.org/x/exp/maps"
 )
@@ -58,6 +59,7 @@ var _ resource.Resource = &resourceVaultSecretsIntegrationGCP{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsIntegrationGCP{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsIntegrationGCP{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsIntegrationGCP{}
+var _ resource.ResourceWithIdentity = &resourceVaultSecretsIntegrationGCP{}
 
 func NewVaultSecretsIntegrationGCPResource() resource.Resource {
 	return &resourceVaultSecretsIntegrationGCP{}
@@ -122,6 +124,7 @@ func (r *resourceVaultSecretsIntegrationGCP) Schema(_ context.Context, _ resourc
 
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets GCP integration resource manages an GCP integration.",
+		DeprecationMessage:  deprecatedIntegrationGCP.deprecationMessage(),
 		Attributes:          attributes,
 	}
 }
@@ -146,6 +149,8 @@ func (r *resourceVaultSecretsIntegrationGCP) ModifyPlan(ctx context.Context, req
 }
 
 func (r *resourceVaultSecretsIntegrationGCP) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationGCP](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationGCP)
 		if !ok {
@@ -192,6 +197,7 @@ func (r *resourceVaultSecretsIntegrationGCP) Create(ctx context.Context, req res
 		}
 		return response.Payload.Integration, nil
 	})...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourceVaultSecretsIntegrationGCP) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -240,12 +246,14 @@ func (r *resourceVaultSecretsIntegrationGCP) Delete(ctx context.Context, req res
 	})...)
 }
 
+func (r *resourceVaultSecretsIntegrationGCP) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(vaultSecretsIdentity("name")...)
+}
+
 func (r *resourceVaultSecretsIntegrationGCP) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// The Vault Secrets API does not return sensitive values like the service account key, so they will be initialized to an empty value
 	// It means the first plan/apply after a successful import will always show a diff for the secret account key
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), r.client.Config.OrganizationID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), r.client.Config.ProjectID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	importVaultSecretsState(ctx, r.client, req, resp, "name")
 }
 
 var _ hvsResource = &IntegrationGCP{}
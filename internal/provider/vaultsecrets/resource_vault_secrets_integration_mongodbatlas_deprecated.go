@@ -11,12 +11,12 @@ import (
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 	"golang.org/x/exp/maps"
 )
@@ -48,6 +48,7 @@ var _ resource.Resource = &resourceVaultSecretsIntegrationMongoDBAtlas{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsIntegrationMongoDBAtlas{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsIntegrationMongoDBAtlas{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsIntegrationMongoDBAtlas{}
+var _ resource.ResourceWithIdentity = &resourceVaultSecretsIntegrationMongoDBAtlas{}
 
 func NewVaultSecretsIntegrationMongoDBAtlasResource() resource.Resource {
 	return &resourceVaultSecretsIntegrationMongoDBAtlas{}
@@ -85,6 +86,7 @@ func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Schema(_ context.Context,
 
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets MongoDB Atlas integration resource manages an MongoDB Atlas integration.",
+		DeprecationMessage:  deprecatedIntegrationMongoDBAtlas.deprecationMessage(),
 		Attributes:          attributes,
 	}
 }
@@ -109,6 +111,8 @@ func (r *resourceVaultSecretsIntegrationMongoDBAtlas) ModifyPlan(ctx context.Con
 }
 
 func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationMongoDBAtlas](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationMongoDBAtlas)
 		if !ok {
@@ -154,6 +158,7 @@ func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Create(ctx context.Context
 		}
 		return response.Payload.Integration, nil
 	})...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -201,12 +206,14 @@ func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Delete(ctx context.Context
 	})...)
 }
 
+func (r *resourceVaultSecretsIntegrationMongoDBAtlas) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(vaultSecretsIdentity("name")...)
+}
+
 func (r *resourceVaultSecretsIntegrationMongoDBAtlas) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// The Vault Secrets API does not return sensitive values like the secret access key, so they will be initialized to an empty value
 	// It means the first plan/apply after a successful import will always show a diff for the secret access key
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), r.client.Config.OrganizationID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), r.client.Config.ProjectID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	importVaultSecretsState(ctx, r.client, req, resp, "name")
 }
 
 var _ hvsResource = &IntegrationMongoDBAtlas{}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithMoveState = &resourceVaultSecretsIntegration{}
+
+// attributeRename maps an attribute of a deprecated integration resource to
+// the corresponding attribute of hcp_vault_secrets_integration.
+type attributeRename struct {
+	from string
+	to   string
+}
+
+// deprecatedIntegration describes a per-provider integration resource
+// superseded by hcp_vault_secrets_integration.
+type deprecatedIntegration struct {
+	typeName    string
+	provider    Provider
+	credentials []attributeRename
+}
+
+var (
+	deprecatedIntegrationAWS = deprecatedIntegration{
+		typeName: "hcp_vault_secrets_integration_aws",
+		provider: ProviderAWS,
+		credentials: []attributeRename{
+			{from: "access_keys", to: "aws_access_keys"},
+			{from: "federated_workload_identity", to: "aws_federated_workload_identity"},
+		},
+	}
+	deprecatedIntegrationAzure = deprecatedIntegration{
+		typeName: "hcp_vault_secrets_integration_azure",
+		provider: ProviderAzure,
+		credentials: []attributeRename{
+			{from: "client_secret", to: "azure_client_secret"},
+			{from: "federated_workload_identity", to: "azure_federated_workload_identity"},
+		},
+	}
+	deprecatedIntegrationConfluent = deprecatedIntegration{
+		typeName: "hcp_vault_secrets_integration_confluent",
+		provider: ProviderConfluent,
+		credentials: []attributeRename{
+			{from: "static_credential_details", to: "confluent_static_credentials"},
+		},
+	}
+	deprecatedIntegrationGCP = deprecatedIntegration{
+		typeName: "hcp_vault_secrets_integration_gcp",
+		provider: ProviderGCP,
+		credentials: []attributeRename{
+			{from: "service_account_key", to: "gcp_service_account_key"},
+			{from: "federated_workload_identity", to: "gcp_federated_workload_identity"},
+		},
+	}
+	deprecatedIntegrationMongoDBAtlas = deprecatedIntegration{
+		typeName: "hcp_vault_secrets_integration_mongodbatlas",
+		provider: ProviderMongoDBAtlas,
+		credentials: []attributeRename{
+			{from: "static_credential_details", to: "mongodb_atlas_static_credentials"},
+		},
+	}
+	deprecatedIntegrationTwilio = deprecatedIntegration{
+		typeName: "hcp_vault_secrets_integration_twilio",
+		provider: ProviderTwilio,
+		credentials: []attributeRename{
+			{from: "static_credential_details", to: "twilio_static_credentials"},
+		},
+	}
+)
+
+// sharedIntegrationStateAttributes are carried over unchanged when moving the
+// state of a deprecated integration resource.
+var sharedIntegrationStateAttributes = []string{
+	"organization_id",
+	"project_id",
+	"resource_id",
+	"resource_name",
+	"name",
+	"capabilities",
+}
+
+// deprecationMessage explains how to migrate to hcp_vault_secrets_integration,
+// including the moved block carrying the existing state across.
+func (d deprecatedIntegration) deprecationMessage() string {
+	renames := make([]string, 0, len(d.credentials))
+	for _, c := range d.credentials {
+		renames = append(renames, fmt.Sprintf("`%s` to `%s`", c.from, c.to))
+	}
+
+	return fmt.Sprintf("%s is deprecated, use hcp_vault_secrets_integration instead. "+
+		"Change the resource type to hcp_vault_secrets_integration, set `provider_type = %q` and rename %s, "+
+		"then add a moved block from the previous address of the resource to its new one to keep the existing integration. "+
+		"For example, for a resource labelled example:\n\n"+
+		"moved {\n  from = %s.example\n  to   = hcp_vault_secrets_integration.example\n}",
+		d.typeName, d.provider, strings.Join(renames, ", "), d.typeName)
+}
+
+func (r *resourceVaultSecretsIntegration) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		integrationStateMover(ctx, deprecatedIntegrationAWS, NewVaultSecretsIntegrationAWSResource()),
+		integrationStateMover(ctx, deprecatedIntegrationAzure, NewVaultSecretsIntegrationAzureResource()),
+		integrationStateMover(ctx, deprecatedIntegrationConfluent, NewVaultSecretsIntegrationsConfluentResource()),
+		integrationStateMover(ctx, deprecatedIntegrationGCP, NewVaultSecretsIntegrationGCPResource()),
+		integrationStateMover(ctx, deprecatedIntegrationMongoDBAtlas, NewVaultSecretsIntegrationMongoDBAtlasResource()),
+		integrationStateMover(ctx, deprecatedIntegrationTwilio, NewVaultSecretsIntegrationTwilioResource()),
+	}
+}
+
+// integrationStateMover moves the state of a deprecated integration resource
+// to hcp_vault_secrets_integration, renaming its credential attributes.
+func integrationStateMover(ctx context.Context, d deprecatedIntegration, source resource.Resource) resource.StateMover {
+	var schemaResp resource.SchemaResponse
+	source.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	return resource.StateMover{
+		SourceSchema: &schemaResp.Schema,
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != d.typeName || !strings.HasSuffix(req.SourceProviderAddress, "hashicorp/hcp") {
+				return
+			}
+			if req.SourceState == nil {
+				resp.Diagnostics.AddError(
+					"Unable to move Vault Secrets integration",
+					fmt.Sprintf("The state of %s could not be read with schema version %d.", d.typeName, req.SourceSchemaVersion),
+				)
+				return
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("provider_type"), types.StringValue(d.provider.String()))...)
+
+			attributes := make([]attributeRename, 0, len(sharedIntegrationStateAttributes)+len(d.credentials))
+			for _, a := range sharedIntegrationStateAttributes {
+				attributes = append(attributes, attributeRename{from: a, to: a})
+			}
+			attributes = append(attributes, d.credentials...)
+
+			for _, a := range attributes {
+				var value attr.Value
+				resp.Diagnostics.Append(req.SourceState.GetAttribute(ctx, path.Root(a.from), &value)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root(a.to), value)...)
+			}
+		},
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegrationStateMover(t *testing.T) {
+	ctx := context.Background()
+
+	var targetSchemaResp resource.SchemaResponse
+	NewVaultSecretsIntegrationResource().Schema(ctx, resource.SchemaRequest{}, &targetSchemaResp)
+	require.False(t, targetSchemaResp.Diagnostics.HasError(), targetSchemaResp.Diagnostics)
+	targetSchema := targetSchemaResp.Schema
+
+	cases := []struct {
+		name        string
+		integration deprecatedIntegration
+		source      resource.Resource
+	}{
+		{"AWS", deprecatedIntegrationAWS, NewVaultSecretsIntegrationAWSResource()},
+		{"Azure", deprecatedIntegrationAzure, NewVaultSecretsIntegrationAzureResource()},
+		{"Confluent", deprecatedIntegrationConfluent, NewVaultSecretsIntegrationsConfluentResource()},
+		{"GCP", deprecatedIntegrationGCP, NewVaultSecretsIntegrationGCPResource()},
+		{"MongoDB Atlas", deprecatedIntegrationMongoDBAtlas, NewVaultSecretsIntegrationMongoDBAtlasResource()},
+		{"Twilio", deprecatedIntegrationTwilio, NewVaultSecretsIntegrationTwilioResource()},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mover := integrationStateMover(ctx, c.integration, c.source)
+			sourceSchema := *mover.SourceSchema
+
+			// Every credential attribute of the source state is set, so that
+			// each rename is checked.
+			sourceState := tfsdk.State{
+				Schema: sourceSchema,
+				Raw:    tftypes.NewValue(sourceSchema.Type().TerraformType(ctx), nil),
+			}
+			for _, a := range sharedIntegrationStateAttributes {
+				var value attr.Value = types.StringValue(a + "-value")
+				if a == "capabilities" {
+					value = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("ROTATION")})
+				}
+				require.False(t, sourceState.SetAttribute(ctx, path.Root(a), value).HasError())
+			}
+			for _, cred := range c.integration.credentials {
+				require.False(t, sourceState.SetAttribute(ctx, path.Root(cred.from), testCredentialValue(t, sourceSchema.Attributes[cred.from].GetType())).HasError())
+			}
+
+			req := resource.MoveStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/hcp",
+				SourceTypeName:        c.integration.typeName,
+				SourceState:           &sourceState,
+			}
+			resp := &resource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Schema: targetSchema,
+					Raw:    tftypes.NewValue(targetSchema.Type().TerraformType(ctx), nil),
+				},
+			}
+			mover.StateMover(ctx, req, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var providerType types.String
+			require.False(t, resp.TargetState.GetAttribute(ctx, path.Root("provider_type"), &providerType).HasError())
+			require.Equal(t, c.integration.provider.String(), providerType.ValueString())
+
+			renames := []attributeRename{}
+			for _, a := range sharedIntegrationStateAttributes {
+				renames = append(renames, attributeRename{from: a, to: a})
+			}
+			renames = append(renames, c.integration.credentials...)
+			for _, r := range renames {
+				var from, to attr.Value
+				require.False(t, sourceState.GetAttribute(ctx, path.Root(r.from), &from).HasError())
+				require.False(t, resp.TargetState.GetAttribute(ctx, path.Root(r.to), &to).HasError())
+				require.True(t, from.Equal(to), "%s: moved %s as %s; want %s", r.to, r.from, to, from)
+			}
+		})
+
+		t.Run(c.name+" from another resource type", func(t *testing.T) {
+			mover := integrationStateMover(ctx, c.integration, c.source)
+			req := resource.MoveStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/hcp",
+				SourceTypeName:        "hcp_vault_secrets_app",
+			}
+			resp := &resource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Schema: targetSchema,
+					Raw:    tftypes.NewValue(targetSchema.Type().TerraformType(ctx), nil),
+				},
+			}
+			mover.StateMover(ctx, req, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			require.True(t, resp.TargetState.Raw.IsNull(), "the target state is set; want it left for another mover")
+		})
+	}
+}
+
+func TestDeprecatedIntegrationMessage(t *testing.T) {
+	for _, d := range []deprecatedIntegration{
+		deprecatedIntegrationAWS,
+		deprecatedIntegrationAzure,
+		deprecatedIntegrationConfluent,
+		deprecatedIntegrationGCP,
+		deprecatedIntegrationMongoDBAtlas,
+		deprecatedIntegrationTwilio,
+	} {
+		t.Run(d.typeName, func(t *testing.T) {
+			message := d.deprecationMessage()
+			require.Contains(t, message, "from = "+d.typeName+".example")
+			require.Contains(t, message, "to   = hcp_vault_secrets_integration.example")
+			require.NotContains(t, message, "<")
+			for _, c := range d.credentials {
+				require.Contains(t, message, "`"+c.from+"` to `"+c.to+"`")
+			}
+		})
+	}
+}
+
+// testCredentialValue returns a known value of the given credential type,
+// whose string attributes are set to their name.
+func testCredentialValue(t *testing.T, typ attr.Type) attr.Value {
+	t.Helper()
+
+	objectType, ok := typ.(types.ObjectType)
+	if !ok {
+		t.Fatalf("credential type = %s; want an object", typ)
+	}
+
+	values := make(map[string]attr.Value, len(objectType.AttrTypes))
+	for name, attrType := range objectType.AttrTypes {
+		if attrType != types.StringType {
+			t.Fatalf("credential attribute %s type = %s; want a string", name, attrType)
+		}
+		values[name] = types.StringValue(name)
+	}
+
+	value, diags := types.ObjectValue(objectType.AttrTypes, values)
+	if diags.HasError() {
+		t.Fatalf("unable to build credential value: %v", diags)
+	}
+	return value
+}
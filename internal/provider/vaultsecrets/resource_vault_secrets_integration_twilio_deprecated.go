@@ -11,7 +11,6 @@ import (
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,6 +18,7 @@ import (
 	"golang.org/x/exp/maps"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
@@ -50,6 +50,7 @@ var _ resource.Resource = &resourceVaultSecretsIntegrationTwilio{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsIntegrationTwilio{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsIntegrationTwilio{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsIntegrationTwilio{}
+var _ resource.ResourceWithIdentity = &resourceVaultSecretsIntegrationTwilio{}
 
 func NewVaultSecretsIntegrationTwilioResource() resource.Resource {
 	return &resourceVaultSecretsIntegrationTwilio{}
@@ -91,6 +92,7 @@ func (r *resourceVaultSecretsIntegrationTwilio) Schema(_ context.Context, _ reso
 
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets Twilio integration resource manages a Twilio integration.",
+		DeprecationMessage:  deprecatedIntegrationTwilio.deprecationMessage(),
 		Attributes:          attributes,
 	}
 }
@@ -115,6 +117,8 @@ func (r *resourceVaultSecretsIntegrationTwilio) ModifyPlan(ctx context.Context,
 }
 
 func (r *resourceVaultSecretsIntegrationTwilio) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationTwilio](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationTwilio)
 		if !ok {
@@ -160,6 +164,7 @@ func (r *resourceVaultSecretsIntegrationTwilio) Create(ctx context.Context, req
 		}
 		return response.Payload.Integration, nil
 	})...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourceVaultSecretsIntegrationTwilio) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -207,12 +212,14 @@ func (r *resourceVaultSecretsIntegrationTwilio) Delete(ctx context.Context, req
 	})...)
 }
 
+func (r *resourceVaultSecretsIntegrationTwilio) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(vaultSecretsIdentity("name")...)
+}
+
 func (r *resourceVaultSecretsIntegrationTwilio) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// The Vault Secrets API does not return sensitive values like the secret access key, so they will be initialized to an empty value
 	// It means the first plan/apply after a successful import will always show a diff for the secret access key
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), r.client.Config.OrganizationID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), r.client.Config.ProjectID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	importVaultSecretsState(ctx, r.client, req, resp, "name")
 }
 
 var _ hvsResource = &IntegrationTwilio{}
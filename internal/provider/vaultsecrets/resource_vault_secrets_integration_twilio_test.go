@@ -11,7 +11,9 @@ import (
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
@@ -114,6 +116,61 @@ func TestAccVaultSecretsResourceIntegrationTwilio(t *testing.T) {
 	})
 }
 
+// TestAccVaultSecretsResourceIntegrationTwilio_MoveState validates that a
+// deprecated hcp_vault_secrets_integration_twilio is moved to
+// hcp_vault_secrets_integration without recreating the integration.
+func TestAccVaultSecretsResourceIntegrationTwilio_MoveState(t *testing.T) {
+	accountSID := checkRequiredEnvVarOrFail(t, "TWILIO_ACCOUNT_SID")
+	apiKeySID := checkRequiredEnvVarOrFail(t, "TWILIO_API_KEY_SID")
+	apiKeySecret := checkRequiredEnvVarOrFail(t, "TWILIO_API_KEY_SECRET")
+
+	integrationName := generateRandomSlug()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "hcp_vault_secrets_integration_twilio" "acc_test" {
+					name = %q
+					capabilities = ["ROTATION"]
+					static_credential_details = {
+						account_sid = %q
+						api_key_sid = %q
+						api_key_secret = %q
+					}
+				}`, integrationName, accountSID, apiKeySID, apiKeySecret),
+				Check: resource.TestCheckResourceAttr("hcp_vault_secrets_integration_twilio.acc_test", "name", integrationName),
+			},
+			{
+				Config: twilioConfig(integrationName, accountSID, apiKeySID, apiKeySecret) + `
+				moved {
+					from = hcp_vault_secrets_integration_twilio.acc_test
+					to   = hcp_vault_secrets_integration.acc_test
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("hcp_vault_secrets_integration.acc_test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					twilioCheckFuncs(integrationName, accountSID, apiKeySID, apiKeySecret)...,
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if twilioIntegrationExists(t, integrationName) {
+				return fmt.Errorf("test twilio integration %s was not destroyed", integrationName)
+			}
+			return nil
+		},
+	})
+}
+
 func twilioConfig(integrationName, accountSID, apiKeySID, apiKeySecret string) string {
 	return fmt.Sprintf(`
 	resource "hcp_vault_secrets_integration" "acc_test" {
@@ -6,22 +6,28 @@ package vaultsecrets
 import (
 	"context"
 	"fmt"
+	"reflect"
 
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"golang.org/x/exp/maps"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
@@ -33,6 +39,7 @@ var exactlyOneRotatingSecretTypeFieldsValidator = objectvalidator.ExactlyOneOf(
 		path.MatchRoot("twilio_api_key"),
 		path.MatchRoot("confluent_service_account"),
 		path.MatchRoot("azure_application_password"),
+		path.MatchRoot("postgres_user_password"),
 	}...,
 )
 
@@ -54,6 +61,7 @@ var rotatingSecretsImpl = map[Provider]rotatingSecret{
 	ProviderTwilio:       &twilioRotatingSecret{},
 	ProviderConfluent:    &confluentRotatingSecret{},
 	ProviderAzure:        &azureRotatingSecret{},
+	ProviderPostgres:     &postgresRotatingSecret{},
 }
 
 type RotatingSecret struct {
@@ -64,6 +72,7 @@ type RotatingSecret struct {
 	Name               types.String `tfsdk:"name"`
 	IntegrationName    types.String `tfsdk:"integration_name"`
 	RotationPolicyName types.String `tfsdk:"rotation_policy_name"`
+	RotateOnChange     types.Map    `tfsdk:"rotate_on_change"`
 
 	// Provider specific mutually exclusive fields
 	AWSAccessKeys            *awsAccessKeys            `tfsdk:"aws_access_keys"`
@@ -72,11 +81,16 @@ type RotatingSecret struct {
 	TwilioAPIKey             *twilioAPIKey             `tfsdk:"twilio_api_key"`
 	ConfluentServiceAccount  *confluentServiceAccount  `tfsdk:"confluent_service_account"`
 	AzureApplicationPassword *AzureApplicationPassword `tfsdk:"azure_application_password"`
+	PostgresUserPassword     *postgresUserPassword     `tfsdk:"postgres_user_password"`
 	// Computed fields
-	OrganizationID types.String `tfsdk:"organization_id"`
+	OrganizationID     types.String `tfsdk:"organization_id"`
+	LastRotationStatus types.String `tfsdk:"last_rotation_status"`
+	LastRotationError  types.String `tfsdk:"last_rotation_error"`
+	NextRotationAt     types.String `tfsdk:"next_rotation_at"`
 
 	// Inner API-compatible models derived from the Terraform fields
-	mongoDBRoles []*secretmodels.Secrets20231128MongoDBRole `tfsdk:"-"`
+	mongoDBRoles      []*secretmodels.Secrets20231128MongoDBRole `tfsdk:"-"`
+	postgresUsernames []string                                   `tfsdk:"-"`
 }
 
 type awsAccessKeys struct {
@@ -88,9 +102,121 @@ type gcpServiceAccountKey struct {
 }
 
 type mongoDBAtlasUser struct {
-	ProjectID    types.String   `tfsdk:"project_id"`
-	DatabaseName types.String   `tfsdk:"database_name"`
-	Roles        []types.String `tfsdk:"roles"`
+	ProjectID     types.String   `tfsdk:"project_id"`
+	DatabaseName  types.String   `tfsdk:"database_name"`
+	Roles         []types.String `tfsdk:"roles"`
+	DatabaseRoles []mongoDBRole  `tfsdk:"database_roles"`
+}
+
+type mongoDBRole struct {
+	DatabaseName types.String `tfsdk:"database_name"`
+	RoleName     types.String `tfsdk:"role_name"`
+}
+
+// mongoDBDatabaseRolesAttribute returns the schema of the database_roles
+// attribute of MongoDB Atlas users, for roles that apply to different
+// databases.
+func mongoDBDatabaseRolesAttribute(user string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: fmt.Sprintf("MongoDB Atlas roles to assign to the %s, each on its own database. "+
+			"Exactly one of `roles` or `database_roles` must be set.", user),
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"database_name": schema.StringAttribute{
+					Description: "MongoDB Atlas database or cluster name the role applies to.",
+					Required:    true,
+				},
+				"role_name": schema.StringAttribute{
+					Description: "MongoDB Atlas role to assign.",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// apiRoles returns the roles of the user in the format of the API, with one
+// entry per database a role applies to.
+func (u *mongoDBAtlasUser) apiRoles() []*secretmodels.Secrets20231128MongoDBRole {
+	roles := make([]*secretmodels.Secrets20231128MongoDBRole, 0, len(u.Roles)+len(u.DatabaseRoles))
+	for _, r := range u.Roles {
+		roles = append(roles, &secretmodels.Secrets20231128MongoDBRole{
+			DatabaseName: u.DatabaseName.ValueString(),
+			RoleName:     r.ValueString(),
+		})
+	}
+	for _, r := range u.DatabaseRoles {
+		roles = append(roles, &secretmodels.Secrets20231128MongoDBRole{
+			DatabaseName: r.DatabaseName.ValueString(),
+			RoleName:     r.RoleName.ValueString(),
+		})
+	}
+	return roles
+}
+
+// mongoDBRolesRequireReplace returns a plan modifier of MongoDB Atlas users
+// which requires the replacement of the secret when its roles change. Roles
+// that are only set with the other of `roles` and `database_roles`, such as
+// after an import, are updated in place instead.
+func mongoDBRolesRequireReplace() planmodifier.Object {
+	description := "Changes of the roles, other than setting them with the other of `roles` and `database_roles`, require the secret to be replaced."
+	return objectplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+			planValue, err := req.PlanValue.ToTerraformValue(ctx)
+			if err != nil || !planValue.IsFullyKnown() {
+				resp.RequiresReplace = true
+				return
+			}
+
+			var plan, state mongoDBAtlasUser
+			resp.Diagnostics.Append(req.PlanValue.As(ctx, &plan, basetypes.ObjectAsOptions{})...)
+			resp.Diagnostics.Append(req.StateValue.As(ctx, &state, basetypes.ObjectAsOptions{})...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			resp.RequiresReplace = !reflect.DeepEqual(plan.apiRoles(), state.apiRoles())
+		},
+		description,
+		description,
+	)
+}
+
+// mongoDBAtlasUserFromDetails converts the API details of a MongoDB Atlas secret.
+// The roles are set like in the prior user, with database_roles or with
+// database_name and roles. Without a prior user, such as after an import,
+// database_name and roles are used, unless the roles apply to several
+// databases, which only database_roles can hold.
+func mongoDBAtlasUserFromDetails(prior *mongoDBAtlasUser, details *secretmodels.Secrets20231128MongoDBAtlasSecretDetails) *mongoDBAtlasUser {
+	user := &mongoDBAtlasUser{
+		ProjectID:    types.StringValue(details.MongodbGroupID),
+		DatabaseName: types.StringNull(),
+	}
+
+	withDatabaseRoles := prior != nil && prior.DatabaseRoles != nil
+	for _, role := range details.MongodbRoles {
+		if role.DatabaseName != details.MongodbRoles[0].DatabaseName {
+			withDatabaseRoles = true
+		}
+	}
+
+	if withDatabaseRoles {
+		user.DatabaseRoles = []mongoDBRole{}
+		for _, role := range details.MongodbRoles {
+			user.DatabaseRoles = append(user.DatabaseRoles, mongoDBRole{
+				DatabaseName: types.StringValue(role.DatabaseName),
+				RoleName:     types.StringValue(role.RoleName),
+			})
+		}
+		return user
+	}
+
+	user.Roles = []types.String{}
+	for _, role := range details.MongodbRoles {
+		user.DatabaseName = types.StringValue(role.DatabaseName)
+		user.Roles = append(user.Roles, types.StringValue(role.RoleName))
+	}
+	return user
 }
 
 type confluentServiceAccount struct {
@@ -104,9 +230,15 @@ type AzureApplicationPassword struct {
 
 type twilioAPIKey struct{}
 
+type postgresUserPassword struct {
+	Usernames []types.String `tfsdk:"usernames"`
+}
+
 var _ resource.Resource = &resourceVaultSecretsRotatingSecret{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsRotatingSecret{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsRotatingSecret{}
+var _ resource.ResourceWithImportState = &resourceVaultSecretsRotatingSecret{}
+var _ resource.ResourceWithIdentity = &resourceVaultSecretsRotatingSecret{}
 
 func NewVaultSecretsRotatingSecretResource() resource.Resource {
 	return &resourceVaultSecretsRotatingSecret{}
@@ -126,6 +258,23 @@ func (r *resourceVaultSecretsRotatingSecret) Schema(_ context.Context, _ resourc
 			Description: "Name of the rotation policy that governs the rotation of the secret.",
 			Required:    true,
 		},
+		"rotate_on_change": schema.MapAttribute{
+			Description: "Arbitrary map of values that, when changed, triggers an immediate rotation of the secret during apply.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"last_rotation_status": schema.StringAttribute{
+			Description: "Status of the rotation of the secret, for example `WAITING_FOR_NEXT_ROTATION` or `ERRORED`.",
+			Computed:    true,
+		},
+		"last_rotation_error": schema.StringAttribute{
+			Description: "Error message of the last rotation, if it failed.",
+			Computed:    true,
+		},
+		"next_rotation_at": schema.StringAttribute{
+			Description: "Time of the next scheduled rotation, in RFC3339 format.",
+			Computed:    true,
+		},
 		"aws_access_keys": schema.SingleNestedAttribute{
 			Description: "AWS configuration to manage the access key rotation for the given IAM user. Required if `secret_provider` is `aws`.",
 			Optional:    true,
@@ -170,20 +319,25 @@ func (r *resourceVaultSecretsRotatingSecret) Schema(_ context.Context, _ resourc
 					},
 				},
 				"database_name": schema.StringAttribute{
-					Description: "MongoDB Atlas database or cluster name to rotate the username and password for.",
-					Required:    true,
-					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
+					Description: "MongoDB Atlas database or cluster name to rotate the username and password for. Required with `roles`.",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("roles")),
 					},
 				},
 				"roles": schema.ListAttribute{
-					Description: "MongoDB Atlas roles to assign to the rotating user.",
-					Required:    true,
+					Description: "MongoDB Atlas roles to assign to the rotating user on `database_name`. Exactly one of `roles` or `database_roles` must be set.",
+					Optional:    true,
 					ElementType: types.StringType,
-					PlanModifiers: []planmodifier.List{
-						listplanmodifier.RequiresReplace(),
+					Validators: []validator.List{
+						listvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("database_name")),
+						listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("database_roles")),
 					},
 				},
+				"database_roles": mongoDBDatabaseRolesAttribute("rotating user"),
+			},
+			PlanModifiers: []planmodifier.Object{
+				mongoDBRolesRequireReplace(),
 			},
 			Validators: []validator.Object{
 				exactlyOneRotatingSecretTypeFieldsValidator,
@@ -238,6 +392,20 @@ func (r *resourceVaultSecretsRotatingSecret) Schema(_ context.Context, _ resourc
 				exactlyOneRotatingSecretTypeFieldsValidator,
 			},
 		},
+		"postgres_user_password": schema.SingleNestedAttribute{
+			Description: "PostgreSQL configuration to manage the password rotation of the given database users. Required if `secret_provider` is `postgres`.",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"usernames": schema.ListAttribute{
+					Description: "PostgreSQL usernames to rotate the passwords for. Multiple users are rotated in turn, so one of them always has a valid password.",
+					Required:    true,
+					ElementType: types.StringType,
+				},
+			},
+			Validators: []validator.Object{
+				exactlyOneRotatingSecretTypeFieldsValidator,
+			},
+		},
 	}
 
 	maps.Copy(attributes, locationAttributes)
@@ -266,23 +434,65 @@ func (r *resourceVaultSecretsRotatingSecret) Configure(_ context.Context, req re
 
 func (r *resourceVaultSecretsRotatingSecret) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	modifiers.ModifyPlanForDefaultProjectChange(ctx, r.client.Config.ProjectID, req.State, req.Config, req.Plan, resp)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state RotatingSecret
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Surface failed rotations, which otherwise only show as a change of the computed status
+	if state.LastRotationStatus.ValueString() == string(secretmodels.Secrets20231128RotatingSecretStatusERRORED) {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("Rotation of secret %q failed", state.Name.ValueString()),
+			fmt.Sprintf("The last rotation of the secret failed with: %s. Set or change `rotate_on_change` to retry the rotation.", state.LastRotationError.ValueString()),
+		)
+	}
 }
 
 func (r *resourceVaultSecretsRotatingSecret) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
 	resp.Diagnostics.Append(decorateOperation[*RotatingSecret](ctx, r.client, &resp.State, req.State.Get, "reading", func(s hvsResource) (any, error) {
 		secret, ok := s.(*RotatingSecret)
 		if !ok {
 			return nil, fmt.Errorf(invalidSecretTypeErrorFmt, s)
 		}
 
+		// The provider is only unknown right after an import, the configuration
+		// of the secret is read through the generic operation first.
+		if secret.SecretProvider.IsNull() {
+			model, err := getAppRotatingSecret(ctx, r.client.VaultSecrets, secret)
+			if err != nil || model == nil {
+				return nil, err
+			}
+			secret.fromImportedModel(model)
+		}
+
 		rotatingSecretImpl, ok := rotatingSecretsImpl[Provider(secret.SecretProvider.ValueString())]
 		if !ok {
 			return nil, fmt.Errorf(unsupportedProviderErrorFmt, maps.Keys(rotatingSecretsImpl), secret.SecretProvider.ValueString())
 		}
-		return rotatingSecretImpl.read(ctx, r.client.VaultSecrets, secret)
+		model, err := rotatingSecretImpl.read(ctx, r.client.VaultSecrets, secret)
+		if err != nil || model == nil {
+			return model, err
+		}
+		return model, r.readRotationState(ctx, secret)
 	})...)
 }
 
+func (r *resourceVaultSecretsRotatingSecret) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(vaultSecretsIdentity("app_name", "name")...)
+}
+
+func (r *resourceVaultSecretsRotatingSecret) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importVaultSecretsState(ctx, r.client, req, resp, "app_name", "name")
+}
+
 func (r *resourceVaultSecretsRotatingSecret) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	resp.Diagnostics.Append(decorateOperation[*RotatingSecret](ctx, r.client, &resp.State, req.Plan.Get, "creating", func(s hvsResource) (any, error) {
 		secret, ok := s.(*RotatingSecret)
@@ -294,11 +504,22 @@ func (r *resourceVaultSecretsRotatingSecret) Create(ctx context.Context, req res
 		if !ok {
 			return nil, fmt.Errorf(unsupportedProviderErrorFmt, maps.Keys(rotatingSecretsImpl), secret.SecretProvider.ValueString())
 		}
-		return rotatingSecretImpl.create(ctx, r.client.VaultSecrets, secret)
+		model, err := rotatingSecretImpl.create(ctx, r.client.VaultSecrets, secret)
+		if err != nil || model == nil {
+			return model, err
+		}
+		return model, r.readRotationState(ctx, secret)
 	})...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourceVaultSecretsRotatingSecret) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state RotatingSecret
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(decorateOperation[*RotatingSecret](ctx, r.client, &resp.State, req.Plan.Get, "updating", func(s hvsResource) (any, error) {
 		secret, ok := s.(*RotatingSecret)
 		if !ok {
@@ -309,7 +530,17 @@ func (r *resourceVaultSecretsRotatingSecret) Update(ctx context.Context, req res
 		if !ok {
 			return nil, fmt.Errorf(unsupportedProviderErrorFmt, maps.Keys(rotatingSecretsImpl), secret.SecretProvider.ValueString())
 		}
-		return rotatingSecretImpl.update(ctx, r.client.VaultSecrets, secret)
+		model, err := rotatingSecretImpl.update(ctx, r.client.VaultSecrets, secret)
+		if err != nil || model == nil {
+			return model, err
+		}
+
+		if !secret.RotateOnChange.Equal(state.RotateOnChange) {
+			if err := clients.RotateSecret(ctx, r.client, secret.location(), secret.AppName.ValueString(), secret.Name.ValueString()); err != nil {
+				return nil, fmt.Errorf("unable to rotate secret: %w", err)
+			}
+		}
+		return model, r.readRotationState(ctx, secret)
 	})...)
 }
 
@@ -334,23 +565,55 @@ func (r *resourceVaultSecretsRotatingSecret) Delete(ctx context.Context, req res
 	})...)
 }
 
+// readRotationState sets the computed rotation status fields of the secret.
+func (r *resourceVaultSecretsRotatingSecret) readRotationState(ctx context.Context, secret *RotatingSecret) error {
+	state, err := clients.GetRotatingSecretState(ctx, r.client, secret.location(), secret.AppName.ValueString(), secret.Name.ValueString())
+	if err != nil {
+		return fmt.Errorf("unable to read rotation state: %w", err)
+	}
+
+	secret.LastRotationStatus = types.StringNull()
+	secret.LastRotationError = types.StringNull()
+	secret.NextRotationAt = types.StringNull()
+	if state == nil {
+		return nil
+	}
+
+	if state.Status != nil {
+		secret.LastRotationStatus = types.StringValue(string(*state.Status))
+	}
+	if state.ErrorMessage != "" {
+		secret.LastRotationError = types.StringValue(state.ErrorMessage)
+	}
+	secret.NextRotationAt = versionTime(state.RotationTimeNext)
+
+	return nil
+}
+
 var _ hvsResource = &RotatingSecret{}
 
 func (s *RotatingSecret) projectID() types.String {
 	return s.ProjectID
 }
 
-func (s *RotatingSecret) initModel(_ context.Context, orgID, projID string) diag.Diagnostics {
+func (s *RotatingSecret) location() *sharedmodels.HashicorpCloudLocationLocation {
+	return &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: s.OrganizationID.ValueString(),
+		ProjectID:      s.ProjectID.ValueString(),
+	}
+}
+
+func (s *RotatingSecret) initModel(ctx context.Context, orgID, projID string) diag.Diagnostics {
 	s.OrganizationID = types.StringValue(orgID)
 	s.ProjectID = types.StringValue(projID)
 
 	if s.MongoDBAtlasUser != nil {
-		for _, r := range s.MongoDBAtlasUser.Roles {
-			role := secretmodels.Secrets20231128MongoDBRole{
-				DatabaseName: s.MongoDBAtlasUser.DatabaseName.ValueString(),
-				RoleName:     r.ValueString(),
-			}
-			s.mongoDBRoles = append(s.mongoDBRoles, &role)
+		s.mongoDBRoles = s.MongoDBAtlasUser.apiRoles()
+	}
+
+	if s.PostgresUserPassword != nil {
+		for _, u := range s.PostgresUserPassword.Usernames {
+			s.postgresUsernames = append(s.postgresUsernames, u.ValueString())
 		}
 	}
 
@@ -365,3 +628,63 @@ func (s *RotatingSecret) fromModel(_ context.Context, orgID, projID string, _ an
 
 	return diags
 }
+
+// fromImportedModel sets the configuration of an imported secret, which is
+// otherwise only known from the Terraform configuration.
+func (s *RotatingSecret) fromImportedModel(model *secretmodels.Secrets20231128RotatingSecret) {
+	s.SecretProvider = types.StringValue(model.Provider)
+	s.IntegrationName = types.StringValue(model.IntegrationName)
+	s.RotationPolicyName = types.StringValue(model.RotationPolicyName)
+
+	switch {
+	case model.AwsAccessKeyDetails != nil:
+		s.AWSAccessKeys = &awsAccessKeys{
+			IAMUsername: types.StringValue(model.AwsAccessKeyDetails.Username),
+		}
+	case model.GcpServiceAccountKeyDetails != nil:
+		s.GCPServiceAccountKey = &gcpServiceAccountKey{
+			ServiceAccountEmail: types.StringValue(model.GcpServiceAccountKeyDetails.ServiceAccountEmail),
+		}
+	case model.MongodbAtlasUserPasswordDetails != nil:
+		s.MongoDBAtlasUser = mongoDBAtlasUserFromDetails(s.MongoDBAtlasUser, model.MongodbAtlasUserPasswordDetails)
+	case model.ConfluentCloudAPIKeyDetails != nil:
+		s.ConfluentServiceAccount = &confluentServiceAccount{
+			ServiceAccountID: types.StringValue(model.ConfluentCloudAPIKeyDetails.ServiceAccountID),
+		}
+	case model.AzureApplicationPasswordDetails != nil:
+		s.AzureApplicationPassword = &AzureApplicationPassword{
+			AppClientID: types.StringValue(model.AzureApplicationPasswordDetails.AppClientID),
+			AppObjectID: types.StringValue(model.AzureApplicationPasswordDetails.AppObjectID),
+		}
+	case model.PostgresUserPasswordDetails != nil:
+		s.PostgresUserPassword = &postgresUserPassword{Usernames: []types.String{}}
+		for _, u := range model.PostgresUserPasswordDetails.Usernames {
+			s.PostgresUserPassword.Usernames = append(s.PostgresUserPassword.Usernames, types.StringValue(u))
+		}
+	case Provider(model.Provider) == ProviderTwilio:
+		// Twilio API keys do not have any details
+		s.TwilioAPIKey = &twilioAPIKey{}
+	}
+}
+
+// getAppRotatingSecret reads any type of rotating secret. It returns nil if the
+// secret does not exist.
+func getAppRotatingSecret(ctx context.Context, client secret_service.ClientService, secret *RotatingSecret) (*secretmodels.Secrets20231128RotatingSecret, error) {
+	response, err := client.GetAppRotatingSecret(
+		secret_service.NewGetAppRotatingSecretParamsWithContext(ctx).
+			WithOrganizationID(secret.OrganizationID.ValueString()).
+			WithProjectID(secret.ProjectID.ValueString()).
+			WithAppName(secret.AppName.ValueString()).
+			WithName(secret.Name.ValueString()),
+		nil)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if response == nil || response.Payload == nil {
+		return nil, nil
+	}
+	return response.Payload.Secret, nil
+}
@@ -5,8 +5,12 @@ package vaultsecrets_test
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"os/exec"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -19,6 +23,10 @@ func TestAccVaultSecretsResourceRotatingSecret(t *testing.T) {
 	if _, exists := os.LookupEnv("AWS_ROTATING_SECRET_ACC_ENABLED"); exists {
 		testAccVaultSecretsResourceRotatingSecretAWS(t)
 	}
+
+	if _, exists := os.LookupEnv("POSTGRES_ROTATING_SECRET_ACC_ENABLED"); exists {
+		testAccVaultSecretsResourceRotatingSecretPostgres(t)
+	}
 }
 
 func testAccVaultSecretsResourceRotatingSecretAWS(t *testing.T) {
@@ -35,18 +43,36 @@ func testAccVaultSecretsResourceRotatingSecretAWS(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create initial rotating secret
 			{
-				Config: awsRotatingSecretConfig(appName, secretName1, integrationName, rotationPolicy, username),
+				Config: awsRotatingSecretConfig(appName, secretName1, integrationName, rotationPolicy, username, ""),
 				Check: resource.ComposeTestCheckFunc(
 					awsRotationCheckFunc(appName, secretName1, integrationName, rotationPolicy, username)...,
 				),
 			},
 			// Changing an immutable field causes a recreation
 			{
-				Config: awsRotatingSecretConfig(appName, secretName2, integrationName, rotationPolicy, username),
+				Config: awsRotatingSecretConfig(appName, secretName2, integrationName, rotationPolicy, username, ""),
 				Check: resource.ComposeTestCheckFunc(
 					awsRotationCheckFunc(appName, secretName2, integrationName, rotationPolicy, username)...,
 				),
 			},
+			// Importing the secret reads its whole configuration
+			{
+				ResourceName:                         "hcp_vault_secrets_rotating_secret.acc_test_aws",
+				ImportState:                          true,
+				ImportStateId:                        fmt.Sprintf("%s/%s", appName, secretName2),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"last_rotation_status", "last_rotation_error", "next_rotation_at"},
+			},
+			// Changing rotate_on_change rotates the secret in place
+			{
+				Config: awsRotatingSecretConfig(appName, secretName2, integrationName, rotationPolicy, username, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					append(awsRotationCheckFunc(appName, secretName2, integrationName, rotationPolicy, username),
+						resource.TestCheckResourceAttr("hcp_vault_secrets_rotating_secret.acc_test_aws", "rotate_on_change.trigger", "1"),
+					)...,
+				),
+			},
 			// Deleting the secret out of band causes a recreation
 			{
 				PreConfig: func() {
@@ -62,7 +88,7 @@ func testAccVaultSecretsResourceRotatingSecretAWS(t *testing.T) {
 						t.Fatal(err)
 					}
 				},
-				Config: awsRotatingSecretConfig(appName, secretName2, integrationName, rotationPolicy, username),
+				Config: awsRotatingSecretConfig(appName, secretName2, integrationName, rotationPolicy, username, "1"),
 				Check: resource.ComposeTestCheckFunc(
 					awsRotationCheckFunc(appName, secretName2, integrationName, rotationPolicy, username)...,
 				),
@@ -82,7 +108,14 @@ func testAccVaultSecretsResourceRotatingSecretAWS(t *testing.T) {
 	})
 }
 
-func awsRotatingSecretConfig(appName, name, integrationName, policy, iamUsername string) string {
+func awsRotatingSecretConfig(appName, name, integrationName, policy, iamUsername, trigger string) string {
+	rotateOnChange := ""
+	if trigger != "" {
+		rotateOnChange = fmt.Sprintf(`rotate_on_change = {
+		trigger = %q
+	  }`, trigger)
+	}
+
 	return fmt.Sprintf(`
 	resource "hcp_vault_secrets_rotating_secret" "acc_test_aws" {
 	  app_name             = %q
@@ -93,7 +126,8 @@ func awsRotatingSecretConfig(appName, name, integrationName, policy, iamUsername
 	  aws_access_keys = {
 		iam_username = %q
 	  }
-	}`, appName, name, integrationName, policy, iamUsername)
+	  %s
+	}`, appName, name, integrationName, policy, iamUsername, rotateOnChange)
 }
 
 func awsRotationCheckFunc(appName, name, integrationName, policy, iamUsername string) []resource.TestCheckFunc {
@@ -106,6 +140,8 @@ func awsRotationCheckFunc(appName, name, integrationName, policy, iamUsername st
 		resource.TestCheckResourceAttr("hcp_vault_secrets_rotating_secret.acc_test_aws", "integration_name", integrationName),
 		resource.TestCheckResourceAttr("hcp_vault_secrets_rotating_secret.acc_test_aws", "rotation_policy_name", policy),
 		resource.TestCheckResourceAttr("hcp_vault_secrets_rotating_secret.acc_test_aws", "aws_access_keys.iam_username", iamUsername),
+		resource.TestCheckResourceAttrSet("hcp_vault_secrets_rotating_secret.acc_test_aws", "last_rotation_status"),
+		resource.TestCheckNoResourceAttr("hcp_vault_secrets_rotating_secret.acc_test_aws", "last_rotation_error"),
 	}
 }
 
@@ -126,3 +162,115 @@ func awsRotatingSecretExists(t *testing.T, appName, name string) bool {
 
 	return !clients.IsResponseCodeNotFound(err) && response != nil && response.Payload != nil && response.Payload.Config != nil
 }
+
+// testAccVaultSecretsResourceRotatingSecretPostgres rotates the password of a user of a local Postgres container.
+// The container must be reachable by Vault Secrets for the rotations to succeed, HVS_POSTGRES_HOST can be set to
+// the public address the container port is forwarded to.
+func testAccVaultSecretsResourceRotatingSecretPostgres(t *testing.T) {
+	testAppName := generateRandomSlug()
+	integrationName := generateRandomSlug()
+	connectionString := startPostgresContainer(t, "acc_tests_rotated")
+	secretName := "acc_tests_postgres"
+	resourceName := "hcp_vault_secrets_rotating_secret.acc_test_postgres"
+
+	config := fmt.Sprintf(`
+	resource "hcp_vault_secrets_integration" "acc_test_postgres" {
+	  name          = %q
+	  capabilities  = ["ROTATION"]
+	  provider_type = "postgres"
+	  postgres_static_credentials = {
+		connection_string = %q
+	  }
+	}
+
+	resource "hcp_vault_secrets_rotating_secret" "acc_test_postgres" {
+	  app_name             = %q
+	  secret_provider      = "postgres"
+	  name                 = %q
+	  integration_name     = hcp_vault_secrets_integration.acc_test_postgres.name
+	  rotation_policy_name = "built-in:30-days-2-active"
+	  postgres_user_password = {
+		usernames = ["acc_tests_rotated"]
+	  }
+	}`, integrationName, connectionString, testAppName, secretName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					createTestApp(t, testAppName)
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hcp_vault_secrets_integration.acc_test_postgres", "provider_type", "postgres"),
+					resource.TestCheckResourceAttr("hcp_vault_secrets_integration.acc_test_postgres", "postgres_static_credentials.connection_string", connectionString),
+					resource.TestCheckResourceAttr(resourceName, "secret_provider", "postgres"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_user_password.usernames.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_user_password.usernames.0", "acc_tests_rotated"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_rotation_status"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if secretExists(t, testAppName, secretName) {
+				return fmt.Errorf("test rotating secret %s was not destroyed", secretName)
+			}
+			deleteTestApp(t, testAppName)
+			return nil
+		},
+	})
+}
+
+// startPostgresContainer starts a Postgres container with the given user to rotate, and returns the connection
+// string of its superuser. The container is removed at the end of the test.
+func startPostgresContainer(t *testing.T, username string) string {
+	t.Helper()
+
+	if _, err := exec.LookPath("docker"); err != nil {
+		t.Skip("docker is required to run the Postgres rotating secret tests")
+	}
+
+	out, err := exec.Command("docker", "run", "--detach", "--rm",
+		"--env", "POSTGRES_PASSWORD=acc-tests-password",
+		"--publish", "127.0.0.1::5432",
+		"postgres:16-alpine").Output()
+	if err != nil {
+		t.Fatalf("unable to start the Postgres container: %v", err)
+	}
+	containerID := strings.TrimSpace(string(out))
+	t.Cleanup(func() {
+		_ = exec.Command("docker", "rm", "--force", containerID).Run()
+	})
+
+	out, err = exec.Command("docker", "port", containerID, "5432/tcp").Output()
+	if err != nil {
+		t.Fatalf("unable to read the Postgres container port: %v", err)
+	}
+	host := strings.TrimSpace(strings.Split(string(out), "\n")[0])
+	if h, ok := os.LookupEnv("HVS_POSTGRES_HOST"); ok {
+		host = h
+	}
+
+	// The server restarts once initialized, wait for it to accept queries
+	createUser := fmt.Sprintf("CREATE USER %s WITH PASSWORD 'initial-password'", username)
+	deadline := time.Now().Add(time.Minute)
+	for {
+		err = exec.Command("docker", "exec", containerID, "psql", "--username", "postgres", "--command", createUser).Run()
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("unable to create the Postgres user to rotate: %v", err)
+		}
+		time.Sleep(time.Second)
+	}
+
+	return (&url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword("postgres", "acc-tests-password"),
+		Host:   host,
+		Path:   "postgres",
+	}).String()
+}
@@ -17,12 +17,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
 var _ resource.Resource = &resourceVaultsecretsSecret{}
 var _ resource.ResourceWithConfigure = &resourceVaultsecretsSecret{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultsecretsSecret{}
+var _ resource.ResourceWithImportState = &resourceVaultsecretsSecret{}
+var _ resource.ResourceWithIdentity = &resourceVaultsecretsSecret{}
 
 func NewVaultSecretsSecretResource() resource.Resource {
 	return &resourceVaultsecretsSecret{}
@@ -37,6 +40,8 @@ type VaultSecretsSecret struct {
 	AppName        types.String `tfsdk:"app_name"`
 	SecretName     types.String `tfsdk:"secret_name"`
 	SecretValue    types.String `tfsdk:"secret_value"`
+	LatestVersion  types.Int64  `tfsdk:"latest_version"`
+	CreatedBy      types.String `tfsdk:"created_by"`
 	ProjectID      types.String `tfsdk:"project_id"`
 	OrganizationID types.String `tfsdk:"organization_id"`
 }
@@ -88,6 +93,17 @@ func (r *resourceVaultsecretsSecret) Schema(_ context.Context, _ resource.Schema
 				Required:    true,
 				Sensitive:   true,
 			},
+			"latest_version": schema.Int64Attribute{
+				Description: "The latest version of the secret, incremented every time the value changes.",
+				Computed:    true,
+			},
+			"created_by": schema.StringAttribute{
+				Description: "The email, or name, of the principal that created the secret.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "The ID of the HCP project where the HCP Vault Secrets secret is located.",
 				Computed:    true,
@@ -151,13 +167,18 @@ func (r *resourceVaultsecretsSecret) Create(ctx context.Context, req resource.Cr
 
 	plan.ID = plan.AppName
 	plan.SecretName = types.StringValue(res.Name)
+	plan.LatestVersion = types.Int64Value(res.LatestVersion)
+	plan.CreatedBy = principalName(res.CreatedBy)
 	plan.OrganizationID = types.StringValue(loc.OrganizationID)
 	plan.ProjectID = types.StringValue(loc.ProjectID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourceVaultsecretsSecret) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
 	var state VaultSecretsSecret
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -181,13 +202,42 @@ func (r *resourceVaultsecretsSecret) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	// TODO: so the resource can only create a static secret,
-	// what happens when a user tries to import a rotating/other type of secret?
+	// Rotating and dynamic secrets are managed by their own resources
+	if res.StaticVersion == nil {
+		resp.Diagnostics.AddError(
+			"Unsupported secret type",
+			fmt.Sprintf("The secret %q is a %s secret, only static secrets can be managed by this resource. "+
+				"Use hcp_vault_secrets_rotating_secret or hcp_vault_secrets_dynamic_secret instead.", res.Name, res.Type),
+		)
+		return
+	}
+
+	state.ID = state.AppName
 	state.SecretValue = types.StringValue(res.StaticVersion.Value)
+	state.LatestVersion = types.Int64Value(res.LatestVersion)
+
+	// The creator never changes, it is only read when unknown, for example
+	// after upgrading the provider.
+	if state.CreatedBy.IsNull() || state.CreatedBy.IsUnknown() {
+		secret, err := clients.GetVaultSecretsAppSecret(ctx, r.client, loc, state.AppName.ValueString(), state.SecretName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading secret metadata", err.Error())
+			return
+		}
+		state.CreatedBy = principalName(secret.CreatedBy)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+func (r *resourceVaultsecretsSecret) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(vaultSecretsIdentity("app_name", "secret_name")...)
+}
+
+func (r *resourceVaultsecretsSecret) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importVaultSecretsState(ctx, r.client, req, resp, "app_name", "secret_name")
+}
+
 func (r *resourceVaultsecretsSecret) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan VaultSecretsSecret
 	diags := req.Plan.Get(ctx, &plan)
@@ -214,6 +264,7 @@ func (r *resourceVaultsecretsSecret) Update(ctx context.Context, req resource.Up
 
 	plan.ID = plan.AppName
 	plan.SecretName = types.StringValue(res.Name)
+	plan.LatestVersion = types.Int64Value(res.LatestVersion)
 	plan.OrganizationID = types.StringValue(loc.OrganizationID)
 	plan.ProjectID = types.StringValue(loc.ProjectID)
 
@@ -38,8 +38,17 @@ func TestAccVaultSecretsResourceSecret(t *testing.T) {
 					resource.TestCheckResourceAttr("hcp_vault_secrets_secret.example", "app_name", testAppName1),
 					resource.TestCheckResourceAttr("hcp_vault_secrets_secret.example", "secret_name", secretName1),
 					resource.TestCheckResourceAttr("hcp_vault_secrets_secret.example", "secret_value", "super secret"),
+					resource.TestCheckResourceAttr("hcp_vault_secrets_secret.example", "latest_version", "1"),
+					resource.TestCheckResourceAttrSet("hcp_vault_secrets_secret.example", "created_by"),
 				),
 			},
+			// Import the secret with the default project
+			{
+				ResourceName:      "hcp_vault_secrets_secret.example",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", testAppName1, secretName1),
+				ImportStateVerify: true,
+			},
 			// Changing secret name should cause recreation.
 			// Validate that secretName2 is created and secretName1 is destroyed.
 			{
@@ -84,6 +93,19 @@ func TestAccVaultSecretsResourceSecret(t *testing.T) {
 					testAccCheckSecretExists(t, testAppName1, secretName2),
 				),
 			},
+			// Import the secret with an explicit project
+			{
+				ResourceName: "hcp_vault_secrets_secret.example",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["hcp_vault_secrets_secret.example"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state")
+					}
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["project_id"], testAppName2, secretName2), nil
+				},
+				ImportStateVerify: true,
+			},
 		},
 		CheckDestroy: func(s *terraform.State) error {
 			deleteTestApp(t, testAppName1)
@@ -0,0 +1,443 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
+)
+
+var _ resource.Resource = &resourceVaultSecretsSecrets{}
+var _ resource.ResourceWithConfigure = &resourceVaultSecretsSecrets{}
+var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsSecrets{}
+var _ resource.ResourceWithConfigValidators = &resourceVaultSecretsSecrets{}
+
+func NewVaultSecretsSecretsResource() resource.Resource {
+	return &resourceVaultSecretsSecrets{}
+}
+
+type resourceVaultSecretsSecrets struct {
+	client *clients.Client
+}
+
+type VaultSecretsSecrets struct {
+	ID               types.String `tfsdk:"id"`
+	AppName          types.String `tfsdk:"app_name"`
+	Secrets          types.Map    `tfsdk:"secrets"`
+	SecretsWO        types.Map    `tfsdk:"secrets_wo"`
+	SecretsWOVersion types.Int64  `tfsdk:"secrets_wo_version"`
+	SecretNames      types.Set    `tfsdk:"secret_names"`
+	ProjectID        types.String `tfsdk:"project_id"`
+	OrganizationID   types.String `tfsdk:"organization_id"`
+}
+
+func (r *resourceVaultSecretsSecrets) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_secrets_secrets"
+}
+
+func (r *resourceVaultSecretsSecrets) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	secretNameValidators := []validator.String{
+		stringvalidator.LengthAtLeast(1),
+		stringvalidator.LengthAtMost(64),
+		stringvalidator.RegexMatches(
+			regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`),
+			"must contain only ASCII letters, numbers, and underscores; must not start with a number",
+		),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Vault Secrets secrets resource manages a set of static secrets within a given application. " +
+			"Secrets are created and updated in bulk, and only the secrets managed by the resource are changed or deleted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The id of the resource",
+				Computed:    true,
+			},
+			"app_name": schema.StringAttribute{
+				Description: "The name of the application the secrets can be found in",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(3),
+					stringvalidator.LengthAtMost(36),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9\-]+[a-zA-Z0-9]$`),
+						"must contain only ASCII letters, numbers, and hyphens; must not start or end with a hyphen",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"secrets": schema.MapAttribute{
+				Description: "The values of the secrets, keyed by secret name. Values are stored in the Terraform state; " +
+					"use `secrets_wo` to keep them out of it.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(secretNameValidators...),
+				},
+			},
+			"secrets_wo": schema.MapAttribute{
+				Description: "The values of the secrets, keyed by secret name. Values are write-only and never stored in the " +
+					"Terraform state, only secrets added since the last apply are written unless `secrets_wo_version` changes. " +
+					"Requires Terraform 1.11 or later.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(secretNameValidators...),
+				},
+			},
+			"secrets_wo_version": schema.Int64Attribute{
+				Description: "The version of `secrets_wo`. Changing it writes all the values of `secrets_wo` again.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("secrets_wo")),
+				},
+			},
+			"secret_names": schema.SetAttribute{
+				Description: "The names of the secrets managed by the resource.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the HCP Vault Secrets secrets are located.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the HCP organization where the project the HCP Vault Secrets secrets are located.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *resourceVaultSecretsSecrets) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("secrets"),
+			path.MatchRoot("secrets_wo"),
+		),
+	}
+}
+
+func (r *resourceVaultSecretsSecrets) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Source Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *resourceVaultSecretsSecrets) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifiers.ModifyPlanForDefaultProjectChange(ctx, r.client.Config.ProjectID, req.State, req.Config, req.Plan, resp)
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The managed secrets are the keys of the configured map, write-only
+	// values are only available in the configuration.
+	values, diags := configuredSecrets(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretNames := types.SetUnknown(types.StringType)
+	if !values.IsUnknown() {
+		secretNames, diags = types.SetValueFrom(ctx, types.StringType, sortedKeys(values.Elements()))
+		resp.Diagnostics.Append(diags...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_names"), secretNames)...)
+}
+
+func (r *resourceVaultSecretsSecrets) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan VaultSecretsSecrets
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values, diags := configuredSecretValues(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loc := r.location(plan.ProjectID)
+
+	if err := createAppSecrets(ctx, r.client, loc, plan.AppName.ValueString(), values); err != nil {
+		resp.Diagnostics.AddError("Error creating secrets", err.Error())
+		return
+	}
+
+	plan.ID = plan.AppName
+	plan.OrganizationID = types.StringValue(loc.OrganizationID)
+	plan.ProjectID = types.StringValue(loc.ProjectID)
+	plan.SecretNames, diags = types.SetValueFrom(ctx, types.StringType, sortedKeys(values))
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceVaultSecretsSecrets) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state VaultSecretsSecrets
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loc := r.location(state.ProjectID)
+
+	secrets, err := clients.OpenVaultSecretsAppSecrets(ctx, r.client, loc, state.AppName.ValueString())
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			tflog.Info(ctx, "Vault Secrets app not found, removing secrets from state", map[string]interface{}{
+				"app_name": state.AppName.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading secrets", err.Error())
+		return
+	}
+
+	remote := make(map[string]string, len(secrets))
+	for _, secret := range secrets {
+		if secret.StaticVersion != nil {
+			remote[secret.Name] = secret.StaticVersion.Value
+		}
+	}
+
+	var managed []string
+	resp.Diagnostics.Append(state.SecretNames.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Secrets deleted outside of Terraform are dropped, so that they are
+	// created again on the next apply. Values are only tracked when they are
+	// stored in the state.
+	present := []string{}
+	values := map[string]string{}
+	for _, name := range managed {
+		value, ok := remote[name]
+		if !ok {
+			continue
+		}
+		present = append(present, name)
+		values[name] = value
+	}
+
+	var diags diag.Diagnostics
+	state.SecretNames, diags = types.SetValueFrom(ctx, types.StringType, present)
+	resp.Diagnostics.Append(diags...)
+	if !state.Secrets.IsNull() {
+		state.Secrets, diags = types.MapValueFrom(ctx, types.StringType, values)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceVaultSecretsSecrets) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VaultSecretsSecrets
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values, diags := configuredSecretValues(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managed []string
+	resp.Diagnostics.Append(state.SecretNames.ElementsAs(ctx, &managed, false)...)
+	stateValues := map[string]string{}
+	if !state.Secrets.IsNull() {
+		resp.Diagnostics.Append(state.Secrets.ElementsAs(ctx, &stateValues, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values cannot be compared with the previous ones, they are
+	// only written again when the version changes or the values move out of
+	// or into the state.
+	rewriteAll := !plan.SecretsWOVersion.Equal(state.SecretsWOVersion) ||
+		plan.Secrets.IsNull() != state.Secrets.IsNull()
+
+	isManaged := make(map[string]bool, len(managed))
+	for _, name := range managed {
+		isManaged[name] = true
+	}
+
+	upserts := map[string]string{}
+	for name, value := range values {
+		changed := !plan.Secrets.IsNull() && stateValues[name] != value
+		if rewriteAll || !isManaged[name] || changed {
+			upserts[name] = value
+		}
+	}
+
+	var deletes []string
+	for _, name := range managed {
+		if _, ok := values[name]; !ok {
+			deletes = append(deletes, name)
+		}
+	}
+
+	loc := r.location(plan.ProjectID)
+
+	if len(upserts) > 0 {
+		_, err := clients.BulkCreateVaultSecretsAppSecrets(ctx, r.client, loc, plan.AppName.ValueString(), upserts, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating secrets", err.Error())
+			return
+		}
+	}
+
+	if err := clients.DeleteVaultSecretsAppSecrets(ctx, r.client, loc, plan.AppName.ValueString(), deletes); err != nil {
+		resp.Diagnostics.AddError("Error deleting secrets", err.Error())
+		return
+	}
+
+	plan.ID = plan.AppName
+	plan.OrganizationID = types.StringValue(loc.OrganizationID)
+	plan.ProjectID = types.StringValue(loc.ProjectID)
+	plan.SecretNames, diags = types.SetValueFrom(ctx, types.StringType, sortedKeys(values))
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceVaultSecretsSecrets) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state VaultSecretsSecrets
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managed []string
+	resp.Diagnostics.Append(state.SecretNames.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := clients.DeleteVaultSecretsAppSecrets(ctx, r.client, r.location(state.ProjectID), state.AppName.ValueString(), managed)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting secrets", err.Error())
+		return
+	}
+}
+
+func (r *resourceVaultSecretsSecrets) location(projectID types.String) *sharedmodels.HashicorpCloudLocationLocation {
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: r.client.Config.OrganizationID,
+		ProjectID:      r.client.Config.ProjectID,
+	}
+	if !projectID.IsUnknown() && !projectID.IsNull() {
+		loc.ProjectID = projectID.ValueString()
+	}
+	return loc
+}
+
+// createAppSecrets creates the given secrets of an app. Secrets are not
+// overwritten, so that existing secrets are not silently taken over by the
+// resource. If the creation fails, the secrets already created are deleted
+// since the resource is not saved to the state.
+func createAppSecrets(ctx context.Context, client *clients.Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName string, values map[string]string) error {
+	created, err := clients.BulkCreateVaultSecretsAppSecrets(ctx, client, loc, appName, values, false)
+	if err == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(created))
+	for _, secret := range created {
+		if secret != nil {
+			names = append(names, secret.Name)
+		}
+	}
+	if deleteErr := clients.DeleteVaultSecretsAppSecrets(ctx, client, loc, appName, names); deleteErr != nil {
+		return fmt.Errorf("%w; the secrets already created could not be deleted: %v", err, deleteErr)
+	}
+	return err
+}
+
+// configuredSecrets returns the configured secrets map, which is either
+// `secrets` or the write-only `secrets_wo`.
+func configuredSecrets(ctx context.Context, config tfsdk.Config) (types.Map, diag.Diagnostics) {
+	var secrets, secretsWO types.Map
+	diags := config.GetAttribute(ctx, path.Root("secrets"), &secrets)
+	diags.Append(config.GetAttribute(ctx, path.Root("secrets_wo"), &secretsWO)...)
+	if !secrets.IsNull() {
+		return secrets, diags
+	}
+	return secretsWO, diags
+}
+
+// configuredSecretValues returns the configured values of the secrets, keyed
+// by secret name.
+func configuredSecretValues(ctx context.Context, config tfsdk.Config) (map[string]string, diag.Diagnostics) {
+	secrets, diags := configuredSecrets(ctx, config)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	values := map[string]string{}
+	if !secrets.IsNull() {
+		diags.Append(secrets.ElementsAs(ctx, &values, false)...)
+	}
+	return values, diags
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"sync"
+	"testing"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
+	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+func TestCreateAppSecrets_PartialFailure(t *testing.T) {
+	const prefix = "/secrets/2023-11-28/organizations/org-id/projects/project-id/apps/app"
+
+	var mu sync.Mutex
+	var batches int
+	var deleted []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+prefix+"/secret/kv:batch", func(w http.ResponseWriter, r *http.Request) {
+		var body secretmodels.SecretServiceBulkCreateAppKVSecretsBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unable to decode request: %v", err)
+		}
+
+		mu.Lock()
+		batches++
+		batch := batches
+		mu.Unlock()
+
+		// The second batch conflicts with an existing secret.
+		w.Header().Set("Content-Type", "application/json")
+		if batch > 1 {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"code": 6, "message": "secret already exists"}`))
+			return
+		}
+		secrets := make([]*secretmodels.Secrets20231128Secret, 0, len(body.Secrets))
+		for _, s := range body.Secrets {
+			secrets = append(secrets, &secretmodels.Secrets20231128Secret{Name: s.Name})
+		}
+		_ = json.NewEncoder(w).Encode(secretmodels.Secrets20231128BulkCreateAppKVSecretsResponse{Secrets: secrets})
+	})
+	mux.HandleFunc("DELETE "+prefix+"/secrets/{name}", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		deleted = append(deleted, r.PathValue("name"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client := &clients.Client{
+		VaultSecrets: secret_service.New(httptransport.New(u.Host, "", []string{u.Scheme}), strfmt.Default),
+	}
+	loc := &sharedmodels.HashicorpCloudLocationLocation{OrganizationID: "org-id", ProjectID: "project-id"}
+
+	// More secrets than fit in a single batch, so that the second batch fails
+	// after the first one was created.
+	values := map[string]string{}
+	for i := range 150 {
+		values[fmt.Sprintf("secret_%03d", i)] = "value"
+	}
+
+	err = createAppSecrets(context.Background(), client, loc, "app", values)
+	require.Error(t, err)
+	require.Equal(t, 2, batches)
+
+	// The secrets of the first batch are deleted.
+	expected := sortedKeys(values)[:100]
+	sort.Strings(deleted)
+	require.Equal(t, expected, deleted)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+)
+
+func TestAccVaultSecretsResourceSecrets(t *testing.T) {
+	testAppName := generateRandomSlug()
+	resourceName := "hcp_vault_secrets_secrets.example"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create two secrets in bulk
+			{
+				PreConfig: func() {
+					createTestApp(t, testAppName)
+				},
+				Config: secretsConfig(testAppName, `{
+					acc_tests_bulk_1 = "one"
+					acc_tests_bulk_2 = "two"
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "app_name", testAppName),
+					resource.TestCheckResourceAttr(resourceName, "secrets.acc_tests_bulk_1", "one"),
+					resource.TestCheckResourceAttr(resourceName, "secrets.acc_tests_bulk_2", "two"),
+					resource.TestCheckResourceAttr(resourceName, "secret_names.#", "2"),
+				),
+			},
+			// Update a value, add a secret and remove another one.
+			// Validate that the removed secret is deleted from the app.
+			{
+				Config: secretsConfig(testAppName, `{
+					acc_tests_bulk_1 = "updated"
+					acc_tests_bulk_3 = "three"
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secrets.acc_tests_bulk_1", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "secrets.acc_tests_bulk_3", "three"),
+					resource.TestCheckNoResourceAttr(resourceName, "secrets.acc_tests_bulk_2"),
+					resource.TestCheckResourceAttr(resourceName, "secret_names.#", "2"),
+					testAccCheckSecretsExist(t, testAppName, map[string]bool{
+						"acc_tests_bulk_1": true,
+						"acc_tests_bulk_2": false,
+						"acc_tests_bulk_3": true,
+					}),
+				),
+			},
+			// Secrets deleted outside of Terraform are created again
+			{
+				PreConfig: func() {
+					deleteTestAppSecret(t, testAppName, "acc_tests_bulk_3")
+				},
+				Config: secretsConfig(testAppName, `{
+					acc_tests_bulk_1 = "updated"
+					acc_tests_bulk_3 = "three"
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secret_names.#", "2"),
+					testAccCheckSecretsExist(t, testAppName, map[string]bool{
+						"acc_tests_bulk_3": true,
+					}),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if secretExists(t, testAppName, "acc_tests_bulk_1") {
+				return fmt.Errorf("test secret acc_tests_bulk_1 was not destroyed")
+			}
+			deleteTestApp(t, testAppName)
+			return nil
+		},
+	})
+}
+
+func TestAccVaultSecretsResourceSecrets_WriteOnly(t *testing.T) {
+	testAppName := generateRandomSlug()
+	resourceName := "hcp_vault_secrets_secrets.example"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					createTestApp(t, testAppName)
+				},
+				Config: secretsWOConfig(testAppName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "secrets_wo.%"),
+					resource.TestCheckNoResourceAttr(resourceName, "secrets.%"),
+					resource.TestCheckResourceAttr(resourceName, "secret_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "secret_names.*", "acc_tests_bulk_wo"),
+					testAccCheckSecretsExist(t, testAppName, map[string]bool{
+						"acc_tests_bulk_wo": true,
+					}),
+				),
+			},
+			// Bumping the version writes the values again
+			{
+				Config: secretsWOConfig(testAppName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secrets_wo_version", "2"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if secretExists(t, testAppName, "acc_tests_bulk_wo") {
+				return fmt.Errorf("test secret acc_tests_bulk_wo was not destroyed")
+			}
+			deleteTestApp(t, testAppName)
+			return nil
+		},
+	})
+}
+
+func testAccCheckSecretsExist(t *testing.T, appName string, secrets map[string]bool) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		for secretName, shouldExist := range secrets {
+			if exists := secretExists(t, appName, secretName); exists != shouldExist {
+				return fmt.Errorf("expected secret %s to exist: %t, got: %t", secretName, shouldExist, exists)
+			}
+		}
+		return nil
+	}
+}
+
+func secretsConfig(appName, secrets string) string {
+	return fmt.Sprintf(`
+	resource "hcp_vault_secrets_secrets" "example" {
+		app_name = %q
+		secrets = %s
+	}`, appName, secrets)
+}
+
+func secretsWOConfig(appName string, version int) string {
+	return fmt.Sprintf(`
+	resource "hcp_vault_secrets_secrets" "example" {
+		app_name = %q
+		secrets_wo = {
+			acc_tests_bulk_wo = "write only"
+		}
+		secrets_wo_version = %d
+	}`, appName, version)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
@@ -45,6 +46,7 @@ var _ resource.Resource = &resourceVaultSecretsSync{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsSync{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsSync{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsSync{}
+var _ resource.ResourceWithIdentity = &resourceVaultSecretsSync{}
 
 func NewVaultSecretsSyncResource() resource.Resource {
 	return &resourceVaultSecretsSync{}
@@ -147,6 +149,8 @@ func (r *resourceVaultSecretsSync) ModifyPlan(ctx context.Context, req resource.
 }
 
 func (r *resourceVaultSecretsSync) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
+
 	resp.Diagnostics.Append(decorateOperation[*Sync](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		sync, ok := i.(*Sync)
 		if !ok {
@@ -203,6 +207,7 @@ func (r *resourceVaultSecretsSync) Create(ctx context.Context, req resource.Crea
 
 		return response.Payload.Sync, nil
 	})...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *resourceVaultSecretsSync) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -226,10 +231,12 @@ func (r *resourceVaultSecretsSync) Delete(ctx context.Context, req resource.Dele
 	})...)
 }
 
+func (r *resourceVaultSecretsSync) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(vaultSecretsIdentity("name")...)
+}
+
 func (r *resourceVaultSecretsSync) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), r.client.Config.OrganizationID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), r.client.Config.ProjectID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	importVaultSecretsState(ctx, r.client, req, resp, "name")
 }
 
 var _ hvsResource = &Sync{}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"testing"
+
+	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMongoDBAtlasUserFromDetails(t *testing.T) {
+	withRoles := &mongoDBAtlasUser{
+		DatabaseName: types.StringValue("db"),
+		Roles:        []types.String{types.StringValue("read")},
+	}
+	withDatabaseRoles := &mongoDBAtlasUser{
+		DatabaseRoles: []mongoDBRole{{DatabaseName: types.StringValue("db"), RoleName: types.StringValue("read")}},
+	}
+
+	cases := []struct {
+		name     string
+		prior    *mongoDBAtlasUser
+		roles    []*secretmodels.Secrets20231128MongoDBRole
+		expected *mongoDBAtlasUser
+	}{
+		{
+			name: "no roles",
+			expected: &mongoDBAtlasUser{
+				ProjectID:    types.StringValue("group-123"),
+				DatabaseName: types.StringNull(),
+				Roles:        []types.String{},
+			},
+		},
+		{
+			name: "roles on a single database",
+			roles: []*secretmodels.Secrets20231128MongoDBRole{
+				{DatabaseName: "db", RoleName: "read"},
+				{DatabaseName: "db", RoleName: "readWrite"},
+			},
+			expected: &mongoDBAtlasUser{
+				ProjectID:    types.StringValue("group-123"),
+				DatabaseName: types.StringValue("db"),
+				Roles:        []types.String{types.StringValue("read"), types.StringValue("readWrite")},
+			},
+		},
+		{
+			name:  "roles on a single database set with roles",
+			prior: withRoles,
+			roles: []*secretmodels.Secrets20231128MongoDBRole{
+				{DatabaseName: "db", RoleName: "read"},
+			},
+			expected: &mongoDBAtlasUser{
+				ProjectID:    types.StringValue("group-123"),
+				DatabaseName: types.StringValue("db"),
+				Roles:        []types.String{types.StringValue("read")},
+			},
+		},
+		{
+			name:  "roles on a single database set with database_roles",
+			prior: withDatabaseRoles,
+			roles: []*secretmodels.Secrets20231128MongoDBRole{
+				{DatabaseName: "db", RoleName: "read"},
+				{DatabaseName: "db", RoleName: "readWrite"},
+			},
+			expected: &mongoDBAtlasUser{
+				ProjectID:    types.StringValue("group-123"),
+				DatabaseName: types.StringNull(),
+				DatabaseRoles: []mongoDBRole{
+					{DatabaseName: types.StringValue("db"), RoleName: types.StringValue("read")},
+					{DatabaseName: types.StringValue("db"), RoleName: types.StringValue("readWrite")},
+				},
+			},
+		},
+		{
+			name:  "no roles set with database_roles",
+			prior: withDatabaseRoles,
+			expected: &mongoDBAtlasUser{
+				ProjectID:     types.StringValue("group-123"),
+				DatabaseName:  types.StringNull(),
+				DatabaseRoles: []mongoDBRole{},
+			},
+		},
+		{
+			name:  "roles on several databases",
+			prior: withRoles,
+			roles: []*secretmodels.Secrets20231128MongoDBRole{
+				{DatabaseName: "db1", RoleName: "read"},
+				{DatabaseName: "db2", RoleName: "read"},
+				{DatabaseName: "db2", RoleName: "readWrite"},
+			},
+			expected: &mongoDBAtlasUser{
+				ProjectID:    types.StringValue("group-123"),
+				DatabaseName: types.StringNull(),
+				DatabaseRoles: []mongoDBRole{
+					{DatabaseName: types.StringValue("db1"), RoleName: types.StringValue("read")},
+					{DatabaseName: types.StringValue("db2"), RoleName: types.StringValue("read")},
+					{DatabaseName: types.StringValue("db2"), RoleName: types.StringValue("readWrite")},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			user := mongoDBAtlasUserFromDetails(c.prior, &secretmodels.Secrets20231128MongoDBAtlasSecretDetails{
+				MongodbGroupID: "group-123",
+				MongodbRoles:   c.roles,
+			})
+			require.Equal(t, c.expected, user)
+
+			// Converting the user back gives the roles it was read from.
+			roles := c.roles
+			if roles == nil {
+				roles = []*secretmodels.Secrets20231128MongoDBRole{}
+			}
+			require.Equal(t, roles, user.apiRoles())
+		})
+	}
+}
+
+func TestMongoDBRolesRequireReplace(t *testing.T) {
+	ctx := context.Background()
+
+	roleType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"database_name": types.StringType,
+		"role_name":     types.StringType,
+	}}
+	userType := map[string]attr.Type{
+		"project_id":     types.StringType,
+		"database_name":  types.StringType,
+		"roles":          types.ListType{ElemType: types.StringType},
+		"database_roles": types.ListType{ElemType: roleType},
+	}
+	withRoles := func(database string, roles ...attr.Value) types.Object {
+		return types.ObjectValueMust(userType, map[string]attr.Value{
+			"project_id":     types.StringValue("group-123"),
+			"database_name":  types.StringValue(database),
+			"roles":          types.ListValueMust(types.StringType, roles),
+			"database_roles": types.ListNull(roleType),
+		})
+	}
+	withDatabaseRoles := func(database string, roles ...string) types.Object {
+		values := []attr.Value{}
+		for _, r := range roles {
+			values = append(values, types.ObjectValueMust(roleType.AttrTypes, map[string]attr.Value{
+				"database_name": types.StringValue(database),
+				"role_name":     types.StringValue(r),
+			}))
+		}
+		return types.ObjectValueMust(userType, map[string]attr.Value{
+			"project_id":     types.StringValue("group-123"),
+			"database_name":  types.StringNull(),
+			"roles":          types.ListNull(types.StringType),
+			"database_roles": types.ListValueMust(roleType, values),
+		})
+	}
+
+	cases := []struct {
+		name            string
+		state, plan     types.Object
+		requiresReplace bool
+	}{
+		{
+			name:  "same roles set with database_roles",
+			state: withRoles("db", types.StringValue("read")),
+			plan:  withDatabaseRoles("db", "read"),
+		},
+		{
+			name:  "same roles set with roles",
+			state: withDatabaseRoles("db", "read"),
+			plan:  withRoles("db", types.StringValue("read")),
+		},
+		{
+			name:            "other database",
+			state:           withRoles("db", types.StringValue("read")),
+			plan:            withDatabaseRoles("other", "read"),
+			requiresReplace: true,
+		},
+		{
+			name:            "other roles",
+			state:           withDatabaseRoles("db", "read"),
+			plan:            withDatabaseRoles("db", "read", "readWrite"),
+			requiresReplace: true,
+		},
+		{
+			name:            "unknown roles",
+			state:           withRoles("db", types.StringValue("read")),
+			plan:            withRoles("db", types.StringUnknown()),
+			requiresReplace: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// The state and plan of the resource are only checked to be set.
+			resource := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+			req := planmodifier.ObjectRequest{
+				State:       tfsdk.State{Raw: resource},
+				Plan:        tfsdk.Plan{Raw: resource},
+				StateValue:  c.state,
+				PlanValue:   c.plan,
+				ConfigValue: c.plan,
+			}
+			resp := &planmodifier.ObjectResponse{PlanValue: c.plan}
+			mongoDBRolesRequireReplace().PlanModifyObject(ctx, req, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			require.Equal(t, c.requiresReplace, resp.RequiresReplace)
+		})
+	}
+}
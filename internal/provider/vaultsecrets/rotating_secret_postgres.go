@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
+	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+var _ rotatingSecret = &postgresRotatingSecret{}
+
+type postgresRotatingSecret struct{}
+
+func (s *postgresRotatingSecret) read(ctx context.Context, client secret_service.ClientService, secret *RotatingSecret) (any, error) {
+	response, err := client.GetPostgresRotatingSecretConfig(
+		secret_service.NewGetPostgresRotatingSecretConfigParamsWithContext(ctx).
+			WithOrganizationID(secret.OrganizationID.ValueString()).
+			WithProjectID(secret.ProjectID.ValueString()).
+			WithAppName(secret.AppName.ValueString()).
+			WithName(secret.Name.ValueString()), nil)
+	if err != nil && !clients.IsResponseCodeNotFound(err) {
+		return nil, err
+	}
+	if response == nil || response.Payload == nil {
+		return nil, nil
+	}
+	return response.Payload.Config, nil
+}
+
+func (s *postgresRotatingSecret) create(ctx context.Context, client secret_service.ClientService, secret *RotatingSecret) (any, error) {
+	if secret.PostgresUserPassword == nil {
+		return nil, fmt.Errorf("missing required field 'postgres_user_password'")
+	}
+
+	response, err := client.CreatePostgresRotatingSecret(
+		secret_service.NewCreatePostgresRotatingSecretParamsWithContext(ctx).
+			WithOrganizationID(secret.OrganizationID.ValueString()).
+			WithProjectID(secret.ProjectID.ValueString()).
+			WithAppName(secret.AppName.ValueString()).
+			WithBody(&secretmodels.SecretServiceCreatePostgresRotatingSecretBody{
+				IntegrationName:    secret.IntegrationName.ValueString(),
+				RotationPolicyName: secret.RotationPolicyName.ValueString(),
+				PostgresParams: &secretmodels.Secrets20231128PostgresParams{
+					Usernames: secret.postgresUsernames,
+				},
+				Name: secret.Name.ValueString(),
+			}),
+		nil)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil || response.Payload == nil {
+		return nil, nil
+	}
+	return response.Payload.Config, nil
+}
+
+func (s *postgresRotatingSecret) update(ctx context.Context, client secret_service.ClientService, secret *RotatingSecret) (any, error) {
+	if secret.PostgresUserPassword == nil {
+		return nil, fmt.Errorf("missing required field 'postgres_user_password'")
+	}
+
+	response, err := client.UpdatePostgresRotatingSecret(
+		secret_service.NewUpdatePostgresRotatingSecretParamsWithContext(ctx).
+			WithOrganizationID(secret.OrganizationID.ValueString()).
+			WithProjectID(secret.ProjectID.ValueString()).
+			WithAppName(secret.AppName.ValueString()).
+			WithName(secret.Name.ValueString()).
+			WithBody(&secretmodels.SecretServiceUpdatePostgresRotatingSecretBody{
+				RotationPolicyName: secret.RotationPolicyName.ValueString(),
+				PostgresParams: &secretmodels.Secrets20231128PostgresParams{
+					Usernames: secret.postgresUsernames,
+				},
+			}),
+		nil)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil || response.Payload == nil {
+		return nil, nil
+	}
+	return response.Payload.Config, nil
+}
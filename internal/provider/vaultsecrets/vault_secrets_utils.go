@@ -7,9 +7,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 
+	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -18,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 )
 
 type Provider string
@@ -30,6 +37,7 @@ const (
 	ProviderMongoDBAtlas Provider = "mongodb-atlas"
 	ProviderTwilio       Provider = "twilio"
 	ProviderGitLab       Provider = "gitlab"
+	ProviderPostgres     Provider = "postgres"
 )
 
 func (p Provider) String() string {
@@ -45,6 +53,7 @@ func ProviderStrings() []string {
 		string(ProviderMongoDBAtlas),
 		string(ProviderTwilio),
 		string(ProviderGitLab),
+		string(ProviderPostgres),
 	}
 }
 
@@ -114,7 +123,7 @@ var managedSecretAttributes = map[string]schema.Attribute{
 		},
 	},
 	"secret_provider": schema.StringAttribute{
-		Description: "The third party platform the dynamic credentials give access to. One of `aws` or `gcp`.",
+		Description: "The third party platform the credentials give access to. Must match the provider of the integration, for example `aws`, `azure`, `confluent`, `gcp`, `mongodb-atlas` or `postgres`.",
 		Required:    true,
 		PlanModifiers: []planmodifier.String{
 			stringplanmodifier.RequiresReplace(),
@@ -142,6 +151,77 @@ var managedSecretAttributes = map[string]schema.Attribute{
 	},
 }
 
+// principalName returns the email of a principal, or its name for principals
+// without an email such as service principals.
+func principalName(p *secretmodels.Secrets20231128Principal) types.String {
+	switch {
+	case p == nil:
+		return types.StringNull()
+	case p.Email != "":
+		return types.StringValue(p.Email)
+	default:
+		return types.StringValue(p.Name)
+	}
+}
+
+// vaultSecretsIdentity returns the identity of a Vault Secrets resource: its
+// project, which defaults to the project of the provider, and the given name
+// attributes.
+func vaultSecretsIdentity(nameAttrs ...string) []hcpvalidator.ImportIDPart {
+	parts := []hcpvalidator.ImportIDPart{
+		{Name: "project_id", Optional: true, Validators: []validator.String{hcpvalidator.UUID()}},
+	}
+	for _, name := range nameAttrs {
+		parts = append(parts, hcpvalidator.ImportIDPart{Name: name})
+	}
+	return parts
+}
+
+// importVaultSecretsState sets the location and the given name attributes of a
+// Vault Secrets resource, from its identity or from an import ID made of the
+// names separated by slashes and optionally prefixed by the project ID, such as
+// [project_id/]app_name/secret_name. The rest of the state is populated by the
+// read following the import.
+func importVaultSecretsState(ctx context.Context, client *clients.Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse, nameAttrs ...string) {
+	var values []string
+	if identity.ImportedWithIdentity(req) {
+		var diags diag.Diagnostics
+		values, diags = identity.ImportID(ctx, req, vaultSecretsIdentity(nameAttrs...)...)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		values = strings.Split(req.ID, "/")
+		if len(values) == len(nameAttrs) {
+			values = append([]string{""}, values...)
+		}
+		if len(values) != len(nameAttrs)+1 || slices.Contains(values[1:], "") {
+			format := strings.Join(nameAttrs, "/")
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				fmt.Sprintf("Expected an import ID in the format %s or project_id/%s, got: %q", format, format, req.ID),
+			)
+			return
+		}
+		resp.Diagnostics.Append(hcpvalidator.ValidateImportIDPart(ctx, vaultSecretsIdentity()[0], values[0])...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	projectID := values[0]
+	if projectID == "" {
+		projectID = client.Config.ProjectID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), client.Config.OrganizationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	for i, name := range nameAttrs {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), values[i+1])...)
+	}
+}
+
 // resourceFunc is used to get the appropriate Terraform Vault Secrets integration representation either from the plan (create, update) or the state (read, delete)
 type resourceFunc func(ctx context.Context, target interface{}) diag.Diagnostics
 
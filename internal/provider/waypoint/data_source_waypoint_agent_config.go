@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waypoint
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// agentConfigFile is the file name the agent configuration is expected to be
+// saved as by run_command.
+const agentConfigFile = "agent.hcl"
+
+var _ datasource.DataSource = &DataSourceAgentConfig{}
+var _ datasource.DataSourceWithConfigValidators = &DataSourceAgentConfig{}
+
+func (d DataSourceAgentConfig) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("client_id"),
+			path.MatchRoot("client_secret"),
+		),
+	}
+}
+
+type DataSourceAgentConfig struct {
+	client *clients.Client
+}
+
+type DataSourceAgentConfigModel struct {
+	GroupName    types.String       `tfsdk:"group_name"`
+	ProjectID    types.String       `tfsdk:"project_id"`
+	OrgID        types.String       `tfsdk:"organization_id"`
+	Commands     types.Map          `tfsdk:"commands"`
+	ClientID     types.String       `tfsdk:"client_id"`
+	ClientSecret types.String       `tfsdk:"client_secret"`
+	Actions      []agentGroupAction `tfsdk:"actions"`
+	Config       types.String       `tfsdk:"config"`
+	Environment  types.Map          `tfsdk:"environment"`
+	RunCommand   types.String       `tfsdk:"run_command"`
+}
+
+func NewAgentConfigDataSource() datasource.DataSource {
+	return &DataSourceAgentConfig{}
+}
+
+func (d *DataSourceAgentConfig) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_waypoint_agent_config"
+}
+
+func (d *DataSourceAgentConfig) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Waypoint Agent Config data source renders the configuration for a self-hosted " +
+			"Waypoint agent serving an Agent Group. The configuration declares the group and one `action` block " +
+			"for every operation used by the agent Actions routed to the group. When service principal " +
+			"credentials are given, the environment needed to authenticate the agent is rendered as well.",
+		Attributes: map[string]schema.Attribute{
+			"group_name": schema.StringAttribute{
+				Description: "The name of the Agent Group the agent serves.",
+				Required:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the Agent Group is located.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the HCP organization where the Agent Group is located.",
+				Computed:    true,
+			},
+			"commands": schema.MapAttribute{
+				Description: "The command run by the agent for each operation, keyed by operation ID. " +
+					"Operations without a command run `./actions/<operation_id>.sh`.",
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+			"client_id": schema.StringAttribute{
+				Description: "The client ID of the service principal the agent authenticates as. " +
+					"Must be set together with `client_secret`.",
+				Optional: true,
+			},
+			"client_secret": schema.StringAttribute{
+				Description: "The client secret of the service principal the agent authenticates as. " +
+					"Must be set together with `client_id`.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"actions": schema.ListNestedAttribute{
+				Description: "The agent Actions routed to the Agent Group.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: agentGroupActionAttributes(),
+				},
+			},
+			"config": schema.StringAttribute{
+				Description: "The agent configuration, in HCL.",
+				Computed:    true,
+			},
+			"environment": schema.MapAttribute{
+				Description: "The environment variables the agent needs to authenticate with HCP and " +
+					"locate the Agent Group.",
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"run_command": schema.StringAttribute{
+				Description: fmt.Sprintf("The command that starts the agent, assuming `config` is saved as `%s`.",
+					agentConfigFile),
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *DataSourceAgentConfig) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *DataSourceAgentConfig) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceAgentConfigModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.client
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HCP Client",
+			"Expected configured HCP client. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	projectID := client.Config.ProjectID
+	if !data.ProjectID.IsNull() {
+		projectID = data.ProjectID.ValueString()
+	}
+	orgID := client.Config.OrganizationID
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: orgID,
+		ProjectID:      projectID,
+	}
+
+	// Make sure the group exists, an agent for a missing group would never
+	// receive any operations.
+	group, err := clients.GetAgentGroup(ctx, client, loc, data.GroupName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Waypoint Agent Group", err.Error())
+		return
+	}
+
+	actions, err := clients.ListAgentGroupActions(ctx, client, loc, group.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing Waypoint Agent Group Actions", err.Error())
+		return
+	}
+
+	commands := map[string][]string{}
+	if !data.Commands.IsNull() {
+		resp.Diagnostics.Append(data.Commands.ElementsAs(ctx, &commands, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data.ProjectID = types.StringValue(projectID)
+	data.OrgID = types.StringValue(orgID)
+	data.Actions = readAgentGroupActions(actions)
+	data.Config = types.StringValue(renderAgentConfig(group.Name, data.Actions, commands))
+	data.RunCommand = types.StringValue("hcp waypoint agent run --config=" + agentConfigFile)
+
+	env := map[string]string{
+		"HCP_ORGANIZATION_ID": orgID,
+		"HCP_PROJECT_ID":      projectID,
+	}
+	if !data.ClientID.IsNull() {
+		env["HCP_CLIENT_ID"] = data.ClientID.ValueString()
+		env["HCP_CLIENT_SECRET"] = data.ClientSecret.ValueString()
+	}
+	environment, diags := types.MapValueFrom(ctx, types.StringType, env)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Environment = environment
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// renderAgentConfig renders the agent configuration for a group. Every
+// operation is declared once, even if several Actions use it, and operations
+// are sorted so that the output is stable.
+func renderAgentConfig(groupName string, actions []agentGroupAction, commands map[string][]string) string {
+	operations := map[string]bool{}
+	for _, action := range actions {
+		operations[action.OperationID.ValueString()] = true
+	}
+	operationIDs := make([]string, 0, len(operations))
+	for id := range operations {
+		operationIDs = append(operationIDs, id)
+	}
+	sort.Strings(operationIDs)
+
+	f := hclwrite.NewEmptyFile()
+	group := f.Body().AppendNewBlock("group", []string{groupName})
+	for i, id := range operationIDs {
+		if i > 0 {
+			group.Body().AppendNewline()
+		}
+
+		command, ok := commands[id]
+		if !ok {
+			command = []string{fmt.Sprintf("./actions/%s.sh", id)}
+		}
+		args := make([]cty.Value, 0, len(command))
+		for _, arg := range command {
+			args = append(args, cty.StringVal(arg))
+		}
+		commandVal := cty.ListValEmpty(cty.String)
+		if len(args) > 0 {
+			commandVal = cty.ListVal(args)
+		}
+
+		action := group.Body().AppendNewBlock("action", []string{id})
+		run := action.Body().AppendNewBlock("run", nil)
+		run.Body().SetAttributeValue("command", commandVal)
+	}
+
+	return string(f.Bytes())
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waypoint
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderAgentConfig(t *testing.T) {
+	cases := []struct {
+		name     string
+		actions  []agentGroupAction
+		commands map[string][]string
+		expected string
+	}{
+		{
+			name: "no actions",
+			expected: `group "my-group" {
+}
+`,
+		},
+		{
+			name:    "default command",
+			actions: []agentGroupAction{agentAction("deploy")},
+			expected: `group "my-group" {
+  action "deploy" {
+    run {
+      command = ["./actions/deploy.sh"]
+    }
+  }
+}
+`,
+		},
+		{
+			name:    "sorted and deduplicated operations",
+			actions: []agentGroupAction{agentAction("restart"), agentAction("deploy"), agentAction("restart")},
+			expected: `group "my-group" {
+  action "deploy" {
+    run {
+      command = ["./actions/deploy.sh"]
+    }
+  }
+
+  action "restart" {
+    run {
+      command = ["./actions/restart.sh"]
+    }
+  }
+}
+`,
+		},
+		{
+			name:    "configured commands",
+			actions: []agentGroupAction{agentAction("deploy"), agentAction("noop")},
+			commands: map[string][]string{
+				"deploy": {"kubectl", "rollout", "restart", "deployment/\"web\""},
+				"noop":   {},
+				"unused": {"true"},
+			},
+			expected: `group "my-group" {
+  action "deploy" {
+    run {
+      command = ["kubectl", "rollout", "restart", "deployment/\"web\""]
+    }
+  }
+
+  action "noop" {
+    run {
+      command = []
+    }
+  }
+}
+`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.expected, renderAgentConfig("my-group", c.actions, c.commands))
+		})
+	}
+}
+
+func agentAction(operationID string) agentGroupAction {
+	return agentGroupAction{OperationID: types.StringValue(operationID)}
+}
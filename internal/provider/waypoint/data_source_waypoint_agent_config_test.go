@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waypoint_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/waypoint"
+)
+
+func TestAcc_Waypoint_Agent_Config_DataSource_basic(t *testing.T) {
+	t.Parallel()
+	var agentGroupModel waypoint.AgentGroupResourceModel
+	resourceName := "hcp_waypoint_agent_group.test"
+	actionsDataSourceName := "data.hcp_waypoint_agent_group_actions.test"
+	configDataSourceName := "data.hcp_waypoint_agent_config.test"
+	agentGroupName := generateRandomName()
+	actionName := generateRandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckWaypointAgentGroupDestroy(t, &agentGroupModel),
+		Steps: []resource.TestStep{
+			{
+				// establish the base agent group and an action routed to it
+				Config: testAgentGroupWithAction(agentGroupName, actionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWaypointAgentGroupExists(t, resourceName, &agentGroupModel),
+				),
+			},
+			{
+				// add the data sources describing the group's agent
+				Config: testDataAgentConfig(agentGroupName, actionName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(actionsDataSourceName, "actions.#", "1"),
+					resource.TestCheckResourceAttr(actionsDataSourceName, "actions.0.name", actionName),
+					resource.TestCheckResourceAttr(actionsDataSourceName, "actions.0.operation_id", "deploy"),
+					resource.TestMatchResourceAttr(configDataSourceName, "config",
+						regexp.MustCompile(fmt.Sprintf(`group "%s" \{`, agentGroupName))),
+					resource.TestMatchResourceAttr(configDataSourceName, "config",
+						regexp.MustCompile(`command = \["./deploy.sh", "--yes"\]`)),
+					resource.TestCheckResourceAttr(configDataSourceName, "environment.HCP_CLIENT_ID", "test-client-id"),
+				),
+			},
+		},
+	})
+}
+
+func testAgentGroupWithAction(agentGroupName, actionName string) string {
+	return fmt.Sprintf(`%s
+resource "hcp_waypoint_action" "test" {
+  name        = "%s"
+  description = "Test action"
+  request = {
+    agent = {
+      operation_id = "deploy"
+      group        = hcp_waypoint_agent_group.test.name
+    }
+  }
+}`, testAgentGroup(agentGroupName), actionName)
+}
+
+func testDataAgentConfig(agentGroupName, actionName string) string {
+	return fmt.Sprintf(`%s
+data "hcp_waypoint_agent_group_actions" "test" {
+  group_name = hcp_waypoint_agent_group.test.name
+}
+
+data "hcp_waypoint_agent_config" "test" {
+  group_name    = hcp_waypoint_agent_group.test.name
+  client_id     = "test-client-id"
+  client_secret = "test-client-secret"
+  commands = {
+    deploy = ["./deploy.sh", "--yes"]
+  }
+}`, testAgentGroupWithAction(agentGroupName, actionName))
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waypoint
+
+import (
+	"context"
+	"fmt"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	waypoint_models "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+var _ datasource.DataSource = &DataSourceAgentGroupActions{}
+
+type DataSourceAgentGroupActions struct {
+	client *clients.Client
+}
+
+type DataSourceAgentGroupActionsModel struct {
+	GroupName types.String       `tfsdk:"group_name"`
+	ProjectID types.String       `tfsdk:"project_id"`
+	OrgID     types.String       `tfsdk:"organization_id"`
+	Actions   []agentGroupAction `tfsdk:"actions"`
+}
+
+// agentGroupAction is an agent Action routed to an Agent Group.
+type agentGroupAction struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	OperationID types.String `tfsdk:"operation_id"`
+}
+
+func NewAgentGroupActionsDataSource() datasource.DataSource {
+	return &DataSourceAgentGroupActions{}
+}
+
+func (d *DataSourceAgentGroupActions) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_waypoint_agent_group_actions"
+}
+
+func (d *DataSourceAgentGroupActions) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Waypoint Agent Group Actions data source lists the agent Actions routed to an Agent Group.",
+		Attributes: map[string]schema.Attribute{
+			"group_name": schema.StringAttribute{
+				Description: "The name of the Agent Group.",
+				Required:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the Agent Group is located.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the HCP organization where the Agent Group is located.",
+				Computed:    true,
+			},
+			"actions": schema.ListNestedAttribute{
+				Description: "The agent Actions routed to the Agent Group.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: agentGroupActionAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// agentGroupActionAttributes returns the attributes of an agent Action, shared
+// by the data sources describing the Actions of an Agent Group.
+func agentGroupActionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the Action.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the Action.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "The description of the Action.",
+			Computed:    true,
+		},
+		"operation_id": schema.StringAttribute{
+			Description: "The identifying name of the operation in the agent config file.",
+			Computed:    true,
+		},
+	}
+}
+
+func (d *DataSourceAgentGroupActions) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *DataSourceAgentGroupActions) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceAgentGroupActionsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.client
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HCP Client",
+			"Expected configured HCP client. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	projectID := client.Config.ProjectID
+	if !data.ProjectID.IsNull() {
+		projectID = data.ProjectID.ValueString()
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: client.Config.OrganizationID,
+		ProjectID:      projectID,
+	}
+
+	actions, err := clients.ListAgentGroupActions(ctx, client, loc, data.GroupName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing Waypoint Agent Group Actions", err.Error())
+		return
+	}
+
+	data.ProjectID = types.StringValue(projectID)
+	data.OrgID = types.StringValue(client.Config.OrganizationID)
+	data.Actions = readAgentGroupActions(actions)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func readAgentGroupActions(actions []*waypoint_models.HashicorpCloudWaypointV20241122ActionConfig) []agentGroupAction {
+	result := make([]agentGroupAction, 0, len(actions))
+	for _, action := range actions {
+		a := agentGroupAction{
+			ID:          types.StringValue(action.ID),
+			Name:        types.StringValue(action.Name),
+			Description: types.StringNull(),
+			OperationID: types.StringValue(action.Request.Agent.Op.ID),
+		}
+		if action.Description != "" {
+			a.Description = types.StringValue(action.Description)
+		}
+		result = append(result, a)
+	}
+	return result
+}
@@ -51,6 +51,7 @@ type DataSourceTemplateModel struct {
 	VariableOptions             []*tfcVariableOption `tfsdk:"variable_options"`
 	TerraformExecutionMode      types.String         `tfsdk:"terraform_execution_mode"`
 	TerraformAgentPoolID        types.String         `tfsdk:"terraform_agent_pool_id"`
+	Version                     types.String         `tfsdk:"version"`
 }
 
 func NewTemplateDataSource() datasource.DataSource {
@@ -163,6 +164,11 @@ func (d *DataSourceTemplate) Schema(ctx context.Context, req datasource.SchemaRe
 				Computed:    true,
 				Description: "The ID of the Terraform no-code module to use for running Terraform operations",
 			},
+			"version": schema.StringAttribute{
+				Computed: true,
+				Description: "The version of the Template. It changes whenever the no-code module or " +
+					"variable options of the Template change.",
+			},
 		},
 	}
 }
@@ -225,6 +231,7 @@ func (d *DataSourceTemplate) Read(ctx context.Context, req datasource.ReadReques
 	data.Summary = types.StringValue(appTemplate.Summary)
 	data.TerraformNoCodeModuleSource = types.StringValue(appTemplate.ModuleSource)
 	data.TerraformNoCodeModuleID = types.StringValue(appTemplate.ModuleID)
+	data.Version = types.StringValue(templateVersion(appTemplate))
 
 	if appTemplate.TerraformCloudWorkspaceDetails != nil {
 		tfcWorkspace := &tfcWorkspace{
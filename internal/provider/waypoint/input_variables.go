@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waypoint
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	waypoint_models "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+// inputVariableSchema describes the input variables accepted by a Template or
+// an Add-on Definition.
+type inputVariableSchema struct {
+	// variables holds the known variables by name. The variable options of
+	// the Template or Add-on Definition take precedence over the variables
+	// of the no-code module.
+	variables map[string]*waypoint_models.HashicorpCloudWaypointV20241122TFModuleVariable
+
+	// complete is true if the variables of the no-code module could be
+	// fetched, in which case variables not in the schema are rejected.
+	complete bool
+}
+
+// newInputVariableSchema fetches the variables of the no-code module used by a
+// Template or Add-on Definition and combines them with its variable options.
+// If the module variables cannot be fetched, only the variable options are
+// used.
+func newInputVariableSchema(
+	ctx context.Context,
+	client *clients.Client,
+	loc *sharedmodels.HashicorpCloudLocationLocation,
+	moduleSource, moduleID string,
+	varOpts []*waypoint_models.HashicorpCloudWaypointV20241122TFModuleVariable,
+) inputVariableSchema {
+	s := inputVariableSchema{
+		variables: map[string]*waypoint_models.HashicorpCloudWaypointV20241122TFModuleVariable{},
+	}
+
+	moduleVars, err := clients.GetModuleVariables(ctx, client, loc, moduleSource, moduleID)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to fetch no-code module variables, only validating against variable options", map[string]interface{}{
+			"module_source": moduleSource,
+			"error":         err.Error(),
+		})
+	} else {
+		s.complete = len(moduleVars) > 0
+		for _, v := range moduleVars {
+			if v != nil {
+				s.variables[v.Name] = v
+			}
+		}
+	}
+
+	for _, v := range varOpts {
+		if v == nil {
+			continue
+		}
+		if moduleVar, ok := s.variables[v.Name]; ok && v.VariableType == "" {
+			// Variable options may omit the type, fall back to the module's
+			v = &waypoint_models.HashicorpCloudWaypointV20241122TFModuleVariable{
+				Name:         v.Name,
+				Options:      v.Options,
+				UserEditable: v.UserEditable,
+				VariableType: moduleVar.VariableType,
+			}
+		}
+		s.variables[v.Name] = v
+	}
+
+	return s
+}
+
+// validate checks the given input variables against the schema, reporting
+// problems on attrPath:
+//   - every variable must be known, when the module variables are known
+//   - variables set by the Template or Add-on Definition cannot be set
+//   - the variable type must match, and the value must be valid for it
+//   - the value must be one of the options, if options are given
+//   - user editable variables with more than one option must be set, as
+//     HCP Waypoint cannot choose between them
+//
+// Variables with unknown names or values are skipped.
+func (s inputVariableSchema) validate(attrPath path.Path, inputVars []InputVar) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	set := map[string]bool{}
+	for _, iv := range inputVars {
+		if iv.Name.IsUnknown() {
+			continue
+		}
+		name := iv.Name.ValueString()
+		set[name] = true
+
+		v, ok := s.variables[name]
+		if !ok {
+			if s.complete {
+				diags.AddAttributeError(attrPath, "Unknown input variable",
+					fmt.Sprintf("The no-code module does not have a variable named %q. Valid variables are: %s.",
+						name, strings.Join(s.names(), ", ")))
+			}
+			continue
+		}
+
+		if !v.UserEditable && len(v.Options) > 0 {
+			diags.AddAttributeError(attrPath, "Input variable cannot be set",
+				fmt.Sprintf("The variable %q is set to %q by the template or add-on definition and cannot be changed.",
+					name, strings.Join(v.Options, ", ")))
+			continue
+		}
+
+		if !iv.VariableType.IsUnknown() && v.VariableType != "" &&
+			iv.VariableType.ValueString() != v.VariableType {
+			diags.AddAttributeError(attrPath, "Input variable type mismatch",
+				fmt.Sprintf("The variable %q has type %q, but %q was given.",
+					name, v.VariableType, iv.VariableType.ValueString()))
+			continue
+		}
+
+		if iv.Value.IsUnknown() {
+			continue
+		}
+		value := iv.Value.ValueString()
+
+		if err := validateInputVariableValue(iv.VariableType, value); err != nil {
+			diags.AddAttributeError(attrPath, "Invalid input variable value",
+				fmt.Sprintf("The value of variable %q is invalid: %s.", name, err))
+			continue
+		}
+
+		if len(v.Options) > 0 && !slices.Contains(v.Options, value) {
+			diags.AddAttributeError(attrPath, "Input variable value not allowed",
+				fmt.Sprintf("The value %q is not allowed for variable %q. Allowed values are: %s.",
+					value, name, strings.Join(v.Options, ", ")))
+		}
+	}
+
+	for _, name := range s.names() {
+		v := s.variables[name]
+		if !set[name] && v.UserEditable && len(v.Options) > 1 {
+			diags.AddAttributeError(attrPath, "Missing required input variable",
+				fmt.Sprintf("The variable %q must be set to one of: %s.",
+					name, strings.Join(v.Options, ", ")))
+		}
+	}
+
+	return diags
+}
+
+// names returns the sorted names of the variables in the schema.
+func (s inputVariableSchema) names() []string {
+	names := make([]string, 0, len(s.variables))
+	for name := range s.variables {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// validateInputVariableValue checks that a value can be converted to the given
+// primitive Terraform type. Complex types are left for Terraform to validate.
+func validateInputVariableValue(variableType types.String, value string) error {
+	switch variableType.ValueString() {
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+	case "bool":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%q is not a bool", value)
+		}
+	}
+	return nil
+}
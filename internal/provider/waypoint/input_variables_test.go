@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waypoint
+
+import (
+	"testing"
+
+	waypoint_models "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInputVariableSchemaValidate(t *testing.T) {
+	attrPath := path.Root("app_input_vars")
+
+	// The schema of a no-code module with a free string, a number, a bool, a
+	// region chosen by the user and a size set by the template.
+	schema := inputVariableSchema{
+		variables: map[string]*waypoint_models.HashicorpCloudWaypointV20241122TFModuleVariable{
+			"name":     {Name: "name", VariableType: "string", UserEditable: true},
+			"replicas": {Name: "replicas", VariableType: "number", UserEditable: true},
+			"public":   {Name: "public", VariableType: "bool", UserEditable: true},
+			"region":   {Name: "region", VariableType: "string", UserEditable: true, Options: []string{"us-east-1", "eu-west-1"}},
+			"size":     {Name: "size", VariableType: "string", Options: []string{"small"}},
+		},
+		complete: true,
+	}
+	region := inputVar("region", "string", "us-east-1")
+
+	cases := []struct {
+		name      string
+		schema    inputVariableSchema
+		inputVars []InputVar
+		// expected are the summaries of the expected errors.
+		expected []string
+	}{
+		{
+			name:   "valid values",
+			schema: schema,
+			inputVars: []InputVar{
+				inputVar("name", "string", "my-app"),
+				inputVar("replicas", "number", "3"),
+				inputVar("public", "bool", "true"),
+				region,
+			},
+		},
+		{
+			name:   "decimal number",
+			schema: schema,
+			inputVars: []InputVar{
+				inputVar("replicas", "number", "1.5"),
+				region,
+			},
+		},
+		{
+			name:   "empty string",
+			schema: schema,
+			inputVars: []InputVar{
+				inputVar("name", "string", ""),
+				region,
+			},
+		},
+		{
+			name:      "unknown variable",
+			schema:    schema,
+			inputVars: []InputVar{inputVar("zone", "string", "a"), region},
+			expected:  []string{"Unknown input variable"},
+		},
+		{
+			name: "unknown variable with incomplete schema",
+			schema: inputVariableSchema{
+				variables: schema.variables,
+			},
+			inputVars: []InputVar{inputVar("zone", "string", "a"), region},
+		},
+		{
+			name:      "variable set by the template",
+			schema:    schema,
+			inputVars: []InputVar{inputVar("size", "string", "small"), region},
+			expected:  []string{"Input variable cannot be set"},
+		},
+		{
+			name:      "type mismatch",
+			schema:    schema,
+			inputVars: []InputVar{inputVar("replicas", "string", "3"), region},
+			expected:  []string{"Input variable type mismatch"},
+		},
+		{
+			name:      "invalid number",
+			schema:    schema,
+			inputVars: []InputVar{inputVar("replicas", "number", "three"), region},
+			expected:  []string{"Invalid input variable value"},
+		},
+		{
+			name:      "invalid bool",
+			schema:    schema,
+			inputVars: []InputVar{inputVar("public", "bool", "yes"), region},
+			expected:  []string{"Invalid input variable value"},
+		},
+		{
+			name:      "value not in options",
+			schema:    schema,
+			inputVars: []InputVar{inputVar("region", "string", "ap-south-1")},
+			expected:  []string{"Input variable value not allowed"},
+		},
+		{
+			name:     "missing variable with several options",
+			schema:   schema,
+			expected: []string{"Missing required input variable"},
+		},
+		{
+			name:   "unknown name",
+			schema: schema,
+			inputVars: []InputVar{
+				{Name: types.StringUnknown(), VariableType: types.StringValue("string"), Value: types.StringValue("a")},
+				region,
+			},
+		},
+		{
+			name:   "unknown value",
+			schema: schema,
+			inputVars: []InputVar{
+				{Name: types.StringValue("replicas"), VariableType: types.StringValue("number"), Value: types.StringUnknown()},
+				region,
+			},
+		},
+		{
+			name:   "unknown type",
+			schema: schema,
+			inputVars: []InputVar{
+				{Name: types.StringValue("replicas"), VariableType: types.StringUnknown(), Value: types.StringValue("3")},
+				region,
+			},
+		},
+		{
+			name:   "several errors",
+			schema: schema,
+			inputVars: []InputVar{
+				inputVar("zone", "string", "a"),
+				inputVar("public", "bool", "maybe"),
+			},
+			expected: []string{"Unknown input variable", "Invalid input variable value", "Missing required input variable"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			diags := c.schema.validate(attrPath, c.inputVars)
+
+			summaries := []string{}
+			for _, d := range diags.Errors() {
+				summaries = append(summaries, d.Summary())
+			}
+			require.ElementsMatch(t, c.expected, summaries, "diagnostics: %v", diags)
+			require.Equal(t, len(c.expected), diags.WarningsCount()+diags.ErrorsCount())
+		})
+	}
+}
+
+func TestValidateInputVariableValue(t *testing.T) {
+	cases := []struct {
+		variableType types.String
+		value        string
+		valid        bool
+	}{
+		{types.StringValue("string"), "anything", true},
+		{types.StringValue("number"), "42", true},
+		{types.StringValue("number"), "-0.5", true},
+		{types.StringValue("number"), "", false},
+		{types.StringValue("number"), "4 2", false},
+		{types.StringValue("bool"), "false", true},
+		{types.StringValue("bool"), "1", true},
+		{types.StringValue("bool"), "no", false},
+		{types.StringValue("list(string)"), "[\"a\"]", true},
+		{types.StringNull(), "anything", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.variableType.String()+" "+c.value, func(t *testing.T) {
+			err := validateInputVariableValue(c.variableType, c.value)
+			if c.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func inputVar(name, variableType, value string) InputVar {
+	return InputVar{
+		Name:         types.StringValue(name),
+		VariableType: types.StringValue(variableType),
+		Value:        types.StringValue(value),
+	}
+}
@@ -0,0 +1,467 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waypoint
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	waypoint_models "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+// actionRunTimeout is how long an apply waits for an Action run to finish
+// when wait_for_completion is set.
+const actionRunTimeout = 30 * time.Minute
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ActionRunResource{}
+
+func NewActionRunResource() resource.Resource {
+	return &ActionRunResource{}
+}
+
+type ActionRunResource struct {
+	client *clients.Client
+}
+
+// ActionRunResourceModel describes the resource data model.
+type ActionRunResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	OrgID           types.String `tfsdk:"organization_id"`
+	ActionID        types.String `tfsdk:"action_id"`
+	ActionName      types.String `tfsdk:"action_name"`
+	ApplicationName types.String `tfsdk:"application_name"`
+
+	VariableOverrides          types.Map  `tfsdk:"variable_overrides"`
+	SensitiveVariableOverrides types.Map  `tfsdk:"sensitive_variable_overrides"`
+	Triggers                   types.Map  `tfsdk:"triggers"`
+	WaitForCompletion          types.Bool `tfsdk:"wait_for_completion"`
+
+	Sequence       types.String `tfsdk:"sequence"`
+	Status         types.String `tfsdk:"status"`
+	StatusDetails  types.String `tfsdk:"status_details"`
+	ResponseStatus types.String `tfsdk:"response_status"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	CompletedAt    types.String `tfsdk:"completed_at"`
+
+	StatusLogs []actionRunStatusLog `tfsdk:"status_logs"`
+}
+
+type actionRunStatusLog struct {
+	EmittedAt types.String `tfsdk:"emitted_at"`
+	Log       types.String `tfsdk:"log"`
+	Metadata  types.Map    `tfsdk:"metadata"`
+}
+
+func (r *ActionRunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_waypoint_action_run"
+}
+
+func (r *ActionRunResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The Waypoint Action Run resource invokes an Action and records the outcome of the run. " +
+			"A new run is started whenever the Action, its scope, its variable overrides or `triggers` change. " +
+			"Destroying the resource only removes it from state; past runs remain visible in HCP Waypoint. " +
+			"The HCP Waypoint API does not return the URL of the logs of a run nor the body of the response " +
+			"of the endpoint the Action called, so only `response_status` and the `status_logs` of the run are available.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the Action run.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the Action is located.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the HCP organization where the Action is located.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"action_id": schema.StringAttribute{
+				Description: "The ID of the Action to run. Exactly one of `action_id` or `action_name` must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("action_name")),
+				},
+			},
+			"action_name": schema.StringAttribute{
+				Description: "The name of the Action to run. Exactly one of `action_id` or `action_name` must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application_name": schema.StringAttribute{
+				Description: "The name of the Application to scope the run to. If unset, the Action is run globally.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"variable_overrides": schema.MapAttribute{
+				Description: "Variables to override for this run, keyed by variable reference, such as `var.option`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"sensitive_variable_overrides": schema.MapAttribute{
+				Description: "Sensitive variables to override for this run, keyed by variable reference. " +
+					"Their values are hidden in the run history.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that, when changed, start a new run of the Action. " +
+					"Use this to rerun the Action when, for example, an Application is redeployed.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "If true, apply waits for the run to finish and fails if the run does not succeed. " +
+					"Defaults to true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"sequence": schema.StringAttribute{
+				Description: "The sequence number of the run for the Action.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the run. One of `STATUS_QUEUED`, `STATUS_RUNNING`, " +
+					"`STATUS_SUCCESS`, `STATUS_ERRORED` or `STATUS_HALTED`.",
+				Computed: true,
+			},
+			"status_details": schema.StringAttribute{
+				Description: "Details reported for the run, such as an error message.",
+				Computed:    true,
+			},
+			"response_status": schema.StringAttribute{
+				Description: "The response status reported by the endpoint the Action called. " +
+					"One of `NONE`, `UNKNOWN`, `SUCCESS` or `ERROR`. The body of the response is not returned by HCP Waypoint.",
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The time the run was started.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"completed_at": schema.StringAttribute{
+				Description: "The time the run finished, if it has.",
+				Computed:    true,
+			},
+			"status_logs": schema.ListNestedAttribute{
+				Description: "The status log entries recorded for the run, in the order they were emitted.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"emitted_at": schema.StringAttribute{
+							Description: "The time the entry was emitted.",
+							Computed:    true,
+						},
+						"log": schema.StringAttribute{
+							Description: "The log message.",
+							Computed:    true,
+						},
+						"metadata": schema.MapAttribute{
+							Description: "Additional key/value data attached to the entry.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ActionRunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ActionRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ActionRunResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := r.client.Config.ProjectID
+	if !plan.ProjectID.IsUnknown() && !plan.ProjectID.IsNull() {
+		projectID = plan.ProjectID.ValueString()
+	}
+
+	orgID := r.client.Config.OrganizationID
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: orgID,
+		ProjectID:      projectID,
+	}
+
+	overrides, diags := actionRunVariableOverrides(ctx, plan.VariableOverrides, false)
+	resp.Diagnostics.Append(diags...)
+	sensitiveOverrides, diags := actionRunVariableOverrides(ctx, plan.SensitiveVariableOverrides, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	run, err := clients.RunAction(ctx, r.client, loc,
+		plan.ActionID.ValueString(),
+		plan.ActionName.ValueString(),
+		plan.ApplicationName.ValueString(),
+		append(overrides, sensitiveOverrides...),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error running Action", err.Error())
+		return
+	}
+	if run == nil {
+		resp.Diagnostics.AddError("Unknown error running Action", "Empty Action run returned")
+		return
+	}
+
+	if plan.WaitForCompletion.ValueBool() {
+		run, err = clients.WaitForActionRunToComplete(ctx, r.client, loc,
+			plan.ActionID.ValueString(),
+			plan.ActionName.ValueString(),
+			run.Sequence,
+			actionRunTimeout,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Error waiting for Action run", err.Error())
+			return
+		}
+	}
+
+	plan.ProjectID = types.StringValue(projectID)
+	plan.OrgID = types.StringValue(orgID)
+
+	resp.Diagnostics.Append(readActionRun(ctx, plan, run)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "Created Action run resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	// A failed run is kept in state so that the resource is tainted and the
+	// Action is run again on the next apply.
+	if plan.WaitForCompletion.ValueBool() {
+		switch plan.Status.ValueString() {
+		case clients.ActionRunStateErrored, clients.ActionRunStateHalted:
+			resp.Diagnostics.AddError(
+				"Action run did not succeed",
+				fmt.Sprintf("Run %s finished with status %s: %s",
+					plan.Sequence.ValueString(), plan.Status.ValueString(), plan.StatusDetails.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *ActionRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ActionRunResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: data.OrgID.ValueString(),
+		ProjectID:      data.ProjectID.ValueString(),
+	}
+
+	run, err := clients.GetActionRun(ctx, r.client, loc,
+		data.ActionID.ValueString(),
+		data.ActionName.ValueString(),
+		data.Sequence.ValueString(),
+	)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			tflog.Info(ctx, "Action run not found for organization, removing from state.")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Action run", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(readActionRun(ctx, data, run)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is only reachable when wait_for_completion changes, as every other
+// argument requires a new run. It keeps the recorded run as-is.
+func (r *ActionRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ActionRunResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.WaitForCompletion = plan.WaitForCompletion
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete only removes the run from state; HCP Waypoint keeps the run history
+// of an Action for as long as the Action exists.
+func (r *ActionRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Action runs cannot be deleted, removing from state only.")
+}
+
+// actionRunVariableOverrides converts a map of variable overrides into the
+// form expected by the RunAction endpoint. Keys are sorted so that requests
+// are deterministic.
+func actionRunVariableOverrides(ctx context.Context, overrides types.Map, sensitive bool) ([]*waypoint_models.HashicorpCloudWaypointV20241122RunActionRequestVariableOverride, diag.Diagnostics) {
+	if overrides.IsNull() || overrides.IsUnknown() {
+		return nil, nil
+	}
+
+	values := make(map[string]string, len(overrides.Elements()))
+	diags := overrides.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make([]*waypoint_models.HashicorpCloudWaypointV20241122RunActionRequestVariableOverride, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, &waypoint_models.HashicorpCloudWaypointV20241122RunActionRequestVariableOverride{
+			Key:       key,
+			Value:     values[key],
+			Sensitive: sensitive,
+		})
+	}
+
+	return result, diags
+}
+
+func readActionRun(
+	ctx context.Context,
+	data *ActionRunResourceModel,
+	run *waypoint_models.HashicorpCloudWaypointV20241122ActionRun,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(run.ID)
+	data.Sequence = types.StringValue(run.Sequence)
+	data.Status = types.StringValue(clients.ActionRunState(run))
+
+	data.StatusDetails = types.StringNull()
+	if run.BackgroundJob != nil && run.BackgroundJob.Details != "" {
+		data.StatusDetails = types.StringValue(run.BackgroundJob.Details)
+	}
+
+	data.ResponseStatus = types.StringNull()
+	if run.ResponseStatus != nil {
+		data.ResponseStatus = types.StringValue(string(*run.ResponseStatus))
+	}
+
+	data.CreatedAt = types.StringValue(run.CreatedAt.String())
+	data.CompletedAt = types.StringNull()
+	if !run.CompletedAt.IsZero() {
+		data.CompletedAt = types.StringValue(run.CompletedAt.String())
+	}
+
+	data.StatusLogs = make([]actionRunStatusLog, 0, len(run.StatusLog))
+	for _, entry := range run.StatusLog {
+		if entry == nil {
+			continue
+		}
+		metadata, d := types.MapValueFrom(ctx, types.StringType, entry.Metadata)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		data.StatusLogs = append(data.StatusLogs, actionRunStatusLog{
+			EmittedAt: types.StringValue(entry.EmittedAt.String()),
+			Log:       types.StringValue(entry.Log),
+			Metadata:  metadata,
+		})
+	}
+
+	return diags
+}
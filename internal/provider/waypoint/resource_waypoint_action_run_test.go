@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waypoint_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+)
+
+func TestAcc_Waypoint_ActionRun_basic(t *testing.T) {
+	t.Parallel()
+
+	// Skip this test unless the appropriate environment variable is set
+	// This is to prevent running this test by default
+	if os.Getenv("HCP_WAYP_ACTION_TEST") == "" {
+		t.Skipf("Waypoint Action tests skipped unless env '%s' set",
+			"HCP_WAYP_ACTION_TEST")
+		return
+	}
+	resourceName := "hcp_waypoint_action_run.test"
+	actionName := generateRandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testActionRun(actionName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWaypointActionRunExists(t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action_name", actionName),
+					resource.TestCheckResourceAttr(resourceName, "sequence", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", clients.ActionRunStateSuccess),
+					resource.TestCheckResourceAttrSet(resourceName, "completed_at"),
+				),
+			},
+			{
+				// Changing a trigger starts a new run of the Action
+				Config: testActionRun(actionName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWaypointActionRunExists(t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sequence", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", clients.ActionRunStateSuccess),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWaypointActionRunExists(t *testing.T, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Find the corresponding state object
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := acctest.HCPClients(t)
+		loc := &sharedmodels.HashicorpCloudLocationLocation{
+			OrganizationID: client.Config.OrganizationID,
+			ProjectID:      rs.Primary.Attributes["project_id"],
+		}
+
+		run, err := clients.GetActionRun(context.Background(), client, loc,
+			rs.Primary.Attributes["action_id"],
+			rs.Primary.Attributes["action_name"],
+			rs.Primary.Attributes["sequence"],
+		)
+		if err != nil {
+			return err
+		}
+
+		if run.ID != rs.Primary.ID {
+			return fmt.Errorf("expected action run ID to be %s, but got %s", rs.Primary.ID, run.ID)
+		}
+
+		return nil
+	}
+}
+
+func testActionRun(actionName, trigger string) string {
+	return fmt.Sprintf(`
+resource "hcp_waypoint_action" "test" {
+	name = "%s"
+	description = "Test action"
+	request = {
+	    custom = {
+			method = "GET"
+			url = "https://example.com"
+		}
+	}
+}
+
+resource "hcp_waypoint_action_run" "test" {
+	action_name = hcp_waypoint_action.test.name
+	triggers = {
+		run = "%s"
+	}
+}
+`, actionName, trigger)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/client/waypoint_service"
 	waypoint_models "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,11 +22,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AddOnResource{}
 var _ resource.ResourceWithImportState = &AddOnResource{}
+var _ resource.ResourceWithIdentity = &AddOnResource{}
+var _ resource.ResourceWithModifyPlan = &AddOnResource{}
 
 func NewAddOnResource() resource.Resource {
 	return &AddOnResource{}
@@ -184,8 +189,9 @@ func (r *AddOnResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"add_on_input_variables": schema.SetNestedAttribute{
-				Optional:    true,
-				Description: "Input variables set for the add-on.",
+				Optional: true,
+				Description: "Input variables set for the add-on. They are validated against the " +
+					"variables of the add-on definition's no-code module and its variable options when planning.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": &schema.StringAttribute{
@@ -465,6 +471,7 @@ func (r *AddOnResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Save plan into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 // TODO: Add support for new fields
@@ -473,6 +480,7 @@ func (r *AddOnResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -772,8 +780,68 @@ func (r *AddOnResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 }
 
+// ModifyPlan validates the input variables of the Add-on against its Add-on
+// Definition, so that mistakes are reported before the HCP Terraform run
+// starts.
+func (r *AddOnResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AddOnResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.validateInputVariables(ctx, &plan, &resp.Diagnostics)
+}
+
+// validateInputVariables checks the planned add_on_input_variables against the
+// variables of the Add-on Definition's no-code module and its variable
+// options.
+func (r *AddOnResource) validateInputVariables(ctx context.Context, plan *AddOnResourceModel, d *diag.Diagnostics) {
+	// The provider may not be configured yet during validation
+	if r.client == nil || plan.DefinitionID.IsUnknown() || plan.InputVars.IsUnknown() {
+		return
+	}
+
+	projectID := r.client.Config.ProjectID
+	if !plan.ProjectID.IsUnknown() && !plan.ProjectID.IsNull() {
+		projectID = plan.ProjectID.ValueString()
+	}
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: r.client.Config.OrganizationID,
+		ProjectID:      projectID,
+	}
+
+	definition, err := clients.GetAddOnDefinitionByID(ctx, r.client, loc, plan.DefinitionID.ValueString())
+	if err != nil {
+		tflog.Warn(ctx, "Unable to fetch Add-on Definition, skipping input variable validation", map[string]interface{}{
+			"definition_id": plan.DefinitionID.ValueString(),
+			"error":         err.Error(),
+		})
+		return
+	}
+
+	var inputVars []InputVar
+	d.Append(plan.InputVars.ElementsAs(ctx, &inputVars, false)...)
+	if d.HasError() {
+		return
+	}
+
+	schema := newInputVariableSchema(ctx, r.client, loc,
+		definition.ModuleSource, definition.ModuleID, definition.VariableOptions)
+	d.Append(schema.validate(path.Root("add_on_input_variables"), inputVars)...)
+}
+
+func (r *AddOnResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(hcpvalidator.ImportIDPart{Name: "id"})
+}
+
 func (r *AddOnResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // readOutputs accepts a list of output values in the type returned by the Waypoint API and returns a list of output
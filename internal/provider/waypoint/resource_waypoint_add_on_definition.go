@@ -21,11 +21,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AddOnDefinitionResource{}
 var _ resource.ResourceWithImportState = &AddOnDefinitionResource{}
+var _ resource.ResourceWithIdentity = &AddOnDefinitionResource{}
 
 func NewAddOnDefinitionResource() resource.Resource {
 	return &AddOnDefinitionResource{}
@@ -389,6 +392,7 @@ func (r *AddOnDefinitionResource) Create(ctx context.Context, req resource.Creat
 
 	// Save plan into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *AddOnDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -396,6 +400,7 @@ func (r *AddOnDefinitionResource) Read(ctx context.Context, req resource.ReadReq
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -694,6 +699,10 @@ func (r *AddOnDefinitionResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+func (r *AddOnDefinitionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(hcpvalidator.ImportIDPart{Name: "id"})
+}
+
 func (r *AddOnDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
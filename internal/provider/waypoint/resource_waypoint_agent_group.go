@@ -17,11 +17,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &AgentGroupResource{}
 var _ resource.ResourceWithImportState = &AgentGroupResource{}
+var _ resource.ResourceWithIdentity = &AgentGroupResource{}
 
 func NewAgentGroupResource() resource.Resource {
 	return &AgentGroupResource{}
@@ -181,6 +184,7 @@ func (r *AgentGroupResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 
 }
 
@@ -189,6 +193,7 @@ func (r *AgentGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Read the Terraform state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -349,6 +354,10 @@ func (r *AgentGroupResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+func (r *AgentGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(hcpvalidator.ImportIDPart{Name: "id"})
+}
+
 func (r *AgentGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
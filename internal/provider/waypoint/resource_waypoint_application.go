@@ -12,20 +12,26 @@ import (
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/client/waypoint_service"
 	waypoint_models "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ApplicationResource{}
 var _ resource.ResourceWithImportState = &ApplicationResource{}
+var _ resource.ResourceWithIdentity = &ApplicationResource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationResource{}
 
 func NewApplicationResource() resource.Resource {
 	return &ApplicationResource{}
@@ -61,6 +67,10 @@ type ApplicationResourceModel struct {
 	TemplateInputVars types.Set `tfsdk:"template_input_variables"`
 
 	OutputValues types.List `tfsdk:"output_values"`
+
+	TemplateVersion       types.String `tfsdk:"template_version"`
+	LatestTemplateVersion types.String `tfsdk:"latest_template_version"`
+	AutoUpgrade           types.Bool   `tfsdk:"auto_upgrade"`
 }
 
 type InputVar struct {
@@ -120,6 +130,30 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 				Required:    true,
 				Description: "ID of the Template this Application is based on.",
 			},
+			"template_version": schema.StringAttribute{
+				Computed: true,
+				Description: "The version of the Template this Application was created from, " +
+					"or last upgraded to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"latest_template_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The current version of the Template this Application is based on.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_upgrade": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "If true, the Application is upgraded to the latest version of its " +
+					"Template whenever `template_version` differs from `latest_template_version`. " +
+					"If false, the Application stays on its current Template version and a warning " +
+					"is shown when a newer version is available. Defaults to false.",
+			},
 			// template_name is a computed only attribute for ease
 			// of reference
 			"template_name": schema.StringAttribute{
@@ -159,8 +193,9 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"application_input_variables": schema.SetNestedAttribute{
-				Optional:    true,
-				Description: "Input variables set for the application.",
+				Optional: true,
+				Description: "Input variables set for the application. They are validated against the " +
+					"variables of the template's no-code module and its variable options when planning.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": &schema.StringAttribute{
@@ -353,6 +388,14 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	plan.TemplateName = types.StringValue(application.ApplicationTemplate.Name)
 	plan.NamespaceID = types.StringValue(ns.ID)
 
+	appTemplate, err := clients.GetApplicationTemplateByID(ctx, client, loc, application.ApplicationTemplate.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Template", err.Error())
+		return
+	}
+	plan.TemplateVersion = types.StringValue(templateVersion(appTemplate))
+	plan.LatestTemplateVersion = plan.TemplateVersion
+
 	var planActionIDs []string
 	for _, n := range application.ActionCfgRefs {
 		planActionIDs = append(planActionIDs, n.ID)
@@ -424,6 +467,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -431,6 +475,7 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -479,6 +524,26 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 	data.OrgID = types.StringValue(orgID)
 	data.TemplateName = types.StringValue(application.ApplicationTemplate.Name)
 
+	appTemplate, err := clients.GetApplicationTemplateByID(ctx, client, loc, application.ApplicationTemplate.ID)
+	switch {
+	case err == nil:
+		data.LatestTemplateVersion = types.StringValue(templateVersion(appTemplate))
+	case clients.IsResponseCodeNotFound(err):
+		tflog.Info(ctx, "Template not found for Application, clearing latest template version.")
+		data.LatestTemplateVersion = types.StringNull()
+	default:
+		resp.Diagnostics.AddError("Error reading Template", err.Error())
+		return
+	}
+	// Applications created before template versions were tracked are
+	// assumed to be running the current version.
+	if data.TemplateVersion.IsNull() || data.TemplateVersion.IsUnknown() {
+		data.TemplateVersion = data.LatestTemplateVersion
+	}
+	if data.AutoUpgrade.IsNull() || data.AutoUpgrade.IsUnknown() {
+		data.AutoUpgrade = types.BoolValue(false)
+	}
+
 	data.Actions = types.ListNull(types.StringType)
 	if application.ActionCfgRefs != nil {
 		var actionIDs []string
@@ -668,6 +733,46 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// varTypes is used to store the variable type for each input variable
+	// to be used later when fetching the input variables from the API
+	varTypes := map[string]string{}
+	ivs := make([]*waypoint_models.HashicorpCloudWaypointV20241122InputVariable, 0)
+
+	var inputVarsSlice []InputVar
+	diags = plan.InputVars.ElementsAs(ctx, &inputVarsSlice, false)
+	if diags.HasError() {
+		return
+	}
+	for _, v := range inputVarsSlice {
+		ivs = append(ivs, &waypoint_models.HashicorpCloudWaypointV20241122InputVariable{
+			Name:         v.Name.ValueString(),
+			Value:        v.Value.ValueString(),
+			VariableType: v.VariableType.ValueString(),
+		})
+		varTypes[v.Name.ValueString()] = v.VariableType.ValueString()
+	}
+
+	// A change of template_version is only planned when the Application is
+	// set to auto-upgrade and its Template has a newer version.
+	if !plan.TemplateVersion.Equal(data.TemplateVersion) {
+		application, err = clients.UpgradeApplication(ctx, client, loc, application.ID, application.Name, ivs)
+		if err != nil {
+			resp.Diagnostics.AddError("Error upgrading Application", err.Error())
+			return
+		}
+		if application == nil {
+			resp.Diagnostics.AddError("unknown error upgrading application", "empty application returned")
+			return
+		}
+
+		appTemplate, err := clients.GetApplicationTemplateByID(ctx, client, loc, application.ApplicationTemplate.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading Template", err.Error())
+			return
+		}
+		plan.LatestTemplateVersion = types.StringValue(templateVersion(appTemplate))
+	}
+
 	plan.ID = types.StringValue(application.ID)
 	plan.ProjectID = types.StringValue(projectID)
 	plan.Name = types.StringValue(application.Name)
@@ -696,6 +801,33 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		plan.ReadmeMarkdown = types.StringNull()
 	}
 
+	inputVars, err := clients.GetInputVariables(ctx, client, plan.Name.ValueString(), loc)
+	if err != nil {
+		resp.Diagnostics.AddError(err.Error(), "Failed to fetch application's input variables.")
+		return
+	}
+
+	applicationInputVars, templateInputVars := splitInputs(inputVars, varTypes)
+	if len(applicationInputVars) > 0 {
+		aivs, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: InputVar{}.attrTypes()}, applicationInputVars)
+		if diags.HasError() {
+			return
+		}
+		plan.InputVars = aivs
+	} else {
+		plan.InputVars = types.SetNull(types.ObjectType{AttrTypes: InputVar{}.attrTypes()})
+	}
+
+	if len(templateInputVars) > 0 {
+		tivs, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: InputVar{}.attrTypes()}, templateInputVars)
+		if diags.HasError() {
+			return
+		}
+		plan.TemplateInputVars = tivs
+	} else {
+		plan.TemplateInputVars = types.SetNull(types.ObjectType{AttrTypes: InputVar{}.attrTypes()})
+	}
+
 	// Read the output values from the application and set them in the plan
 	ol := readOutputs(application.OutputValues)
 	if len(ol) > 0 {
@@ -758,6 +890,93 @@ func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+// ModifyPlan validates the input variables of the Application against its
+// Template. It also plans an upgrade of the Application when its Template has
+// a newer version and auto_upgrade is set, and otherwise warns that the
+// Application is running an older version of its Template.
+func (r *ApplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ApplicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.validateInputVariables(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state ApplicationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.LatestTemplateVersion.IsNull() || state.LatestTemplateVersion.Equal(state.TemplateVersion) {
+		return
+	}
+
+	if !plan.AutoUpgrade.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Application is running an older Template version",
+			fmt.Sprintf("Application %q uses version %s of Template %q, but the latest version is %s. "+
+				"Set auto_upgrade to true to upgrade the Application.",
+				state.Name.ValueString(), state.TemplateVersion.ValueString(),
+				state.TemplateName.ValueString(), state.LatestTemplateVersion.ValueString()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("template_version"), state.LatestTemplateVersion)...)
+}
+
+// validateInputVariables checks the planned application_input_variables
+// against the variables of the Template's no-code module and its variable
+// options, so that mistakes are reported before the HCP Terraform run starts.
+func (r *ApplicationResource) validateInputVariables(ctx context.Context, plan *ApplicationResourceModel, d *diag.Diagnostics) {
+	// The provider may not be configured yet during validation
+	if r.client == nil || plan.TemplateID.IsUnknown() || plan.InputVars.IsUnknown() {
+		return
+	}
+
+	projectID := r.client.Config.ProjectID
+	if !plan.ProjectID.IsUnknown() && !plan.ProjectID.IsNull() {
+		projectID = plan.ProjectID.ValueString()
+	}
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: r.client.Config.OrganizationID,
+		ProjectID:      projectID,
+	}
+
+	appTemplate, err := clients.GetApplicationTemplateByID(ctx, r.client, loc, plan.TemplateID.ValueString())
+	if err != nil {
+		tflog.Warn(ctx, "Unable to fetch Template, skipping input variable validation", map[string]interface{}{
+			"template_id": plan.TemplateID.ValueString(),
+			"error":       err.Error(),
+		})
+		return
+	}
+
+	var inputVars []InputVar
+	d.Append(plan.InputVars.ElementsAs(ctx, &inputVars, false)...)
+	if d.HasError() {
+		return
+	}
+
+	schema := newInputVariableSchema(ctx, r.client, loc,
+		appTemplate.ModuleSource, appTemplate.ModuleID, appTemplate.VariableOptions)
+	d.Append(schema.validate(path.Root("application_input_variables"), inputVars)...)
+}
+
+func (r *ApplicationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(hcpvalidator.ImportIDPart{Name: "id"})
+}
+
 func (r *ApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -6,6 +6,7 @@ package waypoint_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
@@ -111,6 +112,73 @@ func TestAcc_Waypoint_ApplicationInputVariables_OnTemplate(t *testing.T) {
 	})
 }
 
+// TestAcc_Waypoint_Application_TemplateUpgrade tests that an application set
+// to auto-upgrade is upgraded after its template changes.
+func TestAcc_Waypoint_Application_TemplateUpgrade(t *testing.T) {
+	t.Parallel()
+
+	var applicationModel waypoint.ApplicationResourceModel
+	resourceName := "hcp_waypoint_application.test_upgrade"
+	templateResourceName := "hcp_waypoint_template.test_upgrade"
+	templateName := generateRandomName()
+	applicationName := generateRandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckWaypointApplicationDestroy(t, &applicationModel),
+		Steps: []resource.TestStep{
+			{
+				Config: testApplicationUpgradeConfig(templateName, applicationName, "lone-wanderer"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWaypointApplicationExists(t, resourceName, &applicationModel),
+					resource.TestCheckResourceAttrSet(templateResourceName, "version"),
+					resource.TestCheckResourceAttrPair(resourceName, "template_version", templateResourceName, "version"),
+					resource.TestCheckResourceAttrPair(resourceName, "latest_template_version", templateResourceName, "version"),
+				),
+			},
+			{
+				// The application only learns about the new template version
+				// when it is refreshed, so the upgrade is planned afterwards.
+				Config:             testApplicationUpgradeConfig(templateName, applicationName, "courier"),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testApplicationUpgradeConfig(templateName, applicationName, "courier"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "template_version", templateResourceName, "version"),
+					resource.TestCheckResourceAttrPair(resourceName, "latest_template_version", templateResourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+// TestAcc_Waypoint_Application_InvalidInputVariables tests that input
+// variables are validated against the template at plan time.
+func TestAcc_Waypoint_Application_InvalidInputVariables(t *testing.T) {
+	t.Parallel()
+
+	templateName := generateRandomName()
+	applicationName := generateRandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The template must exist for the application to be validated
+				Config: testApplicationInvalidInputVarsConfig(templateName, applicationName, false),
+			},
+			{
+				Config:      testApplicationInvalidInputVarsConfig(templateName, applicationName, true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Input variable value not allowed`),
+			},
+		},
+	})
+}
+
 // TestAcc_Waypoint_Application_Action_Assign tests that an application can be
 // created with an action assigned to it.
 func TestAcc_Waypoint_Application_Action_Assign(t *testing.T) {
@@ -382,6 +450,96 @@ resource "hcp_waypoint_application" "test_var_opts" {
 }`, tempName, appName)
 }
 
+func testApplicationUpgradeConfig(tempName, appName, dwellerName string) string {
+	return fmt.Sprintf(`
+resource "hcp_waypoint_template" "test_upgrade" {
+  name    = "%s"
+  summary = "some summary for fun"
+  readme_markdown_template = base64encode("# Some Readme")
+  terraform_no_code_module_source = "private/waypoint-tfc-testing/waypoint-vault-dweller/null"
+  terraform_no_code_module_id     = "nocode-JSMkg9ztLBYgg1eW"
+  terraform_project_id = "prj-gfVyPJ2q2Aurn25o"
+  labels = ["fallout", "vault-tec"]
+  variable_options = [
+    {
+      name          = "vault_dweller_name"
+      variable_type = "string"
+      user_editable = false
+      options       = [
+        "%s",
+      ]
+    },
+    {
+      name          = "faction"
+      variable_type = "string"
+      user_editable = false
+      options       = [
+        "brotherhood-of-steel",
+      ]
+    },
+    {
+      name          = "vault_dweller_shelter"
+      variable_type = "string"
+      user_editable = true
+    }
+  ]
+}
+
+resource "hcp_waypoint_application" "test_upgrade" {
+  name         = "%s"
+  template_id  = hcp_waypoint_template.test_upgrade.id
+  auto_upgrade = true
+  application_input_variables = [
+    {
+      name          = "vault_dweller_shelter"
+      variable_type = "string"
+      value         = "101"
+    }
+  ]
+}`, tempName, dwellerName, appName)
+}
+
+func testApplicationInvalidInputVarsConfig(tempName, appName string, withApp bool) string {
+	app := ""
+	if withApp {
+		app = fmt.Sprintf(`
+resource "hcp_waypoint_application" "test_invalid" {
+  name        = "%s"
+  template_id = hcp_waypoint_template.test_invalid.id
+  application_input_variables = [
+    {
+      name          = "vault_dweller_name"
+      variable_type = "string"
+      value         = "mr-house"
+    }
+  ]
+}`, appName)
+	}
+
+	return fmt.Sprintf(`
+resource "hcp_waypoint_template" "test_invalid" {
+  name    = "%s"
+  summary = "some summary for fun"
+  readme_markdown_template = base64encode("# Some Readme")
+  terraform_no_code_module_source = "private/waypoint-tfc-testing/waypoint-vault-dweller/null"
+  terraform_no_code_module_id     = "nocode-JSMkg9ztLBYgg1eW"
+  terraform_project_id = "prj-gfVyPJ2q2Aurn25o"
+  labels = ["fallout", "vault-tec"]
+  variable_options = [
+    {
+      name          = "vault_dweller_name"
+      variable_type = "string"
+      user_editable = true
+      options       = [
+        "lucy",
+        "courier",
+      ]
+    },
+  ]
+}
+%s`, tempName, app)
+}
+
 func testTemplateWithAppAndActionsConfig(
 	templateName string,
 	applicationName string,
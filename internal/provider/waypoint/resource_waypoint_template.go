@@ -24,11 +24,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TemplateResource{}
 var _ resource.ResourceWithImportState = &TemplateResource{}
+var _ resource.ResourceWithIdentity = &TemplateResource{}
+var _ resource.ResourceWithModifyPlan = &TemplateResource{}
 
 func NewTemplateResource() resource.Resource {
 	return &TemplateResource{}
@@ -59,6 +63,7 @@ type TemplateResourceModel struct {
 	TerraformVariableOptions    []*tfcVariableOption `tfsdk:"variable_options"`
 	TerraformExecutionMode      types.String         `tfsdk:"terraform_execution_mode"`
 	TerraformAgentPoolID        types.String         `tfsdk:"terraform_agent_pool_id"`
+	Version                     types.String         `tfsdk:"version"`
 }
 
 func (t tfcWorkspace) attrTypes() map[string]attr.Type {
@@ -178,9 +183,6 @@ func (r *TemplateResource) Schema(ctx context.Context, req resource.SchemaReques
 			"terraform_no_code_module_source": schema.StringAttribute{
 				Required:    true,
 				Description: "Terraform Cloud No-Code Module details",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"variable_options": schema.SetNestedAttribute{
 				Optional:    true,
@@ -229,9 +231,12 @@ func (r *TemplateResource) Schema(ctx context.Context, req resource.SchemaReques
 				Required: true,
 				Description: "The ID of the Terraform no-code module to use for" +
 					" running Terraform operations. This is in the format of 'nocode-<ID>'.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+			},
+			"version": schema.StringAttribute{
+				Computed: true,
+				Description: "The version of the Template. It changes whenever the no-code module or " +
+					"variable options of the Template change. Applications created from an " +
+					"earlier version report it in their `template_version` attribute.",
 			},
 		},
 	}
@@ -438,6 +443,7 @@ func (r *TemplateResource) Create(ctx context.Context, req resource.CreateReques
 		tflog.Error(ctx, err.Error())
 		return
 	}
+	plan.Version = types.StringValue(templateVersion(appTemplate))
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -445,6 +451,7 @@ func (r *TemplateResource) Create(ctx context.Context, req resource.CreateReques
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func readVarOpts(
@@ -480,6 +487,7 @@ func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -516,6 +524,7 @@ func (r *TemplateResource) Read(ctx context.Context, req resource.ReadRequest, r
 	data.Summary = types.StringValue(appTemplate.Summary)
 	data.TerraformNoCodeModuleSource = types.StringValue(appTemplate.ModuleSource)
 	data.TerraformNoCodeModuleID = types.StringValue(appTemplate.ModuleID)
+	data.Version = types.StringValue(templateVersion(appTemplate))
 
 	if appTemplate.TerraformCloudWorkspaceDetails != nil {
 		data.TerraformProjectID = types.StringValue(appTemplate.TerraformCloudWorkspaceDetails.ProjectID)
@@ -750,6 +759,7 @@ func (r *TemplateResource) Update(ctx context.Context, req resource.UpdateReques
 		tflog.Error(ctx, err.Error())
 		return
 	}
+	plan.Version = types.StringValue(templateVersion(appTemplate))
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -799,6 +809,58 @@ func (r *TemplateResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 }
 
+// ModifyPlan keeps the planned version of the Template when only its metadata
+// changes, so that the version is only shown as changing when Applications
+// created from the Template will need to be upgraded.
+func (r *TemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned, current TemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planned)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &current)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planned.TerraformNoCodeModuleSource.Equal(current.TerraformNoCodeModuleSource) ||
+		!planned.TerraformNoCodeModuleID.Equal(current.TerraformNoCodeModuleID) ||
+		!variableOptionsEqual(planned.TerraformVariableOptions, current.TerraformVariableOptions) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version"), current.Version)...)
+}
+
+// variableOptionsEqual reports whether two sets of variable options are the
+// same, regardless of their order.
+func variableOptionsEqual(a, b []*tfcVariableOption) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	byName := make(map[string]*tfcVariableOption, len(b))
+	for _, v := range b {
+		byName[v.Name.ValueString()] = v
+	}
+	for _, v := range a {
+		other, ok := byName[v.Name.ValueString()]
+		if !ok ||
+			!v.VariableType.Equal(other.VariableType) ||
+			!v.Options.Equal(other.Options) ||
+			!v.UserEditable.Equal(other.UserEditable) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *TemplateResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(hcpvalidator.ImportIDPart{Name: "id"})
+}
+
 func (r *TemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -10,17 +10,29 @@ import (
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/client/waypoint_service"
 	waypoint_models "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TfcConfigResource{}
+var _ resource.ResourceWithImportState = &TfcConfigResource{}
+var _ resource.ResourceWithIdentity = &TfcConfigResource{}
+
+// tfcConfigImportID is the import ID of the TFC Config, which is identified by
+// its project since each project has at most one TFC Config.
+var tfcConfigImportID = []hcpvalidator.ImportIDPart{
+	{Name: "project_id", Validators: []validator.String{hcpvalidator.UUID()}},
+}
 
 func NewTfcConfigResource() resource.Resource {
 	return &TfcConfigResource{}
@@ -92,6 +104,10 @@ func (r *TfcConfigResource) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+func (r *TfcConfigResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(tfcConfigImportID...)
+}
+
 func (r *TfcConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -165,6 +181,7 @@ func (r *TfcConfigResource) Create(ctx context.Context, req resource.CreateReque
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 func (r *TfcConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -182,6 +199,10 @@ func (r *TfcConfigResource) Read(ctx context.Context, req resource.ReadRequest,
 		projectID = data.ProjectID.ValueString()
 	}
 
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	}
+
 	orgID := r.client.Config.OrganizationID
 	loc := &sharedmodels.HashicorpCloudLocationLocation{
 		OrganizationID: orgID,
@@ -312,6 +333,19 @@ func (r *TfcConfigResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+// ImportState imports the TFC Config of the project with the given ID. The
+// token is not returned by the API, and must be set in the configuration after
+// the import.
+func (r *TfcConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, diags := identity.ImportID(ctx, req, tfcConfigImportID...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+}
+
 // getNamespaceByLocation will retrieve a namespace by location information
 // provided by HCP
 func getNamespaceByLocation(_ context.Context, client *clients.Client, loc *sharedmodels.HashicorpCloudLocationLocation) (*waypoint_models.HashicorpCloudWaypointV20241122Namespace, error) {
@@ -47,6 +47,17 @@ func TestAcc_Waypoint_TfcConfig_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tfc_org_name", "some-new-org"),
 				),
 			},
+			// import by project ID, the token is not returned by the API
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources[resourceName].Primary.Attributes["project_id"], nil
+				},
+				ImportStateVerifyIdentifierAttribute: "project_id",
+				ImportStateVerifyIgnore:              []string{"token"},
+			},
 		},
 	})
 }
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waypoint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+
+	waypoint_models "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
+)
+
+// templateVersion returns the version of a Template. HCP Waypoint does not
+// version Templates itself, so the version is a fingerprint of the parts of
+// the Template that end up in an Application's HCP Terraform workspace: the
+// no-code module and the variable options. Changes to metadata such as the
+// summary or labels do not change the version.
+func templateVersion(tpl *waypoint_models.HashicorpCloudWaypointV20241122ApplicationTemplate) string {
+	if tpl == nil {
+		return ""
+	}
+
+	type variableOption struct {
+		Name         string   `json:"name"`
+		VariableType string   `json:"variable_type"`
+		Options      []string `json:"options"`
+		UserEditable bool     `json:"user_editable"`
+	}
+
+	varOpts := make([]variableOption, 0, len(tpl.VariableOptions))
+	for _, v := range tpl.VariableOptions {
+		if v == nil {
+			continue
+		}
+		varOpts = append(varOpts, variableOption{
+			Name:         v.Name,
+			VariableType: v.VariableType,
+			Options:      v.Options,
+			UserEditable: v.UserEditable,
+		})
+	}
+	sort.Slice(varOpts, func(i, j int) bool {
+		return varOpts[i].Name < varOpts[j].Name
+	})
+
+	// Marshalling a struct of strings, bools and slices cannot fail.
+	b, _ := json.Marshal(struct {
+		ModuleSource    string           `json:"module_source"`
+		ModuleID        string           `json:"module_id"`
+		VariableOptions []variableOption `json:"variable_options"`
+	}{
+		ModuleSource:    tpl.ModuleSource,
+		ModuleID:        tpl.ModuleID,
+		VariableOptions: varOpts,
+	})
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:8])
+}
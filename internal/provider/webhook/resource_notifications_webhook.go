@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
 	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 	webhookvalidator "github.com/hashicorp/terraform-provider-hcp/internal/provider/webhook/validator"
 )
@@ -31,6 +32,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &resourceNotificationsWebhook{}
 var _ resource.ResourceWithImportState = &resourceNotificationsWebhook{}
+var _ resource.ResourceWithIdentity = &resourceNotificationsWebhook{}
 var _ resource.ResourceWithConfigure = &resourceNotificationsWebhook{}
 var _ resource.ResourceWithModifyPlan = &resourceNotificationsWebhook{}
 
@@ -298,6 +300,7 @@ func (r *resourceNotificationsWebhook) Create(ctx context.Context, req resource.
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, resp.State, resp.Identity)...)
 }
 
 // webhookProjectID extracts the parent resource name from the webhook resource name
@@ -325,6 +328,7 @@ func (r *resourceNotificationsWebhook) Read(ctx context.Context, req resource.Re
 
 	// Read Terraform prior state into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(identity.SetFromState(ctx, req.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -540,6 +544,10 @@ func (r *resourceNotificationsWebhook) Delete(ctx context.Context, req resource.
 	}
 }
 
+func (r *resourceNotificationsWebhook) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identity.Schema(hcpvalidator.ImportIDPart{Name: "resource_name"})
+}
+
 func (r *resourceNotificationsWebhook) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("resource_name"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("resource_name"), path.Root("resource_name"), req, resp)
 }
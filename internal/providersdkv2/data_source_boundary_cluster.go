@@ -5,8 +5,12 @@ package providersdkv2
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
+	"time"
 
+	boundarymodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-boundary-service/stable/2021-12-21/models"
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +18,14 @@ import (
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
 )
 
+// defaultBoundaryClusterTimeout is the amount of time that can elapse
+// before a cluster read operation should timeout.
+var defaultBoundaryClusterTimeout = time.Minute * 5
+
+const boundaryClusterUpgradeTypePrefix = "UPGRADE_TYPE_"
+const boundaryClusterDayOfWeekPrefix = "DAY_OF_WEEK_"
+const boundaryClusterTierPrefix = "CLUSTER_MARKETING_SKU_"
+
 func dataSourceBoundaryCluster() *schema.Resource {
 	return &schema.Resource{
 		Description: "The Boundary cluster data source provides information about an existing HCP Boundary cluster.",
@@ -157,3 +169,68 @@ func dataSourceBoundaryClusterRead(ctx context.Context, d *schema.ResourceData,
 
 	return nil
 }
+
+func setBoundaryClusterResourceData(d *schema.ResourceData, cluster *boundarymodels.HashicorpCloudBoundary20211221Cluster, upgradeType *boundarymodels.HashicorpCloudBoundary20211221UpgradeType, clusterMW *boundarymodels.HashicorpCloudBoundary20211221MaintenanceWindow, clusterCtrlConfig *boundarymodels.HashicorpCloudBoundary20211221ControllerConfiguration) error {
+	if err := d.Set("cluster_id", cluster.ClusterID); err != nil {
+		return err
+	}
+	createdAtStr := cluster.CreatedAt.String()
+	if err := d.Set("created_at", createdAtStr); err != nil {
+		return err
+	}
+	if err := d.Set("cluster_url", cluster.ClusterURL); err != nil {
+		return err
+	}
+	if err := d.Set("state", cluster.State); err != nil {
+		return err
+	}
+
+	tierStr := strings.TrimPrefix(string(*cluster.MarketingSku), boundaryClusterTierPrefix)
+	tierStr = strings.ToUpper(tierStr)
+	if err := d.Set("tier", tierStr); err != nil {
+		return err
+	}
+
+	mwConfig := map[string]interface{}{}
+
+	if upgradeType != nil {
+		upgradeTypeStr := strings.TrimPrefix(string(*upgradeType), boundaryClusterUpgradeTypePrefix)
+		mwConfig["upgrade_type"] = upgradeTypeStr
+
+		if *upgradeType == boundarymodels.HashicorpCloudBoundary20211221UpgradeTypeUPGRADETYPESCHEDULED && clusterMW != nil {
+			dayOfWeekStr := strings.TrimPrefix(string(*clusterMW.DayOfWeek), boundaryClusterDayOfWeekPrefix)
+			mwConfig["day"] = dayOfWeekStr
+			mwConfig["start"] = clusterMW.Start
+			mwConfig["end"] = clusterMW.End
+		} else if *upgradeType == boundarymodels.HashicorpCloudBoundary20211221UpgradeTypeUPGRADETYPESCHEDULED && clusterMW == nil {
+			return fmt.Errorf("invalid maintenance window: missing configuration for SCHEDULED upgrade type")
+		}
+	}
+
+	if err := d.Set("maintenance_window_config", []interface{}{mwConfig}); err != nil {
+		return err
+	}
+
+	authTTL, err := time.ParseDuration(clusterCtrlConfig.AuthTokenTimeToLive)
+	if err != nil {
+		return fmt.Errorf("unable to parse auth_token_time_to_live to time: %v", err)
+	}
+
+	authTTS, err := time.ParseDuration(clusterCtrlConfig.AuthTokenTimeToStale)
+	if err != nil {
+		return fmt.Errorf("unable to parse auth_token_time_to_stale to time: %v", err)
+	}
+
+	if err := d.Set("auth_token_time_to_live", authTTL.String()); err != nil {
+		return err
+	}
+
+	if err := d.Set("auth_token_time_to_stale", authTTS.String()); err != nil {
+		return err
+	}
+
+	if err := d.Set("version", cluster.BoundaryVersion); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providersdkv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+// defaultConsulAgentConfigTimeoutDuration is the default timeout for reading
+// the agent config.
+var defaultConsulAgentConfigTimeoutDuration = time.Minute * 5
+
+// defaultAgentACLTokenPlaceholder is the placeholder of the ACL token of the
+// agent in the agent config, which is replaced by the actual token when the
+// config is installed.
+const defaultAgentACLTokenPlaceholder = "<CONSUL_AGENT_TOKEN>"
+
+// agentConfigTemplate is the template used to generate the HCL config of a
+// Consul client agent connecting to an HCP Consul cluster.
+//
+// see generateAgentConfig for details on the inputs passed in
+const agentConfigTemplate = `datacenter = %q
+data_dir   = %q
+server     = false
+encrypt    = %q
+retry_join = %s
+
+tls {
+  defaults {
+    ca_file         = %q
+    verify_outgoing = true
+  }
+
+  internal_rpc {
+    verify_server_hostname = true
+  }
+}
+
+auto_encrypt {
+  tls = true
+}
+
+acl {
+  enabled        = true
+  default_policy = "deny"
+  down_policy    = "async-cache"
+
+  tokens {
+    agent = %q
+  }
+}
+`
+
+func dataSourceConsulAgentConfig() *schema.Resource {
+	return &schema.Resource{
+		DeprecationMessage: "HashiCorp plans to sunset HashiCorp Consul Dedicated (HCD) in November 2025, more information about the EOL will be provided to existing customers directly",
+		Description: "The Consul agent config data source provides the HCL configuration of a Consul client agent running on a virtual machine, " +
+			"with the gossip encryption key, CA certificate and `retry_join` addresses needed to connect to the Consul cluster.",
+		Timeouts: &schema.ResourceTimeout{
+			Default: &defaultConsulAgentConfigTimeoutDuration,
+		},
+		ReadContext: dataSourceConsulAgentConfigRead,
+		Schema: map[string]*schema.Schema{
+			// Required inputs
+			"cluster_id": {
+				Description:      "The ID of the HCP Consul cluster.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateSlugID,
+			},
+			// Optional inputs
+			"project_id": {
+				Description: `
+The ID of the HCP project where the HCP Consul cluster is located.
+If not specified, the project specified in the HCP Provider config block will be used, if configured.
+If a project is not configured in the HCP Provider config block, the oldest project in the organization will be used.`,
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"data_dir": {
+				Description:      "The data directory of the Consul agent.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "/opt/consul",
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"ca_file_path": {
+				Description:      "The path where the CA certificate of the cluster, provided in `ca_file`, is installed on the machine.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "/etc/consul.d/ca.pem",
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"acl_token_placeholder": {
+				Description:      "The placeholder of the ACL token of the agent in `config`, to be replaced by the actual token when the config is installed.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          defaultAgentACLTokenPlaceholder,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			// Computed outputs
+			"config": {
+				Description: "The agent config, in HCL.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ca_file": {
+				Description: "The CA certificate of the cluster, in PEM format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// dataSourceConsulAgentConfigRead is the func to implement reading of the
+// Consul agent config for an HCP cluster.
+func dataSourceConsulAgentConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client)
+	clusterID := d.Get("cluster_id").(string)
+
+	projectID, err := GetProjectID(d.Get("project_id").(string), client.Config.ProjectID)
+	if err != nil {
+		return diag.Errorf("unable to retrieve project ID: %v", err)
+	}
+
+	loc := &models.HashicorpCloudLocationLocation{
+		OrganizationID: client.Config.OrganizationID,
+		ProjectID:      projectID,
+	}
+
+	consulConfig, caFile, err := getConsulClientConfig(ctx, client, loc, clusterID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("config", generateAgentConfig(
+		consulConfig,
+		d.Get("data_dir").(string),
+		d.Get("ca_file_path").(string),
+		d.Get("acl_token_placeholder").(string))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ca_file", caFile); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("project_id", projectID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// build ID and set it
+	link := newLink(loc, ConsulClusterAgentConfigDataSourceType, clusterID)
+	url, err := linkURL(link)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(url)
+
+	return nil
+}
+
+// getConsulClientConfig returns the Consul config of the client agents of a
+// cluster, and the CA certificate of the cluster in PEM format.
+func getConsulClientConfig(ctx context.Context, client *clients.Client, loc *models.HashicorpCloudLocationLocation,
+	clusterID string) (*ConsulConfig, string, error) {
+
+	clientConfigFiles, err := clients.GetConsulClientConfigFiles(ctx, client, loc, clusterID)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to retrieve Consul cluster (%s) client config files: %v", clusterID, err)
+	}
+
+	// The config files are base64 encoded in the response, and decoded by the
+	// SDK.
+	var consulConfig ConsulConfig
+	if err := json.Unmarshal(clientConfigFiles.ConsulConfigFile, &consulConfig); err != nil {
+		return nil, "", fmt.Errorf("failed to json unmarshal consul config %v", err)
+	}
+
+	return &consulConfig, string(clientConfigFiles.CaFile), nil
+}
+
+// generateAgentConfig will generate the HCL config of a client agent based on
+// the passed in Consul config, data directory, path of the CA certificate and
+// ACL token of the agent.
+func generateAgentConfig(consulConfig *ConsulConfig, dataDir, caFilePath, aclToken string) string {
+	retryJoin := make([]string, 0, len(consulConfig.RetryJoin))
+	for _, address := range consulConfig.RetryJoin {
+		retryJoin = append(retryJoin, fmt.Sprintf("%q", address))
+	}
+
+	return fmt.Sprintf(agentConfigTemplate,
+		consulConfig.Datacenter,
+		dataDir,
+		consulConfig.Encrypt,
+		"["+strings.Join(retryJoin, ", ")+"]",
+		caFilePath,
+		aclToken,
+	)
+}
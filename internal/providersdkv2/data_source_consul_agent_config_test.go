@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providersdkv2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testConsulConfig = &ConsulConfig{
+	Datacenter: "consul-cluster",
+	Encrypt:    "GOSSIP+KEY==",
+	RetryJoin:  []string{"consul-cluster.private.consul.abc123.aws.hashicorp.cloud", "10.0.0.4"},
+}
+
+func TestGenerateAgentConfig(t *testing.T) {
+	expected := `datacenter = "consul-cluster"
+data_dir   = "/opt/consul"
+server     = false
+encrypt    = "GOSSIP+KEY=="
+retry_join = ["consul-cluster.private.consul.abc123.aws.hashicorp.cloud", "10.0.0.4"]
+
+tls {
+  defaults {
+    ca_file         = "/etc/consul.d/ca.pem"
+    verify_outgoing = true
+  }
+
+  internal_rpc {
+    verify_server_hostname = true
+  }
+}
+
+auto_encrypt {
+  tls = true
+}
+
+acl {
+  enabled        = true
+  default_policy = "deny"
+  down_policy    = "async-cache"
+
+  tokens {
+    agent = "<CONSUL_AGENT_TOKEN>"
+  }
+}
+`
+
+	config := generateAgentConfig(testConsulConfig, "/opt/consul", "/etc/consul.d/ca.pem", defaultAgentACLTokenPlaceholder)
+	assert.Equal(t, expected, config)
+}
+
+func TestGenerateECSContainerDefinition(t *testing.T) {
+	out, err := generateECSContainerDefinition(testConsulConfig, "-----BEGIN CERTIFICATE-----\n", "consul-client", "hashicorp/consul:1.16.3",
+		"arn:aws:secretsmanager:us-west-2:123456789012:secret:gossip", "arn:aws:secretsmanager:us-west-2:123456789012:secret:token")
+	require.NoError(t, err)
+
+	var definition ecsContainerDefinition
+	require.NoError(t, json.Unmarshal([]byte(out), &definition))
+
+	assert.Equal(t, "consul-client", definition.Name)
+	assert.Equal(t, "hashicorp/consul:1.16.3", definition.Image)
+	assert.Equal(t, []string{"/bin/sh", "-ec"}, definition.EntryPoint)
+	assert.Equal(t, []ecsKeyValuePair{{Name: "CONSUL_CACERT_PEM", Value: "-----BEGIN CERTIFICATE-----\n"}}, definition.Environment)
+	assert.Equal(t, []ecsContainerSecret{
+		{Name: "CONSUL_GOSSIP_KEY", ValueFrom: "arn:aws:secretsmanager:us-west-2:123456789012:secret:gossip"},
+		{Name: "CONSUL_AGENT_TOKEN", ValueFrom: "arn:aws:secretsmanager:us-west-2:123456789012:secret:token"},
+	}, definition.Secrets)
+
+	// The secrets are expanded by the shell from the environment of the
+	// container, and are not part of the definition.
+	require.Len(t, definition.Command, 1)
+	script := definition.Command[0]
+	assert.Contains(t, script, `printf '%s' "$CONSUL_CACERT_PEM" > /consul/config/ca.pem`)
+	assert.Contains(t, script, `encrypt    = "${CONSUL_GOSSIP_KEY}"`)
+	assert.Contains(t, script, `agent = "${CONSUL_AGENT_TOKEN}"`)
+	assert.Contains(t, script, `ca_file         = "/consul/config/ca.pem"`)
+	assert.Contains(t, script, `data_dir   = "/consul/data"`)
+	assert.Contains(t, script, "exec consul agent -config-dir=/consul/config")
+	assert.NotContains(t, out, "GOSSIP+KEY==")
+
+	// The Consul config of the cluster is left unchanged.
+	assert.Equal(t, "GOSSIP+KEY==", testConsulConfig.Encrypt)
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providersdkv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+// defaultConsulAgentECSConfigTimeoutDuration is the default timeout for
+// reading the agent ECS config.
+var defaultConsulAgentECSConfigTimeoutDuration = time.Minute * 5
+
+const (
+	// ecsGossipKeyVariable is the environment variable of the agent container
+	// set to the gossip encryption key, from its secret.
+	ecsGossipKeyVariable = "CONSUL_GOSSIP_KEY"
+
+	// ecsACLTokenVariable is the environment variable of the agent container
+	// set to the ACL token of the agent, from its secret.
+	ecsACLTokenVariable = "CONSUL_AGENT_TOKEN"
+
+	// ecsCACertVariable is the environment variable of the agent container
+	// set to the CA certificate of the cluster.
+	ecsCACertVariable = "CONSUL_CACERT_PEM"
+)
+
+// ecsAgentScriptTemplate is the template of the script that the agent
+// container runs: it writes the CA certificate and the agent config, in which
+// the shell expands the secrets of the container, and starts the agent.
+const ecsAgentScriptTemplate = `printf '%%s' "$%s" > /consul/config/ca.pem
+cat > /consul/config/client.hcl <<EOF
+%sEOF
+exec consul agent -config-dir=/consul/config`
+
+// ecsContainerDefinition is the container definition of an ECS task
+// definition.
+type ecsContainerDefinition struct {
+	Name        string               `json:"name"`
+	Image       string               `json:"image"`
+	Essential   bool                 `json:"essential"`
+	EntryPoint  []string             `json:"entryPoint"`
+	Command     []string             `json:"command"`
+	Environment []ecsKeyValuePair    `json:"environment"`
+	Secrets     []ecsContainerSecret `json:"secrets"`
+}
+
+// ecsKeyValuePair is an environment variable of an ECS container definition.
+type ecsKeyValuePair struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ecsContainerSecret is a secret of an ECS container definition, exposed to
+// the container as an environment variable.
+type ecsContainerSecret struct {
+	Name      string `json:"name"`
+	ValueFrom string `json:"valueFrom"`
+}
+
+func dataSourceConsulAgentECSConfig() *schema.Resource {
+	return &schema.Resource{
+		DeprecationMessage: "HashiCorp plans to sunset HashiCorp Consul Dedicated (HCD) in November 2025, more information about the EOL will be provided to existing customers directly",
+		Description: "The Consul agent ECS config data source provides the container definition of a Consul client agent running in an Amazon ECS task. " +
+			"The gossip encryption key and the ACL token of the agent are read from secrets, so that they are not stored in the task definition.",
+		Timeouts: &schema.ResourceTimeout{
+			Default: &defaultConsulAgentECSConfigTimeoutDuration,
+		},
+		ReadContext: dataSourceConsulAgentECSConfigRead,
+		Schema: map[string]*schema.Schema{
+			// Required inputs
+			"cluster_id": {
+				Description:      "The ID of the HCP Consul cluster.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateSlugID,
+			},
+			"gossip_key_secret_arn": {
+				Description:      "The ARN of the AWS Secrets Manager secret or SSM parameter holding the gossip encryption key of the cluster, provided in `gossip_key`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"acl_token_secret_arn": {
+				Description:      "The ARN of the AWS Secrets Manager secret or SSM parameter holding the ACL token of the agent.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			// Optional inputs
+			"project_id": {
+				Description: `
+The ID of the HCP project where the HCP Consul cluster is located.
+If not specified, the project specified in the HCP Provider config block will be used, if configured.
+If a project is not configured in the HCP Provider config block, the oldest project in the organization will be used.`,
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"container_name": {
+				Description:      "The name of the agent container.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "consul-client",
+				ValidateDiagFunc: validateStringNotEmpty,
+			},
+			"image": {
+				Description: "The image of the agent container. If not specified, the `hashicorp/consul` image of the Consul version of the cluster is used.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			// Computed outputs
+			"container_definition": {
+				Description: "The container definition of the agent, in JSON, to be added to the container definitions of a task definition.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"gossip_key": {
+				Description: "The gossip encryption key of the cluster, to be stored in the secret of `gossip_key_secret_arn`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"ca_file": {
+				Description: "The CA certificate of the cluster, in PEM format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// dataSourceConsulAgentECSConfigRead is the func to implement reading of the
+// Consul agent ECS config for an HCP cluster.
+func dataSourceConsulAgentECSConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client)
+	clusterID := d.Get("cluster_id").(string)
+
+	projectID, err := GetProjectID(d.Get("project_id").(string), client.Config.ProjectID)
+	if err != nil {
+		return diag.Errorf("unable to retrieve project ID: %v", err)
+	}
+
+	loc := &models.HashicorpCloudLocationLocation{
+		OrganizationID: client.Config.OrganizationID,
+		ProjectID:      projectID,
+	}
+
+	image := d.Get("image").(string)
+	if image == "" {
+		cluster, err := clients.GetConsulClusterByID(ctx, client, loc, clusterID)
+		if err != nil {
+			if clients.IsResponseCodeNotFound(err) {
+				return diag.Errorf("unable to read Consul agent ECS config; Consul cluster (%s) not found", clusterID)
+			}
+			return diag.Errorf("unable to check for presence of an existing Consul cluster (%s): %v", clusterID, err)
+		}
+		image = "hashicorp/consul:" + strings.TrimPrefix(cluster.ConsulVersion, "v")
+	}
+
+	consulConfig, caFile, err := getConsulClientConfig(ctx, client, loc, clusterID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	containerDefinition, err := generateECSContainerDefinition(
+		consulConfig,
+		caFile,
+		d.Get("container_name").(string),
+		image,
+		d.Get("gossip_key_secret_arn").(string),
+		d.Get("acl_token_secret_arn").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("container_definition", containerDefinition); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("image", image); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("gossip_key", consulConfig.Encrypt); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ca_file", caFile); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("project_id", projectID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// build ID and set it
+	link := newLink(loc, ConsulClusterAgentECSConfigDataSourceType, clusterID)
+	url, err := linkURL(link)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(url)
+
+	return nil
+}
+
+// generateECSContainerDefinition will generate the JSON container definition
+// of a client agent based on the passed in Consul config, CA certificate,
+// container name and image, and ARNs of the secrets of the gossip encryption
+// key and ACL token. The agent config is the same as the config of agents
+// running on virtual machines, with the secrets expanded from the environment
+// of the container.
+func generateECSContainerDefinition(consulConfig *ConsulConfig, caFile, name, image, gossipKeySecretARN, aclTokenSecretARN string) (string, error) {
+	agentConfig := *consulConfig
+	agentConfig.Encrypt = "${" + ecsGossipKeyVariable + "}"
+	hcl := generateAgentConfig(&agentConfig, "/consul/data", "/consul/config/ca.pem", "${"+ecsACLTokenVariable+"}")
+
+	definition := ecsContainerDefinition{
+		Name:       name,
+		Image:      image,
+		Essential:  false,
+		EntryPoint: []string{"/bin/sh", "-ec"},
+		Command:    []string{fmt.Sprintf(ecsAgentScriptTemplate, ecsCACertVariable, hcl)},
+		Environment: []ecsKeyValuePair{
+			{Name: ecsCACertVariable, Value: caFile},
+		},
+		Secrets: []ecsContainerSecret{
+			{Name: ecsGossipKeyVariable, ValueFrom: gossipKeySecretARN},
+			{Name: ecsACLTokenVariable, ValueFrom: aclTokenSecretARN},
+		},
+	}
+
+	out, err := json.Marshal(definition)
+	if err != nil {
+		return "", fmt.Errorf("unable to json marshal ECS container definition: %w", err)
+	}
+	return string(out), nil
+}
@@ -5,8 +5,6 @@ package providersdkv2
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -145,26 +143,9 @@ func dataSourceConsulAgentHelmConfigRead(ctx context.Context, d *schema.Resource
 		)
 	}
 
-	// get the cluster's Consul client config files
-	clientConfigFiles, err := clients.GetConsulClientConfigFiles(ctx, client, loc, clusterID)
+	consulConfig, _, err := getConsulClientConfig(ctx, client, loc, clusterID)
 	if err != nil {
-		return diag.Errorf("unable to retrieve Consul cluster (%s) client config files: %v", clusterID, err)
-	}
-
-	// pull off the config string
-	configStr := clientConfigFiles.ConsulConfigFile.String()
-
-	// decode it
-	consulConfigJSON, err := base64.StdEncoding.DecodeString(configStr)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("unable to base64 decode Consul config (%v): %v", configStr, err))
-	}
-
-	// unmarshal from JSON
-	var consulConfig ConsulConfig
-	err = json.Unmarshal(consulConfigJSON, &consulConfig)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to json unmarshal consul config %v", err))
+		return diag.FromErr(err)
 	}
 
 	// generate helm config and set on data source
@@ -6,7 +6,6 @@ package providersdkv2
 import (
 	"context"
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -86,30 +85,13 @@ func dataSourceConsulAgentKubernetesSecretRead(ctx context.Context, d *schema.Re
 		ProjectID:      projectID,
 	}
 
-	// get the cluster's Consul client config files
-	clientConfigFiles, err := clients.GetConsulClientConfigFiles(ctx, client, loc, clusterID)
+	consulConfig, caFile, err := getConsulClientConfig(ctx, client, loc, clusterID)
 	if err != nil {
-		return diag.Errorf("unable to retrieve Consul cluster (%s) client config files: %v", clusterID, err)
-	}
-
-	// pull off the config string
-	configStr := clientConfigFiles.ConsulConfigFile.String()
-
-	// decode it
-	consulConfigJSON, err := base64.StdEncoding.DecodeString(configStr)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to base64 decode consul config (%v): %w", configStr, err))
-	}
-
-	// unmarshal from JSON
-	var consulConfig ConsulConfig
-	err = json.Unmarshal(consulConfigJSON, &consulConfig)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to json unmarshal consul config %v", err))
+		return diag.FromErr(err)
 	}
 
 	encodedGossipKey := base64.StdEncoding.EncodeToString([]byte(consulConfig.Encrypt))
-	encodedCAFile := clientConfigFiles.CaFile
+	encodedCAFile := base64.StdEncoding.EncodeToString([]byte(caFile))
 
 	err = d.Set("secret", fmt.Sprintf(agentConfigKubernetesSecretTemplate, clusterID, encodedGossipKey, encodedCAFile))
 	if err != nil {
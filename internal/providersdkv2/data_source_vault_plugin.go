@@ -53,6 +53,12 @@ If a project is not configured in the HCP Provider config block, the oldest proj
 				ValidateFunc: validation.IsUUID,
 				Computed:     true,
 			},
+			// Computed outputs
+			"plugin_version": {
+				Description: "The version of the plugin.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -82,20 +88,23 @@ func dataSourceVaultPluginRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	found := false
+	var found *vaultmodels.HashicorpCloudVault20201125PluginRegistrationStatus
 	for _, plugin := range pluginsResp.Plugins {
 		if strings.EqualFold(pluginName, plugin.PluginName) && pluginType == *plugin.PluginType && plugin.IsRegistered {
-			found = true
+			found = plugin
 			d.SetId(vaultPluginResourceID(projectID, clusterID, pluginTypeString, pluginName))
 			break
 		}
 	}
 
 	// If Plugin found, update resource data.
-	if found {
+	if found != nil {
 		if err := setVaultPluginResourceData(d, projectID, clusterID, pluginName, pluginTypeString); err != nil {
 			return diag.FromErr(err)
 		}
+		if err := d.Set("plugin_version", found.PluginVersion); err != nil {
+			return diag.FromErr(err)
+		}
 		return nil
 	} else {
 		return diag.Errorf("unable to retrieve registered plugin: %s", pluginName)
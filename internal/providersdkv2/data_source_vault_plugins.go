@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providersdkv2
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+func dataSourceVaultPlugins() *schema.Resource {
+	return &schema.Resource{
+		Description: "The Vault plugins data source lists the plugins available in the plugin catalog of an HCP Vault cluster, " +
+			"which depends on the Vault version of the cluster, and whether they are registered.",
+		ReadContext: dataSourceVaultPluginsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Default: &defaultVaultPluginTimeout,
+		},
+		Schema: map[string]*schema.Schema{
+			// Required inputs
+			"cluster_id": {
+				Description:      "The ID of the HCP Vault cluster.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateSlugID,
+			},
+			// Optional inputs
+			"project_id": {
+				Description: `
+The ID of the HCP project where the HCP Vault cluster is located.
+If not specified, the project specified in the HCP Provider config block will be used, if configured.
+If a project is not configured in the HCP Provider config block, the oldest project in the organization will be used.`,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+				Computed:     true,
+			},
+			// Computed outputs
+			"vault_version": {
+				Description: "The Vault version of the cluster, which determines the plugins and versions available.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"plugins": {
+				Description: "The plugins available on the cluster, sorted by type and name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"plugin_name": {
+							Description: "The name of the plugin.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"plugin_type": {
+							Description: "The type of the plugin, one of `SECRET`, `AUTH` or `DATABASE`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"plugin_version": {
+							Description: "The version of the plugin available for the Vault version of the cluster.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"is_registered": {
+							Description: "Whether the plugin is registered on the cluster.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVaultPluginsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clusterID := d.Get("cluster_id").(string)
+	client := meta.(*clients.Client)
+
+	projectID, err := GetProjectID(d.Get("project_id").(string), client.Config.ProjectID)
+	if err != nil {
+		return diag.Errorf("unable to retrieve project ID: %v", err)
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: client.Config.OrganizationID,
+		ProjectID:      projectID,
+	}
+
+	cluster, err := clients.GetVaultClusterByID(ctx, client, loc, clusterID)
+	if err != nil {
+		return diag.Errorf("unable to fetch Vault cluster (%s): %v", clusterID, err)
+	}
+
+	log.Printf("[INFO] Listing plugins for Vault cluster (%s) [project_id=%s, organization_id=%s]", clusterID, loc.ProjectID, loc.OrganizationID)
+
+	pluginsResp, err := clients.ListPlugins(ctx, client, loc, clusterID)
+	if err != nil {
+		return diag.Errorf("unable to list plugins of Vault cluster (%s): %v", clusterID, err)
+	}
+
+	link := newLink(loc, VaultClusterResourceType, clusterID)
+	url, err := linkURL(link)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(url + "/plugins")
+
+	if err := d.Set("project_id", projectID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("vault_version", cluster.CurrentVersion); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("plugins", flattenVaultPlugins(pluginsResp.Plugins)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenVaultPlugins(plugins []*vaultmodels.HashicorpCloudVault20201125PluginRegistrationStatus) []interface{} {
+	sorted := make([]*vaultmodels.HashicorpCloudVault20201125PluginRegistrationStatus, 0, len(plugins))
+	for _, plugin := range plugins {
+		if plugin != nil {
+			sorted = append(sorted, plugin)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ti, tj := pluginTypeString(sorted[i]), pluginTypeString(sorted[j])
+		if ti != tj {
+			return ti < tj
+		}
+		return sorted[i].PluginName < sorted[j].PluginName
+	})
+
+	result := make([]interface{}, 0, len(sorted))
+	for _, plugin := range sorted {
+		result = append(result, map[string]interface{}{
+			"plugin_name":    plugin.PluginName,
+			"plugin_type":    pluginTypeString(plugin),
+			"plugin_version": plugin.PluginVersion,
+			"is_registered":  plugin.IsRegistered,
+		})
+	}
+
+	return result
+}
+
+func pluginTypeString(plugin *vaultmodels.HashicorpCloudVault20201125PluginRegistrationStatus) string {
+	if plugin.PluginType == nil {
+		return ""
+	}
+	return string(*plugin.PluginType)
+}
@@ -51,6 +51,14 @@ const (
 	// type of a Consul cluster agent Kubernetes secret
 	ConsulClusterAgentKubernetesSecretDataSourceType = ConsulClusterResourceType + ".agent-kubernetes-secret"
 
+	// ConsulClusterAgentConfigDataSourceType is the data source type of a
+	// Consul cluster agent config
+	ConsulClusterAgentConfigDataSourceType = ConsulClusterResourceType + ".agent-config"
+
+	// ConsulClusterAgentECSConfigDataSourceType is the data source type of a
+	// Consul cluster agent ECS config
+	ConsulClusterAgentECSConfigDataSourceType = ConsulClusterResourceType + ".agent-ecs-config"
+
 	// VaultClusterResourceType is the resource type of a Vault cluster
 	VaultClusterResourceType = "hashicorp.vault.cluster"
 
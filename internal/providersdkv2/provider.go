@@ -29,6 +29,8 @@ func New() func() *schema.Provider {
 				"hcp_aws_transit_gateway_attachment": dataSourceAwsTransitGatewayAttachment(),
 				"hcp_azure_peering_connection":       dataSourceAzurePeeringConnection(),
 				"hcp_boundary_cluster":               dataSourceBoundaryCluster(),
+				"hcp_consul_agent_config":            dataSourceConsulAgentConfig(),
+				"hcp_consul_agent_ecs_config":        dataSourceConsulAgentECSConfig(),
 				"hcp_consul_agent_helm_config":       dataSourceConsulAgentHelmConfig(),
 				"hcp_consul_agent_kubernetes_secret": dataSourceConsulAgentKubernetesSecret(),
 				"hcp_consul_cluster":                 dataSourceConsulCluster(),
@@ -43,13 +45,12 @@ func New() func() *schema.Provider {
 				"hcp_private_link":                   dataSourcePrivateLink(),
 				"hcp_vault_cluster":                  dataSourceVaultCluster(),
 				"hcp_vault_plugin":                   dataSourceVaultPlugin(),
+				"hcp_vault_plugins":                  dataSourceVaultPlugins(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
 				"hcp_aws_network_peering":            resourceAwsNetworkPeering(),
 				"hcp_aws_transit_gateway_attachment": resourceAwsTransitGatewayAttachment(),
 				"hcp_azure_peering_connection":       resourceAzurePeeringConnection(),
-				"hcp_boundary_cluster":               resourceBoundaryCluster(),
-				"hcp_consul_cluster":                 resourceConsulCluster(),
 				"hcp_consul_cluster_root_token":      resourceConsulClusterRootToken(),
 				"hcp_consul_snapshot":                resourceConsulSnapshot(),
 				"hcp_dns_forwarding":                 resourceDNSForwarding(),
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-version"
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
 	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -168,10 +169,12 @@ If a project is not configured in the HCP Provider config block, the oldest proj
 				Computed:    true,
 			},
 			"metrics_config": {
-				Description: "The metrics configuration for export. (https://developer.hashicorp.com/vault/tutorials/cloud-monitoring/vault-metrics-guide#metrics-streaming-configuration)",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
+				Description: "The metrics configuration for export. (https://developer.hashicorp.com/vault/tutorials/cloud-monitoring/vault-metrics-guide#metrics-streaming-configuration) " +
+					"It must not be used together with the hcp_vault_cluster_metrics_streaming resource for the same cluster.",
+				Deprecated: "The metrics_config block is deprecated, use the hcp_vault_cluster_metrics_streaming resource instead.",
+				Type:       schema.TypeList,
+				MaxItems:   1,
+				Optional:   true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"grafana_endpoint": {
@@ -325,10 +328,12 @@ If a project is not configured in the HCP Provider config block, the oldest proj
 				},
 			},
 			"audit_log_config": {
-				Description: "The audit logs configuration for export. (https://developer.hashicorp.com/vault/tutorials/cloud-monitoring/vault-metrics-guide#metrics-streaming-configuration)",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
+				Description: "The audit logs configuration for export. (https://developer.hashicorp.com/vault/tutorials/cloud-monitoring/vault-metrics-guide#metrics-streaming-configuration) " +
+					"It must not be used together with the hcp_vault_cluster_audit_log_streaming resource for the same cluster.",
+				Deprecated: "The audit_log_config block is deprecated, use the hcp_vault_cluster_audit_log_streaming resource instead.",
+				Type:       schema.TypeList,
+				MaxItems:   1,
+				Optional:   true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"grafana_endpoint": {
@@ -530,6 +535,56 @@ If a project is not configured in the HCP Provider config block, the oldest proj
 					},
 				},
 			},
+			"minor_version_upgrade_config": {
+				Description: "The Minor Version Upgrade configuration, which also applies to patch upgrades.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"upgrade_type": {
+							Description:      "The minor upgrade type for the cluster. Valid options for upgrade type - `AUTOMATIC`, `SCHEDULED`, `MANUAL`",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateVaultUpgradeType,
+							DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+								return strings.EqualFold(old, new)
+							},
+						},
+						"maintenance_window_day": {
+							Description:      "The maintenance day of the week for scheduled upgrades. Valid options for maintenance window day - `MONDAY`, `TUESDAY`, `WEDNESDAY`, `THURSDAY`, `FRIDAY`, `SATURDAY`, `SUNDAY`",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateVaultUpgradeWindowDay,
+							DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+								return strings.EqualFold(old, new)
+							},
+						},
+						"maintenance_window_time": {
+							Description:      "The maintenance time frame for scheduled upgrades. Valid options for maintenance window time - `WINDOW_12AM_4AM`, `WINDOW_6AM_10AM`, `WINDOW_12PM_4PM`, `WINDOW_6PM_10PM`",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateVaultUpgradeWindowTime,
+							DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+								return strings.EqualFold(old, new)
+							},
+						},
+					},
+				},
+			},
+			"upgrade_to_version": {
+				Description: "The Vault version to upgrade the cluster to. When set to a version newer than the current `vault_version`, " +
+					"the cluster is upgraded immediately, regardless of the major and minor version upgrade configurations. " +
+					"Nothing is done when the cluster already runs this version or a newer one, for instance after a scheduled upgrade. " +
+					"Use the `hcp_vault_cluster_pending_upgrades` data source to list the versions available to the cluster.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateSemVer,
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					return sameVaultVersion(old, new)
+				},
+			},
 			"vault_public_endpoint_url": {
 				Description: "The public URL for the Vault cluster. This will be empty if `public_endpoint` is `false`.",
 				Type:        schema.TypeString,
@@ -593,6 +648,10 @@ func resourceVaultClusterCreate(ctx context.Context, d *schema.ResourceData, met
 	if diagErr != nil {
 		return diagErr
 	}
+	minorConfig, diagErr := getMinorVersionUpgradeConfig(d)
+	if diagErr != nil {
+		return diagErr
+	}
 
 	// Use the hvn to get provider and region.
 	hvn, err := clients.GetHvnByID(ctx, client, loc, hvnID)
@@ -785,7 +844,21 @@ func resourceVaultClusterCreate(ctx context.Context, d *schema.ResourceData, met
 		if err != nil {
 			return diag.Errorf("error updating Vault cluster major version upgrade config (%s): %v", payload.ClusterID, err)
 		}
+	}
+
+	// The same applies to the minor version upgrade configuration.
+	if minorConfig != nil {
+		_, err := clients.UpdateVaultMinorVersionUpgradeConfig(ctx, client, clusterLocationShared, payload.ClusterID, minorConfig)
+		if err != nil {
+			return diag.Errorf("error updating Vault cluster minor version upgrade config (%s): %v", payload.ClusterID, err)
+		}
+	}
 
+	if diagErr := upgradeVaultClusterVersion(ctx, client, d, cluster, clusterLocationShared); diagErr != nil {
+		return diagErr
+	}
+
+	if mvuConfig != nil || minorConfig != nil || d.Get("upgrade_to_version").(string) != "" {
 		// refresh the created Vault cluster.
 		cluster, err = clients.GetVaultClusterByID(ctx, client, loc, payload.ClusterID)
 		if err != nil {
@@ -793,7 +866,7 @@ func resourceVaultClusterCreate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
-	if err := setVaultClusterResourceData(d, cluster); err != nil {
+	if err := setVaultClusterResource(d, cluster); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -832,7 +905,7 @@ func resourceVaultClusterRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	// Cluster found, update resource data.
-	if err := setVaultClusterResourceData(d, cluster); err != nil {
+	if err := setVaultClusterResource(d, cluster); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -874,7 +947,7 @@ func resourceVaultClusterUpdate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	// Confirm at least one modifiable field has changed
-	if !d.HasChanges("tier", "public_endpoint", "proxy_endpoint", "ip_allowlist", "paths_filter", "metrics_config", "audit_log_config", "major_version_upgrade_config") {
+	if !d.HasChanges("tier", "public_endpoint", "proxy_endpoint", "ip_allowlist", "paths_filter", "metrics_config", "audit_log_config", "major_version_upgrade_config", "minor_version_upgrade_config", "upgrade_to_version") {
 		return nil
 	}
 
@@ -883,6 +956,10 @@ func resourceVaultClusterUpdate(ctx context.Context, d *schema.ResourceData, met
 	if diagErr != nil {
 		return diagErr
 	}
+	minorConfig, diagErr := getMinorVersionUpgradeConfig(d)
+	if diagErr != nil {
+		return diagErr
+	}
 
 	if d.HasChange("tier") || d.HasChange("public_endpoint") || d.HasChange("proxy_endpoint") || d.HasChange("ip_allowlist") || d.HasChange("metrics_config") || d.HasChange("audit_log_config") {
 		diagErr := updateVaultClusterConfig(ctx, client, d, cluster, clusterID)
@@ -934,6 +1011,19 @@ func resourceVaultClusterUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if minorConfig != nil {
+		_, err := clients.UpdateVaultMinorVersionUpgradeConfig(ctx, client, clusterLocationShared, clusterID, minorConfig)
+		if err != nil {
+			return diag.Errorf("error updating Vault cluster minor version upgrade config (%s): %v", clusterID, err)
+		}
+	}
+
+	if d.HasChange("upgrade_to_version") {
+		if diagErr := upgradeVaultClusterVersion(ctx, client, d, cluster, clusterLocationShared); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	// Get the updated Vault cluster.
 	cluster, err = clients.GetVaultClusterByID(ctx, client, loc, clusterID)
 
@@ -941,7 +1031,7 @@ func resourceVaultClusterUpdate(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf("unable to retrieve Vault cluster (%s): %v", clusterID, err)
 	}
 
-	if err := setVaultClusterResourceData(d, cluster); err != nil {
+	if err := setVaultClusterResource(d, cluster); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -1105,6 +1195,35 @@ func getIPAllowlist(d *schema.ResourceData, clusterID string) ([]*vaultmodels.Ha
 	return nil, nil
 }
 
+// setVaultClusterResource sets the KV pairs of the Vault cluster resource
+// schema. The metrics and audit log configurations are only tracked when they
+// are set on the resource or when the cluster is imported, since they may be
+// managed by the hcp_vault_cluster_metrics_streaming and
+// hcp_vault_cluster_audit_log_streaming resources instead.
+func setVaultClusterResource(d *schema.ResourceData, cluster *vaultmodels.HashicorpCloudVault20201125Cluster) error {
+	// Right after an import, only the ID of the resource is set.
+	imported := d.Get("cluster_id").(string) == ""
+
+	var untracked []string
+	for _, propertyName := range []string{"metrics_config", "audit_log_config"} {
+		if configParam, ok := d.Get(propertyName).([]interface{}); !imported && (!ok || len(configParam) == 0) {
+			untracked = append(untracked, propertyName)
+		}
+	}
+
+	if err := setVaultClusterResourceData(d, cluster); err != nil {
+		return err
+	}
+
+	for _, propertyName := range untracked {
+		if err := d.Set(propertyName, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // setVaultClusterResourceData sets the KV pairs of the Vault cluster resource schema.
 func setVaultClusterResourceData(d *schema.ResourceData, cluster *vaultmodels.HashicorpCloudVault20201125Cluster) error {
 
@@ -1169,6 +1288,10 @@ func setVaultClusterResourceData(d *schema.ResourceData, cluster *vaultmodels.Ha
 		return err
 	}
 
+	if err := d.Set("minor_version_upgrade_config", flattenMinorVersionUpgradeConfig(cluster.Config.MinorVersionUpgradeConfig)); err != nil {
+		return err
+	}
+
 	if publicEndpoint {
 		// Port 8200 required to communicate with HCP Vault via HTTPS
 		if err := d.Set("vault_public_endpoint_url", fmt.Sprintf("https://%s:8200", cluster.DNSNames.Public)); err != nil {
@@ -1724,6 +1847,130 @@ func flattenMajorVersionUpgradeConfig(config *vaultmodels.HashicorpCloudVault202
 	return []interface{}{configMap}
 }
 
+func getMinorVersionUpgradeConfig(d *schema.ResourceData) (*vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfig, diag.Diagnostics) {
+	if !d.HasChange("minor_version_upgrade_config") {
+		return nil, nil
+	}
+	configParam, ok := d.GetOk("minor_version_upgrade_config")
+	if !ok {
+		return nil, nil
+	}
+
+	configIfaceArr, ok := configParam.([]interface{})
+	if !ok || len(configIfaceArr) == 0 {
+		return nil, nil
+	}
+
+	config, ok := configIfaceArr[0].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	tier := vaultmodels.HashicorpCloudVault20201125TierDEV
+	if inputTier, ok := d.GetOk("tier"); ok {
+		tier = vaultmodels.HashicorpCloudVault20201125Tier(strings.ToUpper(inputTier.(string)))
+	}
+
+	return getValidMinorVersionUpgradeConfig(config, tier)
+}
+
+func getValidMinorVersionUpgradeConfig(config map[string]interface{}, tier vaultmodels.HashicorpCloudVault20201125Tier) (*vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfig, diag.Diagnostics) {
+	if tier == vaultmodels.HashicorpCloudVault20201125TierDEV || tier == vaultmodels.HashicorpCloudVault20201125TierSTARTERSMALL {
+		return nil, diag.Errorf("minor version configuration is only allowed for STANDARD or PLUS clusters")
+	}
+
+	minorConfig := vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfig{}
+
+	upgradeType := vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfigUpgradeType(strings.ToUpper(config["upgrade_type"].(string)))
+	minorConfig.UpgradeType = &upgradeType
+
+	maintenanceWindowDay := strings.ToUpper(config["maintenance_window_day"].(string))
+	maintenanceWindowTime := strings.ToUpper(config["maintenance_window_time"].(string))
+
+	if upgradeType == vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfigUpgradeTypeSCHEDULED {
+		if maintenanceWindowDay == "" || maintenanceWindowTime == "" {
+			return nil, diag.Errorf("minor version upgrade configuration is invalid: maintenance window configuration information missing")
+		}
+		dayOfWeek := vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfigMaintenanceWindowDayOfWeek(maintenanceWindowDay)
+		timeWindowUtc := vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfigMaintenanceWindowTimeWindowUTC(maintenanceWindowTime)
+		minorConfig.MaintenanceWindow = &vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfigMaintenanceWindow{
+			DayOfWeek:     &dayOfWeek,
+			TimeWindowUtc: &timeWindowUtc,
+		}
+	} else if maintenanceWindowDay != "" || maintenanceWindowTime != "" {
+		return nil, diag.Errorf("minor version upgrade configuration is invalid: maintenance window is only allowed to SCHEDULED upgrades")
+	}
+
+	return &minorConfig, nil
+}
+
+func flattenMinorVersionUpgradeConfig(config *vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfig) []interface{} {
+	if config == nil || config.UpgradeType == nil {
+		return []interface{}{}
+	}
+
+	configMap := map[string]interface{}{
+		"upgrade_type": string(*config.UpgradeType),
+	}
+	if *config.UpgradeType == vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfigUpgradeTypeSCHEDULED && config.MaintenanceWindow != nil {
+		if config.MaintenanceWindow.DayOfWeek != nil {
+			configMap["maintenance_window_day"] = string(*config.MaintenanceWindow.DayOfWeek)
+		}
+		if config.MaintenanceWindow.TimeWindowUtc != nil {
+			configMap["maintenance_window_time"] = string(*config.MaintenanceWindow.TimeWindowUtc)
+		}
+	}
+
+	return []interface{}{configMap}
+}
+
+// upgradeVaultClusterVersion upgrades the cluster to the version set in upgrade_to_version, unless it
+// already runs that version or a newer one.
+func upgradeVaultClusterVersion(ctx context.Context, client *clients.Client, d *schema.ResourceData,
+	cluster *vaultmodels.HashicorpCloudVault20201125Cluster, loc *sharedmodels.HashicorpCloudLocationLocation) diag.Diagnostics {
+
+	targetVersion := d.Get("upgrade_to_version").(string)
+	if targetVersion == "" || !olderVaultVersion(cluster.CurrentVersion, targetVersion) {
+		return nil
+	}
+
+	log.Printf("[INFO] Upgrading Vault cluster (%s) from version %s to %s", cluster.ID, cluster.CurrentVersion, targetVersion)
+
+	upgradeResp, err := clients.UpdateVaultClusterVersion(ctx, client, loc, cluster.ID, targetVersion)
+	if err != nil {
+		return diag.Errorf("error upgrading Vault cluster (%s) to version %s: %v", cluster.ID, targetVersion, err)
+	}
+
+	// Wait for the upgrade operation.
+	if err := clients.WaitForOperation(ctx, client, "upgrade Vault cluster", loc, upgradeResp.Operation.ID); err != nil {
+		return diag.Errorf("unable to upgrade Vault cluster (%s) to version %s: %v", cluster.ID, targetVersion, err)
+	}
+
+	return nil
+}
+
+// sameVaultVersion reports whether two Vault versions are equal, ignoring the
+// optional "v" prefix the Vault service uses.
+func sameVaultVersion(a, b string) bool {
+	va, errA := version.NewSemver(a)
+	vb, errB := version.NewSemver(b)
+	if errA != nil || errB != nil {
+		return strings.TrimPrefix(a, "v") == strings.TrimPrefix(b, "v")
+	}
+	return va.Equal(vb)
+}
+
+// olderVaultVersion reports whether the Vault version a is older than b. If
+// either version is not a valid semver, it reports whether they differ.
+func olderVaultVersion(a, b string) bool {
+	va, errA := version.NewSemver(a)
+	vb, errB := version.NewSemver(b)
+	if errA != nil || errB != nil {
+		return !sameVaultVersion(a, b)
+	}
+	return va.LessThan(vb)
+}
+
 func resourceVaultClusterImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// with multi-projects, import arguments must become dynamic:
 	// use explicit project ID with terraform import:
@@ -1837,7 +2084,7 @@ func getPathStrings(pathFilter interface{}) []string {
 }
 
 func printPlusScalingWarningMsg() {
-	log.Printf("[WARN] When scaling Plus-tier Vault clusters, be sure to keep the size of all clusters in a replication group in sync")
+	log.Printf("[WARN] When scaling Plus-tier Vault clusters, be sure to keep the size of all clusters in a replication group in sync, or manage them with the hcp_vault_replication_group resource")
 }
 
 // buildIPAllowlistVaultCluster returns a vault model for the IP allowlist.
@@ -230,3 +230,104 @@ func TestGetValidMajorVersionUpgradeConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestGetValidMinorVersionUpgradeConfig(t *testing.T) {
+	cases := []struct {
+		config        map[string]interface{}
+		expectedError string
+		tier          vaultmodels.HashicorpCloudVault20201125Tier
+	}{
+		{
+			config: map[string]interface{}{
+				"upgrade_type":            "MANUAL",
+				"maintenance_window_day":  "",
+				"maintenance_window_time": "",
+			},
+			expectedError: "minor version configuration is only allowed for STANDARD or PLUS clusters",
+			tier:          vaultmodels.HashicorpCloudVault20201125TierDEV,
+		},
+		{
+			config: map[string]interface{}{
+				"upgrade_type":            "AUTOMATIC",
+				"maintenance_window_day":  "MONDAY",
+				"maintenance_window_time": "WINDOW_6AM_10AM",
+			},
+			expectedError: "minor version upgrade configuration is invalid: maintenance window is only allowed to SCHEDULED upgrades",
+			tier:          vaultmodels.HashicorpCloudVault20201125TierPLUSSMALL,
+		},
+		{
+			config: map[string]interface{}{
+				"upgrade_type":            "SCHEDULED",
+				"maintenance_window_day":  "FRIDAY",
+				"maintenance_window_time": "",
+			},
+			expectedError: "minor version upgrade configuration is invalid: maintenance window configuration information missing",
+			tier:          vaultmodels.HashicorpCloudVault20201125TierSTANDARDSMALL,
+		},
+	}
+
+	for _, c := range cases {
+		_, diags := getValidMinorVersionUpgradeConfig(c.config, c.tier)
+		foundError := false
+		if diags.HasError() {
+			for _, d := range diags {
+				if strings.Contains(d.Summary, c.expectedError) {
+					foundError = true
+					break
+				}
+			}
+		}
+		if !foundError {
+			t.Fatalf("Expected an error: %v", c.expectedError)
+		}
+	}
+
+	config, diags := getValidMinorVersionUpgradeConfig(map[string]interface{}{
+		"upgrade_type":            "scheduled",
+		"maintenance_window_day":  "sunday",
+		"maintenance_window_time": "window_12am_4am",
+	}, vaultmodels.HashicorpCloudVault20201125TierSTANDARDSMALL)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if *config.MaintenanceWindow.DayOfWeek != vaultmodels.HashicorpCloudVault20201125MinorVersionUpgradeConfigMaintenanceWindowDayOfWeekSUNDAY {
+		t.Fatalf("unexpected maintenance window day: %v", *config.MaintenanceWindow.DayOfWeek)
+	}
+}
+
+func TestSameVaultVersion(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"v1.15.2", "v1.15.2", true},
+		{"1.15.2", "v1.15.2", true},
+		{"v1.15.2", "v1.15.3", false},
+		{"v1.16.0", "", false},
+	}
+
+	for _, c := range cases {
+		if actual := sameVaultVersion(c.a, c.b); actual != c.expected {
+			t.Errorf("sameVaultVersion(%q, %q) = %t, expected %t", c.a, c.b, actual, c.expected)
+		}
+	}
+}
+
+func TestOlderVaultVersion(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"v1.15.2", "v1.15.3", true},
+		{"1.15.2", "v1.16.0", true},
+		{"v1.15.2", "v1.15.2", false},
+		{"v1.16.0", "v1.15.3", false},
+		{"", "v1.16.0", true},
+	}
+
+	for _, c := range cases {
+		if actual := olderVaultVersion(c.a, c.b); actual != c.expected {
+			t.Errorf("olderVaultVersion(%q, %q) = %t, expected %t", c.a, c.b, actual, c.expected)
+		}
+	}
+}
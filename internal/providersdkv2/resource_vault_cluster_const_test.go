@@ -73,6 +73,29 @@ resource "hcp_vault_cluster" "test" {
 }
 `
 
+// keeps the tier and network of updatedVaultClusterTierPublicProxyAndMVU, and
+// schedules minor version upgrades
+const updatedVaultClusterMinorVersionUpgrade = `
+resource "hcp_vault_cluster" "test" {
+	cluster_id         = "{{ .ClusterID }}"
+	hvn_id             = hcp_hvn.test.hvn_id
+	tier               = "{{ .Tier }}"
+	public_endpoint    = {{ .PublicEndpoint }}
+	proxy_endpoint     = "{{ .ProxyEndpoint }}"
+	major_version_upgrade_config {
+		upgrade_type = "SCHEDULED"
+		maintenance_window_day = "WEDNESDAY"
+		maintenance_window_time = "WINDOW_12AM_4AM"
+	}
+	minor_version_upgrade_config {
+		upgrade_type = "SCHEDULED"
+		maintenance_window_day = "SATURDAY"
+		maintenance_window_time = "WINDOW_6PM_10PM"
+	}
+	{{ .IPAllowlist }}
+}
+`
+
 func setTestAccVaultClusterConfig(t *testing.T, tfCode string, in inputT, tier string) string {
 	tfTemplate := fmt.Sprintf(`
 resource "hcp_hvn" "test" {
@@ -168,6 +168,7 @@ func awsTestSteps(t *testing.T, inp inputT) []resource.TestStep {
 		updateClusterTier(t, in),
 		updateNetworkObservabilityAndMVU(t, in),
 		updateTierNetworkAndRemoveObservability(t, in),
+		updateMinorVersionUpgradeConfig(t, in),
 	}
 }
 
@@ -360,6 +361,25 @@ func updateTierNetworkAndRemoveObservability(t *testing.T, in *inputT) resource.
 	}
 }
 
+// This step verifies the successful update of the minor version upgrade config, and that
+// "upgrade_to_version" is not set from the Vault version of the cluster.
+func updateMinorVersionUpgradeConfig(t *testing.T, in *inputT) resource.TestStep {
+	newIn := *in
+	newIn.PublicEndpoint = "false"
+	newIn.ProxyEndpoint = "DISABLED"
+	return resource.TestStep{
+		Config: testConfig(setTestAccVaultClusterConfig(t, updatedVaultClusterMinorVersionUpgrade, newIn, newIn.UpdateTier1)),
+		Check: resource.ComposeTestCheckFunc(
+			testAccCheckVaultClusterExists(in.VaultClusterResourceName),
+			resource.TestCheckResourceAttr(in.VaultClusterResourceName, "major_version_upgrade_config.0.upgrade_type", "SCHEDULED"),
+			resource.TestCheckResourceAttr(in.VaultClusterResourceName, "minor_version_upgrade_config.0.upgrade_type", "SCHEDULED"),
+			resource.TestCheckResourceAttr(in.VaultClusterResourceName, "minor_version_upgrade_config.0.maintenance_window_day", "SATURDAY"),
+			resource.TestCheckResourceAttr(in.VaultClusterResourceName, "minor_version_upgrade_config.0.maintenance_window_time", "WINDOW_6PM_10PM"),
+			resource.TestCheckNoResourceAttr(in.VaultClusterResourceName, "upgrade_to_version"),
+		),
+	}
+}
+
 func addTimestampSuffix(in string) string {
 	return in + time.Now().Format("200601021504")
 }
@@ -29,6 +29,7 @@ func resourceVaultPlugin() *schema.Resource {
 		ReadContext:   resourceVaultPluginRead,
 		UpdateContext: resourceVaultPluginUpdate,
 		DeleteContext: resourceVaultPluginDelete,
+		CustomizeDiff: resourceVaultPluginCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Default: &defaultVaultPluginTimeout,
 		},
@@ -62,6 +63,16 @@ func resourceVaultPlugin() *schema.Resource {
 				},
 			},
 			// Optional inputs
+			"plugin_version": {
+				Description: "The version of the plugin. It is only used for validation, since the plugin is always registered with the version available in the plugin catalog of the cluster, " +
+					"which depends on the Vault version of the cluster. If set, the plan fails unless it is the available version. " +
+					"Use the `hcp_vault_plugins` data source to list the available plugins and versions. Changing it does not re-register the plugin. " +
+					"When the Vault version of the cluster is upgraded, the registered plugin follows the plugin catalog of the cluster: " +
+					"if set, plans fail until it is updated to the new available version.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"project_id": {
 				Description: `
 The ID of the HCP project where the HCP Vault cluster is located. 
@@ -107,7 +118,7 @@ func resourceVaultPluginCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return setVaultPluginVersion(ctx, client, d, loc, clusterID, pluginName, pluginType)
 }
 
 func resourceVaultPluginRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -140,6 +151,9 @@ func resourceVaultPluginRead(ctx context.Context, d *schema.ResourceData, meta i
 			if err := setVaultPluginResourceData(d, loc.ProjectID, clusterID, pluginName, pluginTypeString); err != nil {
 				return diag.FromErr(err)
 			}
+			if err := d.Set("plugin_version", plugin.PluginVersion); err != nil {
+				return diag.FromErr(err)
+			}
 			return nil
 		}
 	}
@@ -194,6 +208,12 @@ func resourceVaultPluginUpdate(ctx context.Context, d *schema.ResourceData, meta
 		ProjectID:      projectID,
 	}
 
+	// The plugin_version is only validated, the plugin is not registered again
+	// when it changes alone.
+	if !d.HasChanges("cluster_id", "project_id", "plugin_name", "plugin_type") {
+		return setVaultPluginVersion(ctx, client, d, loc, clusterID, pluginName, pluginType)
+	}
+
 	log.Printf("[INFO] Adding Vault Plugin (%s) on Vault Cluster (%s)", pluginName, clusterID)
 	req := &vaultmodels.HashicorpCloudVault20201125AddPluginRequest{PluginName: pluginName, PluginType: pluginType}
 	_, err = clients.AddPlugin(ctx, client, loc, clusterID, req)
@@ -204,7 +224,8 @@ func resourceVaultPluginUpdate(ctx context.Context, d *schema.ResourceData, meta
 	if err := setVaultPluginResourceData(d, projectID, clusterID, pluginName, pluginType); err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+
+	return setVaultPluginVersion(ctx, client, d, loc, clusterID, pluginName, pluginType)
 }
 
 func resourceVaultPluginDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -255,6 +276,94 @@ func setVaultPluginResourceData(d *schema.ResourceData, projectID string, cluste
 	return nil
 }
 
+// resourceVaultPluginCustomizeDiff validates the plugin_version, if set, against the
+// plugin catalog of the cluster, so that a version that cannot be registered fails
+// at plan time rather than at apply time.
+func resourceVaultPluginCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	pluginVersion := d.Get("plugin_version").(string)
+	if pluginVersion == "" || !d.NewValueKnown("plugin_version") || !d.NewValueKnown("cluster_id") || !d.NewValueKnown("project_id") {
+		return nil
+	}
+	// Only validate versions set in the configuration, not the computed ones.
+	if !d.HasChange("plugin_version") && d.Id() != "" {
+		return nil
+	}
+
+	client := meta.(*clients.Client)
+	clusterID := d.Get("cluster_id").(string)
+	pluginName := d.Get("plugin_name").(string)
+	pluginType := d.Get("plugin_type").(string)
+	projectID, err := GetProjectID(d.Get("project_id").(string), client.Config.ProjectID)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve project ID: %v", err)
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: client.Config.OrganizationID,
+		ProjectID:      projectID,
+	}
+
+	pluginsResp, err := clients.ListPlugins(ctx, client, loc, clusterID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			// The cluster is created in the same apply, the version is checked on create.
+			return nil
+		}
+		return fmt.Errorf("unable to list plugins of Vault cluster (%s): %v", clusterID, err)
+	}
+
+	plugin := findVaultPlugin(pluginsResp.Plugins, pluginName, pluginType)
+	if plugin == nil {
+		return fmt.Errorf("plugin %s of type %s is not available on Vault cluster (%s)", pluginName, pluginType, clusterID)
+	}
+	if !sameVaultVersion(pluginVersion, plugin.PluginVersion) {
+		return fmt.Errorf("version %s of plugin %s is not available on Vault cluster (%s), the available version is %s", pluginVersion, pluginName, clusterID, plugin.PluginVersion)
+	}
+
+	return nil
+}
+
+// setVaultPluginVersion sets the version of the plugin registered on the cluster,
+// and fails if it differs from the configured plugin_version.
+func setVaultPluginVersion(ctx context.Context, client *clients.Client, d *schema.ResourceData, loc *sharedmodels.HashicorpCloudLocationLocation,
+	clusterID string, pluginName string, pluginType string) diag.Diagnostics {
+
+	pluginsResp, err := clients.ListPlugins(ctx, client, loc, clusterID)
+	if err != nil {
+		return diag.Errorf("unable to list plugins of Vault cluster (%s): %v", clusterID, err)
+	}
+
+	plugin := findVaultPlugin(pluginsResp.Plugins, pluginName, pluginType)
+	if plugin == nil {
+		return diag.Errorf("plugin %s of type %s is not available on Vault cluster (%s)", pluginName, pluginType, clusterID)
+	}
+
+	if pluginVersion := d.Get("plugin_version").(string); pluginVersion != "" && !sameVaultVersion(pluginVersion, plugin.PluginVersion) {
+		return diag.Errorf("plugin %s was registered on Vault cluster (%s) with version %s instead of %s", pluginName, clusterID, plugin.PluginVersion, pluginVersion)
+	}
+
+	if err := d.Set("plugin_version", plugin.PluginVersion); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// findVaultPlugin returns the plugin with the given name and type from the plugin
+// catalog of a cluster, or nil if the cluster has no such plugin.
+func findVaultPlugin(plugins []*vaultmodels.HashicorpCloudVault20201125PluginRegistrationStatus, pluginName string, pluginType string) *vaultmodels.HashicorpCloudVault20201125PluginRegistrationStatus {
+	for _, plugin := range plugins {
+		if plugin == nil || plugin.PluginType == nil {
+			continue
+		}
+		if strings.EqualFold(pluginName, plugin.PluginName) && strings.EqualFold(pluginType, string(*plugin.PluginType)) {
+			return plugin
+		}
+	}
+
+	return nil
+}
+
 // resourceHVNRouteImport implements the logic necessary to import an
 // un-tracked (by Terraform) HVN route resource into Terraform state.
 func resourceVaultPluginImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
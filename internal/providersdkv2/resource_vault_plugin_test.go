@@ -6,6 +6,7 @@ package providersdkv2
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -44,7 +45,20 @@ resource "hcp_vault_plugin" "venafi_plugin" {
 		plugin_name        = "venafi-pki-backend"
 		plugin_type        = "SECRET"
 	}
+
+	data "hcp_vault_plugins" "test" {
+		cluster_id         = hcp_vault_cluster.test.cluster_id
+
+		depends_on = [hcp_vault_plugin.venafi_plugin]
+	}
 `, testAccVaultPluginConfig)
+
+	// testAccVaultPluginInvalidVersionConfig pins a version of the plugin that is not
+	// in the plugin catalog of the cluster.
+	testAccVaultPluginInvalidVersionConfig = strings.Replace(testAccVaultPluginConfig,
+		`plugin_type        = "SECRET"`,
+		`plugin_type        = "SECRET"
+	plugin_version     = "v0.0.1"`, 1)
 )
 
 func TestAcc_Vault_Plugin(t *testing.T) {
@@ -66,8 +80,15 @@ func TestAcc_Vault_Plugin(t *testing.T) {
 					testAccChecVaultPluginExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "plugin_name", "venafi-pki-backend"),
 					resource.TestCheckResourceAttr(resourceName, "plugin_type", "SECRET"),
+					resource.TestCheckResourceAttrSet(resourceName, "plugin_version"),
 				),
 			},
+			// Testing that a version missing from the plugin catalog fails at plan time
+			{
+				Config:      testConfig(testAccVaultPluginInvalidVersionConfig),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`version v0.0.1 of plugin venafi-pki-backend is not available`),
+			},
 			// Testing that we can import Vault plugin created in the previous step and that the
 			// resource terraform state will be exactly the same
 			{
@@ -104,6 +125,13 @@ func TestAcc_Vault_Plugin(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "plugin_name", dataSourceName, "plugin_name"),
 					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", dataSourceName, "cluster_id"),
 					resource.TestCheckResourceAttrPair(resourceName, "project_id", dataSourceName, "project_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "plugin_version", dataSourceName, "plugin_version"),
+					resource.TestCheckResourceAttrPair("hcp_vault_cluster.test", "vault_version", "data.hcp_vault_plugins.test", "vault_version"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.hcp_vault_plugins.test", "plugins.*", map[string]string{
+						"plugin_name":   "venafi-pki-backend",
+						"plugin_type":   "SECRET",
+						"is_registered": "true",
+					}),
 				),
 			},
 		},